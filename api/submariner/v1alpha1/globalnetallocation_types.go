@@ -0,0 +1,96 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GlobalnetAllocationSpec defines a cluster's request for a global CIDR.
+// +k8s:openapi-gen=true
+type GlobalnetAllocationSpec struct {
+	// The ID of the cluster requesting the allocation.
+	ClusterID string `json:"clusterID"`
+	// A specific global CIDR to allocate; if empty, a CIDR is allocated from the Broker's globalnetCIDRRange.
+	// +optional
+	GlobalCIDR string `json:"globalCIDR,omitempty"`
+	// The number of global IPs to allocate, when no global CIDR is specified; defaults to the Broker's
+	// defaultGlobalnetClusterSize.
+	// +optional
+	ClusterSize uint `json:"clusterSize,omitempty"`
+}
+
+// GlobalnetAllocationStatus defines the observed state of GlobalnetAllocation.
+// +k8s:openapi-gen=true
+type GlobalnetAllocationStatus struct {
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// The global CIDR allocated to the cluster.
+	// +optional
+	GlobalCIDR string `json:"globalCIDR,omitempty"`
+}
+
+const (
+	// GlobalnetAllocated indicates whether a global CIDR was allocated to the cluster.
+	GlobalnetAllocated = "Allocated"
+	// GlobalnetDisabledReason is the GlobalnetAllocated reason used when globalnet isn't enabled on the Broker.
+	GlobalnetDisabledReason = "GlobalnetDisabled"
+	// GlobalnetAllocationFinalizer is added by the Broker controller to the GlobalnetAllocations it processes, so that
+	// it can release their CIDRs when they are deleted.
+	GlobalnetAllocationFinalizer = "controllers.submariner.io/globalnet-allocation"
+	// GlobalnetAllocationPendingAnnotation marks the GlobalnetAllocations created along with join tokens, before their
+	// cluster joins; the Broker controller ignores them until the joining cluster's request removes the annotation.
+	GlobalnetAllocationPendingAnnotation = "submariner.io/pending-join"
+	// GlobalnetClusterIDMismatchReason is the GlobalnetAllocated reason used when a GlobalnetAllocation isn't named after
+	// the cluster it requests a CIDR for.
+	GlobalnetClusterIDMismatchReason = "ClusterIDMismatch"
+)
+
+// +kubebuilder:object:root=true
+
+// GlobalnetAllocation is the Schema for the globalnetallocations API; it is created on the Broker, one per cluster,
+// named after the cluster's ID, and is processed by the Broker controller. The resulting global CIDR is consumed by subctl when joining, then by the
+// cluster's Submariner controller, which follows any later change.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=globalnetallocations,scope=Namespaced
+// +genclient
+// +operator-sdk:csv:customresourcedefinitions:displayName="Globalnet Allocation"
+type GlobalnetAllocation struct { //nolint:govet // we want to keep the traditional order
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalnetAllocationSpec   `json:"spec,omitempty"`
+	Status GlobalnetAllocationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalnetAllocationList contains a list of GlobalnetAllocation.
+type GlobalnetAllocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalnetAllocation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GlobalnetAllocation{}, &GlobalnetAllocationList{})
+}
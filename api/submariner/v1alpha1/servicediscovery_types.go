@@ -46,6 +46,13 @@ type ServiceDiscoverySpec struct {
 	// +listType=set
 	CustomDomains  []string          `json:"customDomains,omitempty"`
 	ImageOverrides map[string]string `json:"imageOverrides,omitempty"`
+	// +optional
+	ComponentOverrides *ServiceDiscoveryComponentOverrides `json:"componentOverrides,omitempty"`
+	// How long the operator waits for the deployed components to be uninstalled when the resource is deleted;
+	// defaults to two minutes. When the uninstall times out, the resource keeps its finalizer and isn't removed. The
+	// uninstall can then be retried by increasing this, or abandoned by removing the finalizer.
+	// +optional
+	UninstallTimeout *metav1.Duration `json:"uninstallTimeout,omitempty"`
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make manifests" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book-v1.book.kubebuilder.io/beyond_basics/generating_crd.html
@@ -56,10 +63,25 @@ type CoreDNSCustomConfig struct {
 	Namespace     string `json:"namespace,omitempty"`
 }
 
+// ServiceDiscoveryComponentOverrides holds the overrides for each component deployed for a ServiceDiscovery resource.
+type ServiceDiscoveryComponentOverrides struct {
+	// +optional
+	LighthouseAgent *ComponentOverrides `json:"lighthouseAgent,omitempty"`
+	// +optional
+	LighthouseCoreDNS *ComponentOverrides `json:"lighthouseCoreDNS,omitempty"`
+}
+
 // ServiceDiscoveryStatus defines the observed state of ServiceDiscovery
 // +k8s:openapi-gen=true
 type ServiceDiscoveryStatus struct {
 	DeploymentInfo DeploymentInfo `json:"deploymentInfo,omitempty"`
+	// The generation of the ServiceDiscovery resource last processed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make manifests" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book-v1.book.kubebuilder.io/beyond_basics/generating_crd.html
@@ -66,6 +66,13 @@ type SubmarinerSpec struct {
 	ImageOverrides map[string]string `json:"imageOverrides,omitempty"`
 	// +optional
 	ConnectionHealthCheck *HealthCheckSpec `json:"connectionHealthCheck,omitempty"`
+	// +optional
+	ComponentOverrides *SubmarinerComponentOverrides `json:"componentOverrides,omitempty"`
+	// How long the operator waits for the deployed components to be uninstalled when the resource is deleted;
+	// defaults to two minutes. When the uninstall times out, the resource keeps its finalizer and isn't removed. The
+	// uninstall can then be retried by increasing this, or abandoned by removing the finalizer.
+	// +optional
+	UninstallTimeout *metav1.Duration `json:"uninstallTimeout,omitempty"`
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make manifests" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book-v1.book.kubebuilder.io/beyond_basics/generating_crd.html
@@ -87,6 +94,16 @@ type SubmarinerStatus struct {
 	LoadBalancerStatus        LoadBalancerStatus      `json:"loadBalancerStatus,omitempty"`
 	Gateways                  *[]submv1.GatewayStatus `json:"gateways,omitempty"`
 	DeploymentInfo            DeploymentInfo          `json:"deploymentInfo,omitempty"`
+	// The most recent changes of the active gateway, oldest first.
+	// +optional
+	GatewayHistory []GatewayTransition `json:"gatewayHistory,omitempty"`
+	// The generation of the Submariner resource last processed by the operator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make manifests" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book-v1.book.kubebuilder.io/beyond_basics/generating_crd.html
@@ -103,6 +120,20 @@ type DaemonSetStatus struct {
 	MismatchedContainerImages bool                     `json:"mismatchedContainerImages"`
 }
 
+// GatewayTransition records a change of the active gateway.
+type GatewayTransition struct {
+	// The time at which the change was observed.
+	Time metav1.Time `json:"time"`
+	// The host name of the previously active gateway, if any.
+	// +optional
+	PreviousActive string `json:"previousActive,omitempty"`
+	// The host name of the newly active gateway, if any.
+	// +optional
+	NewActive string `json:"newActive,omitempty"`
+	// A brief CamelCase reason for the change.
+	Reason string `json:"reason"`
+}
+
 type DeploymentInfo struct {
 	KubernetesType        KubernetesType `json:"kubernetesType,omitempty"`
 	KubernetesTypeVersion string         `json:"kubernetesTypeVersion,omitempty"`
@@ -118,6 +149,46 @@ type HealthCheckSpec struct {
 	MaxPacketLossCount uint64 `json:"maxPacketLossCount,omitempty"`
 }
 
+// ComponentOverrides customizes the pods deployed for a component.
+type ComponentOverrides struct {
+	// Compute resources required by each of the component's containers.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Tolerations added to the component's default tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Node labels merged into the component's default node selector.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Scheduling constraints replacing the component's defaults; each type of affinity which is set replaces
+	// the corresponding default.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// The name of the priority class assigned to the component's pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Extra labels added to the component's pods; labels set by the operator take precedence.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Extra annotations added to the component's pods.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// SubmarinerComponentOverrides holds the overrides for each component deployed for a Submariner resource.
+type SubmarinerComponentOverrides struct {
+	// +optional
+	Gateway *ComponentOverrides `json:"gateway,omitempty"`
+	// +optional
+	RouteAgent *ComponentOverrides `json:"routeAgent,omitempty"`
+	// +optional
+	Globalnet *ComponentOverrides `json:"globalnet,omitempty"`
+	// +optional
+	NetworkPluginSyncer *ComponentOverrides `json:"networkPluginSyncer,omitempty"`
+	// +optional
+	ServiceDiscoveryComponentOverrides `json:",inline"`
+}
+
 type (
 	KubernetesType string
 	CloudProvider  string
@@ -143,6 +214,7 @@ const (
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=submariners,scope=Namespaced
+// +kubebuilder:storageversion
 // +genclient
 // +operator-sdk:csv:customresourcedefinitions:displayName="Submariner"
 type Submariner struct {
@@ -179,8 +251,55 @@ type BrokerSpec struct {
 // +k8s:openapi-gen=true
 type BrokerStatus struct { // INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// The clusters which have joined the broker.
+	// +optional
+	Clusters []BrokerClusterStatus `json:"clusters,omitempty"`
 }
 
+// BrokerClusterStatus describes a cluster which has joined the broker.
+type BrokerClusterStatus struct {
+	ClusterID string `json:"clusterID"`
+	// The cable drivers used by the cluster's gateway Endpoints.
+	// +optional
+	CableDrivers []string `json:"cableDrivers,omitempty"`
+	// The global CIDRs allocated to the cluster when globalnet is enabled.
+	// +optional
+	GlobalCIDRs []string `json:"globalCIDRs,omitempty"`
+	// The last time one of the cluster's Endpoints was updated on the broker.
+	// +optional
+	LastHeartbeat *metav1.Time `json:"lastHeartbeat,omitempty"`
+	// Whether the cluster has at least one gateway Endpoint on the broker.
+	Connected bool `json:"connected"`
+}
+
+const (
+	// ConditionReady indicates whether all the deployed components are available.
+	ConditionReady = "Ready"
+	// ConditionProgressing indicates whether the deployed components are being rolled out.
+	ConditionProgressing = "Progressing"
+	// ConditionDegraded indicates whether any of the deployed components are failing.
+	ConditionDegraded = "Degraded"
+	// ConditionNetworkDiscovered indicates whether the cluster network details were discovered.
+	ConditionNetworkDiscovered = "NetworkDiscovered"
+	// ConditionUninstalled indicates whether the deployed components were uninstalled, once the resource is deleted.
+	// Each component also gets its own condition, named after the component with this suffix.
+	ConditionUninstalled = "Uninstalled"
+)
+
+const (
+	// BrokerCRDsReady indicates whether the CRDs required on the broker are installed.
+	BrokerCRDsReady = "CRDsReady"
+	// BrokerGlobalnetConfigValid indicates whether the globalnet configuration on the broker is valid.
+	BrokerGlobalnetConfigValid = "GlobalnetConfigValid"
+	// BrokerClustersHealthy indicates whether all the joined clusters have gateway Endpoints on the broker.
+	BrokerClustersHealthy = "ClustersHealthy"
+)
+
 // +kubebuilder:object:root=true
 
 // Broker is the Schema for the brokers API
@@ -26,6 +26,7 @@ import (
 	"github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -35,7 +36,7 @@ func (in *Broker) DeepCopyInto(out *Broker) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Broker.
@@ -56,6 +57,35 @@ func (in *Broker) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerClusterStatus) DeepCopyInto(out *BrokerClusterStatus) {
+	*out = *in
+	if in.CableDrivers != nil {
+		in, out := &in.CableDrivers, &out.CableDrivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GlobalCIDRs != nil {
+		in, out := &in.GlobalCIDRs, &out.GlobalCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastHeartbeat != nil {
+		in, out := &in.LastHeartbeat, &out.LastHeartbeat
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerClusterStatus.
+func (in *BrokerClusterStatus) DeepCopy() *BrokerClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BrokerList) DeepCopyInto(out *BrokerList) {
 	*out = *in
@@ -116,6 +146,20 @@ func (in *BrokerSpec) DeepCopy() *BrokerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BrokerStatus) DeepCopyInto(out *BrokerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]BrokerClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerStatus.
@@ -128,6 +172,59 @@ func (in *BrokerStatus) DeepCopy() *BrokerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentOverrides) DeepCopyInto(out *ComponentOverrides) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentOverrides.
+func (in *ComponentOverrides) DeepCopy() *ComponentOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreDNSCustomConfig) DeepCopyInto(out *CoreDNSCustomConfig) {
 	*out = *in
@@ -189,6 +286,118 @@ func (in *DeploymentInfo) DeepCopy() *DeploymentInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayTransition) DeepCopyInto(out *GatewayTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayTransition.
+func (in *GatewayTransition) DeepCopy() *GatewayTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalnetAllocation) DeepCopyInto(out *GlobalnetAllocation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalnetAllocation.
+func (in *GlobalnetAllocation) DeepCopy() *GlobalnetAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalnetAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalnetAllocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalnetAllocationList) DeepCopyInto(out *GlobalnetAllocationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalnetAllocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalnetAllocationList.
+func (in *GlobalnetAllocationList) DeepCopy() *GlobalnetAllocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalnetAllocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalnetAllocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalnetAllocationSpec) DeepCopyInto(out *GlobalnetAllocationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalnetAllocationSpec.
+func (in *GlobalnetAllocationSpec) DeepCopy() *GlobalnetAllocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalnetAllocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalnetAllocationStatus) DeepCopyInto(out *GlobalnetAllocationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalnetAllocationStatus.
+func (in *GlobalnetAllocationStatus) DeepCopy() *GlobalnetAllocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalnetAllocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
 	*out = *in
@@ -227,7 +436,7 @@ func (in *LoadBalancerStatus) DeepCopy() *LoadBalancerStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceDiscovery) DeepCopyInto(out *ServiceDiscovery) {
 	*out = *in
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 	out.TypeMeta = in.TypeMeta
@@ -251,6 +460,31 @@ func (in *ServiceDiscovery) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceDiscoveryComponentOverrides) DeepCopyInto(out *ServiceDiscoveryComponentOverrides) {
+	*out = *in
+	if in.LighthouseAgent != nil {
+		in, out := &in.LighthouseAgent, &out.LighthouseAgent
+		*out = new(ComponentOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LighthouseCoreDNS != nil {
+		in, out := &in.LighthouseCoreDNS, &out.LighthouseCoreDNS
+		*out = new(ComponentOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDiscoveryComponentOverrides.
+func (in *ServiceDiscoveryComponentOverrides) DeepCopy() *ServiceDiscoveryComponentOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceDiscoveryComponentOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceDiscoveryList) DeepCopyInto(out *ServiceDiscoveryList) {
 	*out = *in
@@ -303,6 +537,16 @@ func (in *ServiceDiscoverySpec) DeepCopyInto(out *ServiceDiscoverySpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ComponentOverrides != nil {
+		in, out := &in.ComponentOverrides, &out.ComponentOverrides
+		*out = new(ServiceDiscoveryComponentOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UninstallTimeout != nil {
+		in, out := &in.UninstallTimeout, &out.UninstallTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDiscoverySpec.
@@ -319,6 +563,13 @@ func (in *ServiceDiscoverySpec) DeepCopy() *ServiceDiscoverySpec {
 func (in *ServiceDiscoveryStatus) DeepCopyInto(out *ServiceDiscoveryStatus) {
 	*out = *in
 	out.DeploymentInfo = in.DeploymentInfo
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDiscoveryStatus.
@@ -358,6 +609,42 @@ func (in *Submariner) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubmarinerComponentOverrides) DeepCopyInto(out *SubmarinerComponentOverrides) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(ComponentOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteAgent != nil {
+		in, out := &in.RouteAgent, &out.RouteAgent
+		*out = new(ComponentOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Globalnet != nil {
+		in, out := &in.Globalnet, &out.Globalnet
+		*out = new(ComponentOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPluginSyncer != nil {
+		in, out := &in.NetworkPluginSyncer, &out.NetworkPluginSyncer
+		*out = new(ComponentOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ServiceDiscoveryComponentOverrides.DeepCopyInto(&out.ServiceDiscoveryComponentOverrides)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubmarinerComponentOverrides.
+func (in *SubmarinerComponentOverrides) DeepCopy() *SubmarinerComponentOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(SubmarinerComponentOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubmarinerList) DeepCopyInto(out *SubmarinerList) {
 	*out = *in
@@ -415,6 +702,16 @@ func (in *SubmarinerSpec) DeepCopyInto(out *SubmarinerSpec) {
 		*out = new(HealthCheckSpec)
 		**out = **in
 	}
+	if in.ComponentOverrides != nil {
+		in, out := &in.ComponentOverrides, &out.ComponentOverrides
+		*out = new(SubmarinerComponentOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UninstallTimeout != nil {
+		in, out := &in.UninstallTimeout, &out.UninstallTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubmarinerSpec.
@@ -446,6 +743,20 @@ func (in *SubmarinerStatus) DeepCopyInto(out *SubmarinerStatus) {
 		}
 	}
 	out.DeploymentInfo = in.DeploymentInfo
+	if in.GatewayHistory != nil {
+		in, out := &in.GatewayHistory, &out.GatewayHistory
+		*out = make([]GatewayTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubmarinerStatus.
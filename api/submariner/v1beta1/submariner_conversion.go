@@ -0,0 +1,161 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this Submariner to the Hub version (v1alpha1).
+func (s *Submariner) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.Submariner)
+	src := s.DeepCopy()
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
+
+	dst.Spec = v1alpha1.SubmarinerSpec{
+		ClusterID:  src.Spec.ClusterID,
+		Namespace:  src.Spec.Namespace,
+		ColorCodes: src.Spec.ColorCodes,
+		Debug:      src.Spec.Debug,
+
+		Broker:                   src.Spec.Broker.Type,
+		BrokerK8sApiServer:       src.Spec.Broker.APIServer,
+		BrokerK8sApiServerToken:  src.Spec.Broker.APIServerToken,
+		BrokerK8sCA:              src.Spec.Broker.CA,
+		BrokerK8sSecret:          src.Spec.Broker.Secret,
+		BrokerK8sRemoteNamespace: src.Spec.Broker.RemoteNamespace,
+		BrokerK8sInsecure:        src.Spec.Broker.Insecure,
+
+		CableDriver:            src.Spec.Cable.Driver,
+		NatEnabled:             src.Spec.Cable.NATEnabled,
+		LoadBalancerEnabled:    src.Spec.Cable.LoadBalancerEnabled,
+		CeIPSecPSK:             src.Spec.Cable.IPSec.PSK,
+		CeIPSecPSKSecret:       src.Spec.Cable.IPSec.PSKSecret,
+		CeIPSecIKEPort:         src.Spec.Cable.IPSec.IKEPort,
+		CeIPSecNATTPort:        src.Spec.Cable.IPSec.NATTPort,
+		CeIPSecDebug:           src.Spec.Cable.IPSec.Debug,
+		CeIPSecPreferredServer: src.Spec.Cable.IPSec.PreferredServer,
+		CeIPSecForceUDPEncaps:  src.Spec.Cable.IPSec.ForceUDPEncaps,
+
+		ClusterCIDR: src.Spec.Networking.ClusterCIDR,
+		ServiceCIDR: src.Spec.Networking.ServiceCIDR,
+		GlobalCIDR:  src.Spec.Networking.GlobalCIDR,
+
+		Repository:     src.Spec.Images.Repository,
+		Version:        src.Spec.Images.Version,
+		ImageOverrides: src.Spec.Images.Overrides,
+
+		ServiceDiscoveryEnabled: src.Spec.ServiceDiscovery.Enabled,
+		CustomDomains:           src.Spec.ServiceDiscovery.CustomDomains,
+
+		ComponentOverrides: src.Spec.ComponentOverrides,
+		UninstallTimeout:   src.Spec.UninstallTimeout,
+	}
+
+	if src.Spec.ServiceDiscovery.CoreDNSCustomConfig != nil {
+		dst.Spec.CoreDNSCustomConfig = &v1alpha1.CoreDNSCustomConfig{
+			ConfigMapName: src.Spec.ServiceDiscovery.CoreDNSCustomConfig.ConfigMapName,
+			Namespace:     src.Spec.ServiceDiscovery.CoreDNSCustomConfig.Namespace,
+		}
+	}
+
+	if src.Spec.HealthCheck != nil {
+		dst.Spec.ConnectionHealthCheck = &v1alpha1.HealthCheckSpec{
+			Enabled:            src.Spec.HealthCheck.Enabled,
+			IntervalSeconds:    src.Spec.HealthCheck.IntervalSeconds,
+			MaxPacketLossCount: src.Spec.HealthCheck.MaxPacketLossCount,
+		}
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version.
+func (s *Submariner) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.Submariner).DeepCopy()
+
+	s.ObjectMeta = src.ObjectMeta
+	s.Status = src.Status
+
+	s.Spec = SubmarinerSpec{
+		ClusterID:  src.Spec.ClusterID,
+		Namespace:  src.Spec.Namespace,
+		ColorCodes: src.Spec.ColorCodes,
+		Debug:      src.Spec.Debug,
+		Broker: BrokerConfig{
+			Type:            src.Spec.Broker,
+			APIServer:       src.Spec.BrokerK8sApiServer,
+			APIServerToken:  src.Spec.BrokerK8sApiServerToken,
+			CA:              src.Spec.BrokerK8sCA,
+			Secret:          src.Spec.BrokerK8sSecret,
+			RemoteNamespace: src.Spec.BrokerK8sRemoteNamespace,
+			Insecure:        src.Spec.BrokerK8sInsecure,
+		},
+		Cable: CableConfig{
+			Driver:              src.Spec.CableDriver,
+			NATEnabled:          src.Spec.NatEnabled,
+			LoadBalancerEnabled: src.Spec.LoadBalancerEnabled,
+			IPSec: IPSecConfig{
+				PSK:             src.Spec.CeIPSecPSK,
+				PSKSecret:       src.Spec.CeIPSecPSKSecret,
+				IKEPort:         src.Spec.CeIPSecIKEPort,
+				NATTPort:        src.Spec.CeIPSecNATTPort,
+				Debug:           src.Spec.CeIPSecDebug,
+				PreferredServer: src.Spec.CeIPSecPreferredServer,
+				ForceUDPEncaps:  src.Spec.CeIPSecForceUDPEncaps,
+			},
+		},
+		Networking: NetworkingConfig{
+			ClusterCIDR: src.Spec.ClusterCIDR,
+			ServiceCIDR: src.Spec.ServiceCIDR,
+			GlobalCIDR:  src.Spec.GlobalCIDR,
+		},
+		Images: ImagesConfig{
+			Repository: src.Spec.Repository,
+			Version:    src.Spec.Version,
+			Overrides:  src.Spec.ImageOverrides,
+		},
+		ServiceDiscovery: ServiceDiscoveryConfig{
+			Enabled:       src.Spec.ServiceDiscoveryEnabled,
+			CustomDomains: src.Spec.CustomDomains,
+		},
+		ComponentOverrides: src.Spec.ComponentOverrides,
+		UninstallTimeout:   src.Spec.UninstallTimeout,
+	}
+
+	if src.Spec.CoreDNSCustomConfig != nil {
+		s.Spec.ServiceDiscovery.CoreDNSCustomConfig = &CoreDNSCustomConfig{
+			ConfigMapName: src.Spec.CoreDNSCustomConfig.ConfigMapName,
+			Namespace:     src.Spec.CoreDNSCustomConfig.Namespace,
+		}
+	}
+
+	if src.Spec.ConnectionHealthCheck != nil {
+		s.Spec.HealthCheck = &HealthCheckSpec{
+			Enabled:            src.Spec.ConnectionHealthCheck.Enabled,
+			IntervalSeconds:    src.Spec.ConnectionHealthCheck.IntervalSeconds,
+			MaxPacketLossCount: src.Spec.ConnectionHealthCheck.MaxPacketLossCount,
+		}
+	}
+
+	return nil
+}
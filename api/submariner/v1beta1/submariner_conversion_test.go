@@ -0,0 +1,160 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Submariner conversion", func() {
+	When("a fully populated v1alpha1 Submariner is converted to v1beta1 and back", func() {
+		It("should be unchanged", func() {
+			original := newV1alpha1Submariner()
+
+			converted := &v1beta1.Submariner{}
+			Expect(converted.ConvertFrom(original.DeepCopy())).To(Succeed())
+
+			roundTripped := &v1alpha1.Submariner{}
+			Expect(converted.ConvertTo(roundTripped)).To(Succeed())
+
+			Expect(roundTripped).To(Equal(original))
+		})
+
+		It("should group the fields into the v1beta1 sub-specs", func() {
+			original := newV1alpha1Submariner()
+
+			converted := &v1beta1.Submariner{}
+			Expect(converted.ConvertFrom(original)).To(Succeed())
+
+			Expect(converted.Spec.Broker.APIServer).To(Equal(original.Spec.BrokerK8sApiServer))
+			Expect(converted.Spec.Cable.IPSec.NATTPort).To(Equal(original.Spec.CeIPSecNATTPort))
+			Expect(converted.Spec.Networking.GlobalCIDR).To(Equal(original.Spec.GlobalCIDR))
+			Expect(converted.Spec.Images.Overrides).To(Equal(original.Spec.ImageOverrides))
+			Expect(converted.Spec.HealthCheck.IntervalSeconds).To(Equal(original.Spec.ConnectionHealthCheck.IntervalSeconds))
+			Expect(converted.Spec.ComponentOverrides).To(Equal(original.Spec.ComponentOverrides))
+			Expect(converted.Spec.UninstallTimeout).To(Equal(original.Spec.UninstallTimeout))
+			Expect(converted.Status).To(Equal(original.Status))
+		})
+	})
+
+	When("a fully populated v1beta1 Submariner is converted to v1alpha1 and back", func() {
+		It("should be unchanged", func() {
+			original := &v1beta1.Submariner{}
+			Expect(original.ConvertFrom(newV1alpha1Submariner())).To(Succeed())
+
+			hub := &v1alpha1.Submariner{}
+			Expect(original.DeepCopy().ConvertTo(hub)).To(Succeed())
+
+			roundTripped := &v1beta1.Submariner{}
+			Expect(roundTripped.ConvertFrom(hub)).To(Succeed())
+
+			Expect(roundTripped).To(Equal(original))
+		})
+	})
+
+	When("the optional sub-specs aren't set", func() {
+		It("should round-trip them as unset", func() {
+			original := &v1alpha1.Submariner{
+				ObjectMeta: metav1.ObjectMeta{Name: "submariner"},
+				Spec:       v1alpha1.SubmarinerSpec{ClusterID: "east"},
+			}
+
+			converted := &v1beta1.Submariner{}
+			Expect(converted.ConvertFrom(original.DeepCopy())).To(Succeed())
+			Expect(converted.Spec.HealthCheck).To(BeNil())
+			Expect(converted.Spec.ServiceDiscovery.CoreDNSCustomConfig).To(BeNil())
+
+			roundTripped := &v1alpha1.Submariner{}
+			Expect(converted.ConvertTo(roundTripped)).To(Succeed())
+			Expect(roundTripped).To(Equal(original))
+		})
+	})
+})
+
+func newV1alpha1Submariner() *v1alpha1.Submariner {
+	return &v1alpha1.Submariner{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "submariner",
+			Namespace:  "submariner-operator",
+			Labels:     map[string]string{"app": "submariner"},
+			Generation: 3,
+		},
+		Spec: v1alpha1.SubmarinerSpec{
+			Broker:                   "k8s",
+			BrokerK8sApiServer:       "https://broker:6443",
+			BrokerK8sApiServerToken:  "token",
+			BrokerK8sCA:              "ca",
+			BrokerK8sSecret:          "broker-secret",
+			BrokerK8sRemoteNamespace: "submariner-k8s-broker",
+			BrokerK8sInsecure:        true,
+			CableDriver:              "wireguard",
+			CeIPSecPSK:               "psk",
+			CeIPSecPSKSecret:         "psk-secret",
+			CeIPSecIKEPort:           500,
+			CeIPSecNATTPort:          4500,
+			CeIPSecDebug:             true,
+			CeIPSecPreferredServer:   true,
+			CeIPSecForceUDPEncaps:    true,
+			ClusterCIDR:              "10.244.0.0/16",
+			ServiceCIDR:              "10.96.0.0/16",
+			GlobalCIDR:               "242.0.0.0/16",
+			ClusterID:                "east",
+			ColorCodes:               "blue",
+			Repository:               "quay.io/submariner",
+			Version:                  "devel",
+			Namespace:                "submariner-operator",
+			Debug:                    true,
+			NatEnabled:               true,
+			LoadBalancerEnabled:      true,
+			ServiceDiscoveryEnabled:  true,
+			CoreDNSCustomConfig: &v1alpha1.CoreDNSCustomConfig{
+				ConfigMapName: "custom-coredns",
+				Namespace:     "kube-system",
+			},
+			CustomDomains:  []string{"example.org"},
+			ImageOverrides: map[string]string{"submariner-gateway": "quay.io/custom/submariner-gateway:devel"},
+			ConnectionHealthCheck: &v1alpha1.HealthCheckSpec{
+				Enabled:            true,
+				IntervalSeconds:    2,
+				MaxPacketLossCount: 7,
+			},
+			ComponentOverrides: &v1alpha1.SubmarinerComponentOverrides{
+				Gateway: &v1alpha1.ComponentOverrides{
+					Tolerations:       []corev1.Toleration{{Key: "dedicated", Value: "gateway", Effect: corev1.TaintEffectNoSchedule}},
+					PriorityClassName: "system-node-critical",
+				},
+			},
+			UninstallTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+		},
+		Status: v1alpha1.SubmarinerStatus{
+			ClusterID:          "east",
+			NatEnabled:         true,
+			GlobalCIDR:         "242.0.0.0/16",
+			NetworkPlugin:      "generic",
+			ObservedGeneration: 3,
+		},
+	}
+}
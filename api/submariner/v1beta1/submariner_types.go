@@ -0,0 +1,153 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubmarinerSpec defines the desired state of Submariner
+// +k8s:openapi-gen=true
+type SubmarinerSpec struct {
+	ClusterID string `json:"clusterID"`
+	// The namespace in which the Submariner components are deployed.
+	Namespace  string `json:"namespace"`
+	ColorCodes string `json:"colorCodes,omitempty"`
+	Debug      bool   `json:"debug,omitempty"`
+	// The connection details for the broker.
+	Broker BrokerConfig `json:"broker"`
+	// +optional
+	Cable CableConfig `json:"cable,omitempty"`
+	// +optional
+	Networking NetworkingConfig `json:"networking,omitempty"`
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+	// +optional
+	Images ImagesConfig `json:"images,omitempty"`
+	// +optional
+	ServiceDiscovery ServiceDiscoveryConfig `json:"serviceDiscovery,omitempty"`
+	// Customizations of the pods deployed for each component.
+	// +optional
+	ComponentOverrides *v1alpha1.SubmarinerComponentOverrides `json:"componentOverrides,omitempty"`
+	// How long the operator waits for the deployed components to be uninstalled when the resource is deleted;
+	// defaults to two minutes. When the uninstall times out, the resource keeps its finalizer and isn't removed. The
+	// uninstall can then be retried by increasing this, or abandoned by removing the finalizer.
+	// +optional
+	UninstallTimeout *metav1.Duration `json:"uninstallTimeout,omitempty"`
+}
+
+// BrokerConfig holds the details used to connect to the broker cluster.
+type BrokerConfig struct {
+	// The type of broker, currently only "k8s".
+	Type            string `json:"type"`
+	APIServer       string `json:"apiServer"`
+	APIServerToken  string `json:"apiServerToken,omitempty"`
+	CA              string `json:"ca,omitempty"`
+	Secret          string `json:"secret,omitempty"`
+	RemoteNamespace string `json:"remoteNamespace"`
+	Insecure        bool   `json:"insecure,omitempty"`
+}
+
+// CableConfig configures the tunnels established between gateways.
+type CableConfig struct {
+	// The cable driver implementation; defaults to libreswan.
+	Driver              string `json:"driver,omitempty"`
+	NATEnabled          bool   `json:"natEnabled,omitempty"`
+	LoadBalancerEnabled bool   `json:"loadBalancerEnabled,omitempty"`
+	// +optional
+	IPSec IPSecConfig `json:"ipsec,omitempty"`
+}
+
+// IPSecConfig holds the settings specific to the IPsec cable drivers.
+type IPSecConfig struct {
+	PSK             string `json:"psk,omitempty"`
+	PSKSecret       string `json:"pskSecret,omitempty"`
+	IKEPort         int    `json:"ikePort,omitempty"`
+	NATTPort        int    `json:"nattPort,omitempty"`
+	Debug           bool   `json:"debug,omitempty"`
+	PreferredServer bool   `json:"preferredServer,omitempty"`
+	ForceUDPEncaps  bool   `json:"forceUDPEncaps,omitempty"`
+}
+
+// NetworkingConfig holds the cluster's network ranges; any which aren't set are discovered.
+type NetworkingConfig struct {
+	ClusterCIDR string `json:"clusterCIDR,omitempty"`
+	ServiceCIDR string `json:"serviceCIDR,omitempty"`
+	GlobalCIDR  string `json:"globalCIDR,omitempty"`
+}
+
+type HealthCheckSpec struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// The interval at which health check pings are sent.
+	IntervalSeconds uint64 `json:"intervalSeconds,omitempty"`
+	// The maximum number of packets lost at which the health checker will mark the connection as down.
+	MaxPacketLossCount uint64 `json:"maxPacketLossCount,omitempty"`
+}
+
+// ImagesConfig determines the images used for the Submariner components.
+type ImagesConfig struct {
+	Repository string `json:"repository,omitempty"`
+	Version    string `json:"version,omitempty"`
+	// Image overrides keyed by component image name.
+	Overrides map[string]string `json:"overrides,omitempty"`
+}
+
+// ServiceDiscoveryConfig configures the Lighthouse service discovery components.
+type ServiceDiscoveryConfig struct {
+	Enabled             bool                 `json:"enabled,omitempty"`
+	CoreDNSCustomConfig *CoreDNSCustomConfig `json:"coreDNSCustomConfig,omitempty"`
+	// +listType=set
+	CustomDomains []string `json:"customDomains,omitempty"`
+}
+
+type CoreDNSCustomConfig struct {
+	ConfigMapName string `json:"configMapName,omitempty"`
+	Namespace     string `json:"namespace,omitempty"`
+}
+
+// This version isn't served until the CRD is deployed with a conversion webhook, v1alpha1 remains the only
+// served version until then.
+// +kubebuilder:object:root=true
+
+// Submariner is the Schema for the submariners API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=submariners,scope=Namespaced
+// +kubebuilder:unservedversion
+type Submariner struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubmarinerSpec            `json:"spec,omitempty"`
+	Status v1alpha1.SubmarinerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubmarinerList contains a list of Submariner.
+type SubmarinerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Submariner `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Submariner{}, &SubmarinerList{})
+}
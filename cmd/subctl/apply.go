@@ -0,0 +1,303 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subctl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/submariner-io/admiral/pkg/stringset"
+	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/internal/constants"
+	"github.com/submariner-io/submariner-operator/internal/exit"
+	"github.com/submariner-io/submariner-operator/internal/nodes"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	"github.com/submariner-io/submariner-operator/pkg/client"
+	"github.com/submariner-io/submariner-operator/pkg/deploy"
+	"github.com/submariner-io/submariner-operator/pkg/join"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
+	"github.com/submariner-io/submariner-operator/pkg/topology"
+	"k8s.io/client-go/rest"
+)
+
+var (
+	topologyFile   string
+	brokerInfoFile string
+	planOnly       bool
+)
+
+// newApplyCommand returns a new instance of the apply command.
+func newApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Deploy a broker and join clusters to it as described in a topology file",
+		Long: "This command deploys the broker and joins each cluster listed in the topology file, identified by its" +
+			" kubeconfig context. Settings not specified in the file take the same defaults as the deploy-broker and join" +
+			" flags. Clusters which are already deployed are updated to match the file, so the command can be re-run" +
+			" safely.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			status := cli.NewReporter()
+
+			brokerDefaults, joinDefaults := topologyDefaults()
+
+			t, err := topology.ReadFromFile(topologyFile, brokerDefaults, joinDefaults)
+			exit.OnError(status.Error(err, "Error reading the topology"))
+
+			brokerTarget, err := newApplyTarget(t.Broker.Context)
+			exit.OnError(status.Error(err, "Error accessing the broker cluster"))
+
+			clusterTargets := make([]*applyTarget, len(t.Clusters))
+
+			for i := range t.Clusters {
+				clusterTargets[i], err = newApplyTarget(t.Clusters[i].Context)
+				exit.OnError(status.Error(err, "Error accessing the cluster"))
+
+				if t.Clusters[i].ClusterID == "" {
+					t.Clusters[i].ClusterID = clusterTargets[i].clusterID
+				}
+			}
+
+			exit.OnError(status.Error(t.Validate(), "Invalid topology"))
+
+			if brokerInfoFile == "" {
+				brokerInfoFile = filepath.Join(filepath.Dir(topologyFile), broker.InfoFileName)
+			}
+
+			printPlan(t, brokerTarget, clusterTargets, status)
+
+			if planOnly {
+				return
+			}
+
+			err = applyBroker(&t.Broker, brokerTarget, status)
+			exit.OnError(err)
+
+			results := make([]error, len(t.Clusters))
+
+			for i := range t.Clusters {
+				fmt.Printf("\nJoining cluster %q (context %q)\n", t.Clusters[i].ClusterID, t.Clusters[i].Context)
+
+				results[i] = applyCluster(&t.Clusters[i], clusterTargets[i], status)
+			}
+
+			if !printApplyResults(t, results) {
+				exit.WithMessage("Not all clusters were joined successfully")
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&topologyFile, "file", "f", "", "topology file describing the broker and the clusters to join")
+	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().StringVar(&brokerInfoFile, "broker-info", "",
+		"broker information file for this topology; defaults to "+broker.InfoFileName+" next to the topology file")
+	cmd.Flags().BoolVar(&planOnly, "plan", false, "only show the changes which would be applied")
+	restConfigProducer.AddKubeConfigFlag(cmd)
+
+	return cmd
+}
+
+type applyTarget struct {
+	config         *rest.Config
+	clientProducer client.Producer
+	clusterID      string
+}
+
+func init() {
+	rootCmd.AddCommand(newApplyCommand())
+}
+
+// topologyDefaults returns the broker and join options as set by default by the deploy-broker and join flags.
+func topologyDefaults() (*deploy.BrokerOptions, *join.Options) {
+	brokerDefaults := &deploy.BrokerOptions{}
+	addDeployBrokerFlags(&cobra.Command{}, brokerDefaults)
+
+	joinDefaults := &join.Options{}
+	addJoinFlags(&cobra.Command{}, joinDefaults)
+
+	return brokerDefaults, joinDefaults
+}
+
+func newApplyTarget(kubeContext string) (*applyTarget, error) {
+	producer := restConfigProducer.ForContext(kubeContext)
+
+	config, err := producer.ForCluster()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating the REST config for context %q", kubeContext)
+	}
+
+	clientProducer, err := client.NewProducerFromRestConfig(config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating the client producer for context %q", kubeContext)
+	}
+
+	clusterID, err := producer.GetClusterID()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error determining the cluster ID for context %q", kubeContext)
+	}
+
+	return &applyTarget{
+		config:         config,
+		clientProducer: clientProducer,
+		clusterID:      clusterID,
+	}, nil
+}
+
+func printPlan(t *topology.Topology, brokerTarget *applyTarget, clusterTargets []*applyTarget, status reporter.Interface) {
+	status.Start("Comparing the topology with the deployed clusters")
+
+	brokerPlan, err := topology.PlanBroker(&t.Broker, brokerTarget.clientProducer.ForOperator())
+	exit.OnError(status.Error(err, "Error planning the broker"))
+
+	clusterPlans := make([]*topology.Plan, len(t.Clusters))
+
+	for i := range t.Clusters {
+		clusterPlans[i], err = topology.PlanCluster(&t.Clusters[i], clusterTargets[i].clientProducer.ForOperator())
+		exit.OnError(status.Error(err, "Error planning the cluster"))
+	}
+
+	status.End()
+
+	fmt.Println("Broker:")
+	fmt.Printf("  %s\n", brokerPlan)
+	fmt.Println("Clusters:")
+
+	for i := range clusterPlans {
+		fmt.Printf("  %s\n", clusterPlans[i])
+	}
+}
+
+func applyBroker(b *topology.Broker, target *applyTarget, status reporter.Interface) error {
+	fmt.Printf("\nDeploying the broker (context %q)\n", b.Context)
+
+	err := deploy.Broker(&b.BrokerOptions, target.clientProducer, status)
+	if err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	ipsecFile, err := previousBrokerInfoFile(target.config, status)
+	if err != nil {
+		return err
+	}
+
+	// nolint:wrapcheck // No need to wrap here
+	return broker.WriteInfoToFile(brokerInfoFile, target.config, b.BrokerNamespace, ipsecFile,
+		stringset.New(b.BrokerSpec.Components...), b.BrokerSpec.DefaultCustomDomains, status)
+}
+
+// previousBrokerInfoFile returns the broker information file written by a previous run against the same broker, if any,
+// so that its IPsec PSK is kept and clusters which are already joined remain connected.
+func previousBrokerInfoFile(config *rest.Config, status reporter.Interface) (string, error) {
+	if _, err := os.Stat(brokerInfoFile); os.IsNotExist(err) {
+		return "", nil
+	}
+
+	previous, err := broker.ReadInfoFromFile(brokerInfoFile)
+	if err != nil {
+		return "", status.Error(err, "Error loading the previous broker information")
+	}
+
+	if previous.BrokerURL != broker.BrokerURL(config) {
+		status.Warning("The broker information in %q is for broker %q, not %q; a new IPsec PSK will be generated",
+			brokerInfoFile, previous.BrokerURL, broker.BrokerURL(config))
+
+		return "", nil
+	}
+
+	return brokerInfoFile, nil
+}
+
+func applyCluster(c *topology.Cluster, target *applyTarget, status reporter.Interface) error {
+	// Joining replaces the client token in the broker information, so each cluster needs its own copy
+	brokerInfo, err := broker.ReadInfoFromFile(brokerInfoFile)
+	if err != nil {
+		return status.Error(err, "Error loading the broker information")
+	}
+
+	networkDetails := getNetworkDetails(target.clientProducer, status)
+
+	if c.ClusterCIDR == "" && (networkDetails == nil || len(networkDetails.PodCIDRs) == 0) {
+		return status.Error(errors.New("the pod CIDR could not be discovered"), "Specify clusterCIDR for this cluster")
+	}
+
+	if c.ServiceCIDR == "" && (networkDetails == nil || len(networkDetails.ServiceCIDRs) == 0) {
+		return status.Error(errors.New("the service CIDR could not be discovered"), "Specify serviceCIDR for this cluster")
+	}
+
+	if brokerInfo.IsConnectivityEnabled() && c.ShouldLabelGateway() {
+		if err := ensureGatewayLabeled(target.clientProducer, status); err != nil {
+			return err
+		}
+	}
+
+	if c.CustomDomains == nil && brokerInfo.CustomDomains != nil {
+		c.CustomDomains = *brokerInfo.CustomDomains
+	}
+
+	return join.ClusterToBroker(brokerInfo, &c.Options, target.clientProducer, status) // nolint:wrapcheck // No need to wrap here
+}
+
+func ensureGatewayLabeled(clientProducer client.Producer, status reporter.Interface) error {
+	status.Start("Retrieving the gateway nodes")
+	defer status.End()
+
+	gatewayNodes, err := nodes.ListGateways(clientProducer.ForKubernetes())
+	if err != nil {
+		return status.Error(err, "Error retrieving the gateway nodes")
+	}
+
+	if len(gatewayNodes) > 0 {
+		status.Success("Found %d node(s) labeled as gateways", len(gatewayNodes))
+		return nil
+	}
+
+	labeled, err := nodes.LabelAnyAsGateway(clientProducer.ForKubernetes())
+	if err != nil {
+		return status.Error(err, "Error labeling a gateway node")
+	}
+
+	if !labeled {
+		status.Warning("No worker node available to label as the gateway")
+	} else {
+		status.Success("Labeled a worker node with %s=%s", constants.SubmarinerGatewayLabel, constants.TrueLabel)
+	}
+
+	return nil
+}
+
+func printApplyResults(t *topology.Topology, results []error) bool {
+	succeeded := true
+
+	fmt.Println("\nResults:")
+
+	for i := range t.Clusters {
+		if results[i] != nil {
+			succeeded = false
+
+			fmt.Printf("  %s (context %q): failed: %v\n", t.Clusters[i].ClusterID, t.Clusters[i].Context, results[i])
+		} else {
+			fmt.Printf("  %s (context %q): joined\n", t.Clusters[i].ClusterID, t.Clusters[i].Context)
+		}
+	}
+
+	return succeeded
+}
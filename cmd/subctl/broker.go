@@ -0,0 +1,113 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subctl
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/internal/cluster"
+	"github.com/submariner-io/submariner-operator/internal/exit"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	operatorClientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	brokerTokenClusterID string
+	brokerTokenTTL       time.Duration
+	brokerTokenOutput    string
+)
+
+// newBrokerCommand returns a new instance of the broker command and its subcommands.
+func newBrokerCommand() *cobra.Command {
+	brokerTokenCreateCmd := &cobra.Command{
+		Use:   "create <broker-info.subm>",
+		Short: "Create a join token for a cluster",
+		Long: "This command writes a broker information file which can be used to join a single cluster, once, before it" +
+			" expires. Unlike the file written by deploy-broker, it doesn't grant administrative access to the broker.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			status := cli.NewReporter()
+
+			err := cluster.IsValidID(brokerTokenClusterID)
+			exit.OnError(status.Error(err, "Invalid cluster ID"))
+
+			brokerInfo, err := broker.ReadInfoFromFile(args[0])
+			exit.OnError(status.Error(err, "Error loading the broker information from the given file"))
+
+			if brokerInfo.Bootstrap != nil {
+				exit.WithMessage(fmt.Sprintf("%s contains a join token, join tokens can only be created with the broker"+
+					" information file written by deploy-broker", args[0]))
+			}
+
+			brokerAdminConfig, err := brokerInfo.GetBrokerAdministratorConfig()
+			exit.OnError(status.Error(err, "Error retrieving broker admin config"))
+
+			kubeClient, err := kubernetes.NewForConfig(brokerAdminConfig)
+			exit.OnError(status.Error(err, "Error retrieving broker admin connection"))
+
+			operatorClient, err := operatorClientset.NewForConfig(brokerAdminConfig)
+			exit.OnError(status.Error(err, "Error retrieving broker admin connection"))
+
+			status.Start("Creating a join token for cluster %q", brokerTokenClusterID)
+
+			joinInfo, err := broker.NewBootstrapInfo(brokerInfo, kubeClient, operatorClient, brokerTokenClusterID, brokerTokenTTL)
+			exit.OnError(status.Error(err, "Error creating the join token"))
+
+			output := brokerTokenOutput
+			if output == "" {
+				output = fmt.Sprintf("broker-info-%s.subm", brokerTokenClusterID)
+			}
+
+			err = joinInfo.WriteToFile(output)
+			exit.OnError(status.Error(err, "Error saving the join token"))
+
+			status.Success("Saved the join token to %q, it can be used to join cluster %q until %s", output,
+				brokerTokenClusterID, joinInfo.Bootstrap.Expires.Local().Format(time.RFC1123))
+			status.End()
+		},
+	}
+
+	brokerTokenCreateCmd.Flags().StringVar(&brokerTokenClusterID, "cluster-id", "", "ID of the cluster the token allows to join")
+	_ = brokerTokenCreateCmd.MarkFlagRequired("cluster-id")
+	brokerTokenCreateCmd.Flags().DurationVar(&brokerTokenTTL, "ttl", 24*time.Hour, "how long the token remains valid")
+	brokerTokenCreateCmd.Flags().StringVarP(&brokerTokenOutput, "output", "o", "",
+		"file to write the token to (defaults to broker-info-<cluster ID>.subm)")
+
+	brokerTokenCmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage the join tokens used to connect clusters to the broker",
+	}
+	brokerTokenCmd.AddCommand(brokerTokenCreateCmd)
+
+	brokerCmd := &cobra.Command{
+		Use:   "broker",
+		Short: "Manage the broker",
+	}
+	brokerCmd.AddCommand(brokerTokenCmd)
+
+	return brokerCmd
+}
+
+func init() {
+	rootCmd.AddCommand(newBrokerCommand())
+}
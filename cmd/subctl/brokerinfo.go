@@ -0,0 +1,404 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subctl
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/submariner-io/submariner-operator/internal/envelope"
+	"github.com/submariner-io/submariner-operator/internal/exit"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+)
+
+var (
+	brokerInfoOutput         string
+	brokerInfoPassphraseFile string
+	brokerInfoRecipient      string
+	brokerInfoIdentityFile   string
+	brokerInfoSigningKeyFile string
+	brokerInfoSigners        []string
+)
+
+// newBrokerInfoCommand returns a new instance of the broker-info command and its subcommands.
+func newBrokerInfoCommand() *cobra.Command {
+	brokerInfoCmd := &cobra.Command{
+		Use:   "broker-info",
+		Short: "Inspect, encrypt and decrypt broker information files",
+		Long: "These commands manage " + broker.InfoFileName + " files. Encrypted files can be used directly by the other" +
+			" subctl commands if " + envelope.PassphraseEnvVar + " or " + envelope.IdentityEnvVar + " is set; files encrypted" +
+			" for a recipient also need their signer's verification key in " + envelope.SignersEnvVar + ".",
+	}
+
+	brokerInfoInspectCmd := &cobra.Command{
+		Use:   "inspect <file>",
+		Short: "Show the contents of a broker information file, without its secrets",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			exit.OnErrorWithMessage(inspectBrokerInfo(args[0]), "Error inspecting the broker information file")
+		},
+	}
+
+	brokerInfoEncryptCmd := &cobra.Command{
+		Use:   "encrypt <file>",
+		Short: "Encrypt a broker information file with a passphrase or for an age recipient",
+		Long: "This command encrypts a broker information file with age, using a passphrase or an age recipient, and" +
+			" signs it with a signing key, which protects it against modification and identifies who created it. Anyone who" +
+			" knows a recipient can encrypt a file for it, so files encrypted for a recipient must be signed with a signing key" +
+			" whose verification key the recipient trusts. Files encrypted with a passphrase are signed with a new key if none" +
+			" is given.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			exit.OnErrorWithMessage(encryptBrokerInfo(args[0]), "Error encrypting the broker information file")
+		},
+	}
+
+	brokerInfoNewSigningKeyCmd := &cobra.Command{
+		Use:   "new-signing-key <file>",
+		Short: "Generate a signing key for encrypted broker information files",
+		Long: "This command writes a new signing key to the given file, and shows its verification key, which must be given" +
+			" to the users of the files signed with it.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			exit.OnErrorWithMessage(newBrokerInfoSigningKey(args[0]), "Error generating the signing key")
+		},
+	}
+
+	brokerInfoDecryptCmd := &cobra.Command{
+		Use:   "decrypt <file>",
+		Short: "Decrypt an encrypted broker information file",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			exit.OnErrorWithMessage(decryptBrokerInfo(args[0]), "Error decrypting the broker information file")
+		},
+	}
+
+	for _, cmd := range []*cobra.Command{brokerInfoInspectCmd, brokerInfoDecryptCmd} {
+		cmd.Flags().StringVar(&brokerInfoIdentityFile, "identity", "",
+			"age identity file used to open files encrypted for a recipient (defaults to $"+envelope.IdentityEnvVar+")")
+		cmd.Flags().StringSliceVar(&brokerInfoSigners, "signer", nil,
+			"verification key of a trusted signer, can be specified multiple times (added to $"+envelope.SignersEnvVar+")")
+	}
+
+	for _, cmd := range []*cobra.Command{brokerInfoInspectCmd, brokerInfoEncryptCmd, brokerInfoDecryptCmd} {
+		cmd.Flags().StringVar(&brokerInfoPassphraseFile, "passphrase-file", "",
+			"file containing the passphrase (defaults to $"+envelope.PassphraseEnvVar+", otherwise it is prompted for)")
+	}
+
+	for _, cmd := range []*cobra.Command{brokerInfoEncryptCmd, brokerInfoDecryptCmd} {
+		cmd.Flags().StringVarP(&brokerInfoOutput, "output", "o", "", "file to write to (defaults to replacing the input file)")
+	}
+
+	brokerInfoEncryptCmd.Flags().StringVar(&brokerInfoRecipient, "recipient", "",
+		"age public key (age1...) to encrypt the file for, instead of using a passphrase")
+	brokerInfoEncryptCmd.Flags().StringVar(&brokerInfoSigningKeyFile, "signing-key", "",
+		"signing key file, as written by \"subctl broker-info new-signing-key\" (defaults to $"+envelope.SigningKeyEnvVar+")")
+
+	brokerInfoCmd.AddCommand(brokerInfoInspectCmd, brokerInfoEncryptCmd, brokerInfoDecryptCmd, brokerInfoNewSigningKeyCmd)
+
+	return brokerInfoCmd
+}
+
+func init() {
+	rootCmd.AddCommand(newBrokerInfoCommand())
+}
+
+func inspectBrokerInfo(fileName string) error {
+	raw, err := os.ReadFile(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "error reading file %q", fileName)
+	}
+
+	fmt.Printf("File:            %s\n", fileName)
+
+	if envelope.IsEncrypted(raw) {
+		e, err := envelope.Parse(raw)
+		if err != nil {
+			return err // nolint:wrapcheck // No need to wrap here
+		}
+
+		fmt.Printf("Format:          encrypted (version %d)\n", e.Version)
+		fmt.Printf("Created:         %s\n", e.Created.Format("2006-01-02 15:04:05 MST"))
+		fmt.Printf("Signed by:       %s\n", e.Signer)
+
+		if e.Method == envelope.MethodRecipient {
+			fmt.Printf("Encrypted for:   %s\n", e.Recipient)
+		} else {
+			fmt.Println("Encrypted with:  passphrase")
+		}
+
+		keys, err := brokerInfoKeys(e, false)
+		if err != nil {
+			return err
+		}
+
+		raw, err = envelope.Open(raw, keys)
+		if err != nil {
+			fmt.Printf("Contents:        not shown, the file can't be opened: %v\n", err)
+			return nil
+		}
+	} else {
+		fmt.Println("Format:          legacy (unencrypted)")
+	}
+
+	info, err := broker.ParseInfo(raw)
+	if err != nil {
+		return errors.WithMessagef(err, "error decoding data from file %q", fileName)
+	}
+
+	fmt.Printf("Broker URL:      %s\n", info.BrokerURL)
+	fmt.Printf("Components:      %s\n", strings.Join(info.GetComponents().Elements(), ", "))
+
+	if info.CustomDomains != nil {
+		fmt.Printf("Custom domains:  %s\n", strings.Join(*info.CustomDomains, ", "))
+	}
+
+	if info.Bootstrap != nil {
+		fmt.Printf("Join token:      for cluster %q, expires %s\n", info.Bootstrap.ClusterID,
+			info.Bootstrap.Expires.Format("2006-01-02 15:04:05 MST"))
+	} else if info.ClientToken != nil {
+		fmt.Printf("Broker token:    present (from secret %q in namespace %q)\n", info.ClientToken.Name, info.ClientToken.Namespace)
+	} else {
+		fmt.Println("Broker token:    absent")
+	}
+
+	if info.IPSecPSK != nil {
+		fmt.Println("IPsec PSK:       present")
+	} else {
+		fmt.Println("IPsec PSK:       absent")
+	}
+
+	return nil
+}
+
+func encryptBrokerInfo(fileName string) error {
+	raw, err := os.ReadFile(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "error reading file %q", fileName)
+	}
+
+	if envelope.IsEncrypted(raw) {
+		return fmt.Errorf("file %q is already encrypted", fileName)
+	}
+
+	if _, err := broker.ParseInfo(raw); err != nil {
+		return errors.WithMessagef(err, "file %q isn't a valid broker information file", fileName)
+	}
+
+	signingKey, err := brokerInfoSigningKey()
+	if err != nil {
+		return err
+	}
+
+	var sealed []byte
+
+	if brokerInfoRecipient != "" {
+		if signingKey == "" {
+			return fmt.Errorf("files encrypted for a recipient must be signed with a key the recipient trusts; use --signing-key"+
+				" or set %s", envelope.SigningKeyEnvVar)
+		}
+
+		sealed, err = envelope.SealForRecipient(raw, brokerInfoRecipient, signingKey)
+	} else {
+		if signingKey == "" {
+			// Only holders of the passphrase can create the file, so its signer doesn't need to be known in advance
+			signingKey, _, err = envelope.NewSigningKey()
+			if err != nil {
+				return err // nolint:wrapcheck // No need to wrap here
+			}
+		}
+
+		var passphrase string
+
+		passphrase, err = brokerInfoPassphrase(true)
+		if err != nil {
+			return err
+		}
+
+		sealed, err = envelope.SealWithPassphrase(raw, passphrase, signingKey)
+	}
+
+	if err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	verificationKey, err := envelope.VerificationKey(signingKey)
+	if err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	fmt.Printf("Signed with verification key %s\n", verificationKey)
+
+	return writeBrokerInfo(fileName, sealed, "Encrypted")
+}
+
+func newBrokerInfoSigningKey(fileName string) error {
+	if _, err := os.Stat(fileName); err == nil {
+		return fmt.Errorf("file %q already exists", fileName)
+	}
+
+	signingKey, verificationKey, err := envelope.NewSigningKey()
+	if err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	if err := envelope.WriteSigningKey(fileName, signingKey); err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	fmt.Printf("Wrote the signing key to %q\nVerification key: %s\n", fileName, verificationKey)
+
+	return nil
+}
+
+// brokerInfoSigningKey returns the signing key from the signing key file if one was specified, otherwise from the
+// file named in the environment, or an empty string if neither is set.
+func brokerInfoSigningKey() (string, error) {
+	fileName := brokerInfoSigningKeyFile
+	if fileName == "" {
+		fileName = os.Getenv(envelope.SigningKeyEnvVar)
+	}
+
+	if fileName == "" {
+		return "", nil
+	}
+
+	return envelope.ReadSigningKey(fileName) // nolint:wrapcheck // No need to wrap here
+}
+
+func decryptBrokerInfo(fileName string) error {
+	raw, err := os.ReadFile(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "error reading file %q", fileName)
+	}
+
+	if !envelope.IsEncrypted(raw) {
+		return fmt.Errorf("file %q isn't encrypted", fileName)
+	}
+
+	e, err := envelope.Parse(raw)
+	if err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	keys, err := brokerInfoKeys(e, true)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := envelope.Open(raw, keys)
+	if err != nil {
+		return errors.WithMessagef(err, "unable to decrypt file %q", fileName)
+	}
+
+	return writeBrokerInfo(fileName, plaintext, "Decrypted")
+}
+
+// brokerInfoKeys returns the keys needed to open the given envelope, from the command-line flags, then from the
+// environment. If prompt is true and the envelope uses a passphrase which wasn't provided, it is prompted for.
+func brokerInfoKeys(e *envelope.Envelope, prompt bool) (*envelope.Keys, error) {
+	keys, err := envelope.KeysFromEnvironment()
+	if err != nil {
+		return nil, err // nolint:wrapcheck // No need to wrap here
+	}
+
+	if brokerInfoIdentityFile != "" {
+		keys.Identities, err = envelope.ReadIdentities(brokerInfoIdentityFile)
+		if err != nil {
+			return nil, err // nolint:wrapcheck // No need to wrap here
+		}
+	}
+
+	keys.Signers = append(keys.Signers, brokerInfoSigners...)
+
+	if e.Method == envelope.MethodPassphrase && (brokerInfoPassphraseFile != "" || (keys.Passphrase == "" && prompt)) {
+		keys.Passphrase, err = brokerInfoPassphrase(false)
+	}
+
+	return keys, err
+}
+
+// brokerInfoPassphrase returns the passphrase from the passphrase file if one was specified, otherwise from the
+// environment, otherwise by prompting for it; confirm requires prompted passphrases to be entered twice.
+func brokerInfoPassphrase(confirm bool) (string, error) {
+	if brokerInfoPassphraseFile != "" {
+		data, err := os.ReadFile(brokerInfoPassphraseFile)
+		if err != nil {
+			return "", errors.Wrapf(err, "error reading passphrase file %q", brokerInfoPassphraseFile)
+		}
+
+		passphrase := strings.TrimRight(string(data), "\r\n")
+		if passphrase == "" {
+			return "", fmt.Errorf("passphrase file %q is empty", brokerInfoPassphraseFile)
+		}
+
+		return passphrase, nil
+	}
+
+	if passphrase := os.Getenv(envelope.PassphraseEnvVar); passphrase != "" {
+		return passphrase, nil
+	}
+
+	passphrase := ""
+
+	err := survey.AskOne(&survey.Password{Message: "Passphrase:"}, &passphrase, survey.WithValidator(survey.Required))
+	if isNonInteractive(err) {
+		return "", fmt.Errorf("no passphrase provided; use --passphrase-file or set %s", envelope.PassphraseEnvVar)
+	}
+
+	if err != nil || !confirm {
+		return passphrase, err // nolint:wrapcheck // No need to wrap here
+	}
+
+	confirmation := ""
+
+	if err := survey.AskOne(&survey.Password{Message: "Confirm the passphrase:"}, &confirmation); err != nil {
+		return "", err // nolint:wrapcheck // No need to wrap here
+	}
+
+	if confirmation != passphrase {
+		return "", errors.New("the passphrases don't match")
+	}
+
+	return passphrase, nil
+}
+
+func writeBrokerInfo(inputFile string, data []byte, action string) error {
+	outputFile := brokerInfoOutput
+	if outputFile == "" {
+		outputFile = inputFile
+	}
+
+	if err := os.WriteFile(outputFile, data, 0o600); err != nil {
+		return errors.Wrapf(err, "error writing to file %q", outputFile)
+	}
+
+	// WriteFile doesn't change the permissions of existing files.
+	if err := os.Chmod(outputFile, 0o600); err != nil {
+		return errors.Wrapf(err, "error setting the permissions on file %q", outputFile)
+	}
+
+	fmt.Printf("%s %q to %q\n", action, inputFile, outputFile)
+
+	return nil
+}
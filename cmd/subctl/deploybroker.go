@@ -19,6 +19,7 @@ package subctl
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -28,65 +29,85 @@ import (
 	"github.com/submariner-io/submariner-operator/internal/constants"
 	"github.com/submariner-io/submariner-operator/internal/exit"
 	"github.com/submariner-io/submariner-operator/pkg/broker"
-	"github.com/submariner-io/submariner-operator/pkg/client"
 	"github.com/submariner-io/submariner-operator/pkg/deploy"
+	"k8s.io/client-go/rest"
 )
 
 var (
 	deployflags       deploy.BrokerOptions
+	deployDryRun      dryRunOptions
 	ipsecSubmFile     string
 	defaultComponents = []string{component.ServiceDiscovery, component.Connectivity}
 )
 
-// deployBroker represents the deployBroker command.
-var deployBroker = &cobra.Command{
-	Use:   "deploy-broker",
-	Short: "Deploys the broker",
-	Run: func(cmd *cobra.Command, args []string) {
-		status := cli.NewReporter()
+// newDeployBrokerCommand returns a new instance of the deploy-broker command.
+func newDeployBrokerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-broker",
+		Short: "Deploys the broker",
+		Run: func(cmd *cobra.Command, args []string) {
+			status := cli.NewReporter()
+			exit.OnError(status.Error(deployDryRun.validate(), "Invalid dry-run options"))
 
-		config, err := restConfigProducer.ForCluster()
-		exit.OnError(status.Error(err, "Error creating REST config"))
+			var config *rest.Config
+			var err error
 
-		clientProducer, err := client.NewProducerFromRestConfig(config)
-		exit.OnError(status.Error(err, "Error creating client producer"))
+			if !deployDryRun.renderOnly {
+				config, err = restConfigProducer.ForCluster()
+				exit.OnError(status.Error(err, "Error creating REST config"))
+			}
 
-		err = deploy.Broker(&deployflags, clientProducer, status)
-		exit.OnError(err)
+			clientProducer, err := deployDryRun.producerFor(config)
+			exit.OnError(status.Error(err, "Error creating client producer"))
 
-		err = broker.WriteInfoToFile(config, deployflags.BrokerNamespace, ipsecSubmFile,
-			stringset.New(deployflags.BrokerSpec.Components...), deployflags.BrokerSpec.DefaultCustomDomains, status)
-		exit.OnError(err)
-	},
+			err = deploy.Broker(&deployflags, clientProducer, status)
+			exit.OnError(err)
+
+			if deployDryRun.enabled() {
+				status.Warning("The broker information file isn't written when only printing the resources")
+				exit.OnError(status.Error(deployDryRun.printRecorded(os.Stdout, clientProducer), "Error printing the resources"))
+
+				return
+			}
+
+			err = broker.WriteInfoToFile(broker.InfoFileName, config, deployflags.BrokerNamespace, ipsecSubmFile,
+				stringset.New(deployflags.BrokerSpec.Components...), deployflags.BrokerSpec.DefaultCustomDomains, status)
+			exit.OnError(err)
+		},
+	}
+
+	addDeployBrokerFlags(cmd, &deployflags)
+	cmd.PersistentFlags().StringVar(&ipsecSubmFile, "ipsec-psk-from", "",
+		"import IPsec PSK from existing submariner broker file, like broker-info.subm")
+	addDryRunFlags(cmd, &deployDryRun, "")
+	restConfigProducer.AddKubeContextFlag(cmd)
+
+	return cmd
 }
 
 func init() {
-	addDeployBrokerFlags()
-	restConfigProducer.AddKubeContextFlag(deployBroker)
-	rootCmd.AddCommand(deployBroker)
+	rootCmd.AddCommand(newDeployBrokerCommand())
 }
 
-func addDeployBrokerFlags() {
-	deployBroker.PersistentFlags().BoolVar(&deployflags.BrokerSpec.GlobalnetEnabled, "globalnet", false,
+func addDeployBrokerFlags(cmd *cobra.Command, options *deploy.BrokerOptions) {
+	cmd.PersistentFlags().BoolVar(&options.BrokerSpec.GlobalnetEnabled, "globalnet", false,
 		"enable support for Overlapping CIDRs in connecting clusters (default disabled)")
-	deployBroker.PersistentFlags().StringVar(&deployflags.BrokerSpec.GlobalnetCIDRRange, "globalnet-cidr-range",
+	cmd.PersistentFlags().StringVar(&options.BrokerSpec.GlobalnetCIDRRange, "globalnet-cidr-range",
 		broker.DefaultGlobalnetCIDR, "GlobalCIDR supernet range for allocating GlobalCIDRs to each cluster")
-	deployBroker.PersistentFlags().UintVar(&deployflags.BrokerSpec.DefaultGlobalnetClusterSize, "globalnet-cluster-size",
+	cmd.PersistentFlags().UintVar(&options.BrokerSpec.DefaultGlobalnetClusterSize, "globalnet-cluster-size",
 		broker.DefaultGlobalnetClusterSize, "default cluster size for GlobalCIDR allocated to each cluster (amount of global IPs)")
 
-	deployBroker.PersistentFlags().StringVar(&ipsecSubmFile, "ipsec-psk-from", "",
-		"import IPsec PSK from existing submariner broker file, like broker-info.subm")
-
-	deployBroker.PersistentFlags().StringSliceVar(&deployflags.BrokerSpec.DefaultCustomDomains, "custom-domains", nil,
+	cmd.PersistentFlags().StringSliceVar(&options.BrokerSpec.DefaultCustomDomains, "custom-domains", nil,
 		"list of domains to use for multicluster service discovery")
 
-	deployBroker.PersistentFlags().StringSliceVar(&deployflags.BrokerSpec.Components, "components", defaultComponents,
+	cmd.PersistentFlags().StringSliceVar(&options.BrokerSpec.Components, "components", defaultComponents,
 		fmt.Sprintf("The components to be installed - any of %s", strings.Join(deploy.ValidComponents, ",")))
 
-	deployBroker.PersistentFlags().StringVar(&deployflags.Repository, "repository", "", "image repository")
-	deployBroker.PersistentFlags().StringVar(&deployflags.ImageVersion, "version", "", "image version")
+	cmd.PersistentFlags().StringVar(&options.Repository, "repository", "", "image repository")
+	cmd.PersistentFlags().StringVar(&options.ImageVersion, "version", "", "image version")
 
-	deployBroker.PersistentFlags().BoolVar(&deployflags.OperatorDebug, "operator-debug", false, "enable operator debugging (verbose logging)")
-	deployBroker.PersistentFlags().StringVar(&deployflags.BrokerNamespace, "broker-namespace", constants.DefaultBrokerNamespace,
+	cmd.PersistentFlags().BoolVar(&options.OperatorDebug, "operator-debug", false, "enable operator debugging (verbose logging)")
+	cmd.PersistentFlags().BoolVar(&options.ForceCRDUpdate, "force", false, "replace CRDs installed by a newer version of Submariner")
+	cmd.PersistentFlags().StringVar(&options.BrokerNamespace, "broker-namespace", constants.DefaultBrokerNamespace,
 		"namespace for broker")
 }
@@ -0,0 +1,131 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/submariner-io/submariner-operator/pkg/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+)
+
+type dryRunOptions struct {
+	mode       string
+	renderOnly bool
+	output     string
+}
+
+// addDryRunFlags adds the --dry-run, --render-only and --output flags; the note, if any, is appended to the help of the
+// first two.
+func addDryRunFlags(cmd *cobra.Command, options *dryRunOptions, note string) {
+	cmd.Flags().StringVar(&options.mode, "dry-run", "",
+		"only print the resources which would be created or updated: \"client\" doesn't send them to the cluster,"+
+			" \"server\" submits them to the cluster in dry-run mode"+note)
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = string(client.DryRunClient)
+	cmd.Flags().BoolVar(&options.renderOnly, "render-only", false,
+		"only print the resources which would be created in an empty cluster, without accessing any cluster"+note)
+	cmd.Flags().StringVarP(&options.output, "output", "o", "yaml",
+		"output format of the resources printed with --dry-run or --render-only: yaml or json")
+}
+
+func (o *dryRunOptions) enabled() bool {
+	return o.mode != "" || o.renderOnly
+}
+
+func (o *dryRunOptions) validate() error {
+	if o.mode != "" && o.renderOnly {
+		return errors.New("--dry-run and --render-only can't be combined")
+	}
+
+	if o.mode != "" {
+		if _, err := client.ParseDryRunMode(o.mode); err != nil {
+			return err // nolint:wrapcheck // No need to wrap here
+		}
+	}
+
+	if o.output != "yaml" && o.output != "json" {
+		return fmt.Errorf("invalid output format %q, it must be yaml or json", o.output)
+	}
+
+	return nil
+}
+
+// producerFor returns the client producer for the given REST config, recording the changes if a dry run is requested.
+// The config isn't used when only rendering the resources.
+func (o *dryRunOptions) producerFor(config *rest.Config) (client.Producer, error) {
+	if o.renderOnly {
+		return client.NewRecordingProducer(nil, client.RenderOnly) // nolint:wrapcheck // No need to wrap here
+	}
+
+	if o.mode != "" {
+		mode, err := client.ParseDryRunMode(o.mode)
+		if err != nil {
+			return nil, err // nolint:wrapcheck // No need to wrap here
+		}
+
+		return client.NewRecordingProducer(config, mode) // nolint:wrapcheck // No need to wrap here
+	}
+
+	return client.NewProducerFromRestConfig(config) // nolint:wrapcheck // No need to wrap here
+}
+
+// printRecorded prints the resources recorded by the given producer, if it records them.
+func (o *dryRunOptions) printRecorded(out io.Writer, clientProducer client.Producer) error {
+	recording, ok := clientProducer.(*client.RecordingProducer)
+	if !ok {
+		return nil
+	}
+
+	objects := recording.Objects()
+
+	if o.output == "json" {
+		list := &unstructured.UnstructuredList{Object: map[string]interface{}{"apiVersion": "v1", "kind": "List"}}
+		for _, obj := range objects {
+			list.Items = append(list.Items, *obj)
+		}
+
+		data, err := json.MarshalIndent(list, "", "    ")
+		if err != nil {
+			return errors.Wrap(err, "error marshalling the resources")
+		}
+
+		_, err = fmt.Fprintln(out, string(data))
+
+		return errors.Wrap(err, "error printing the resources")
+	}
+
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return errors.Wrap(err, "error marshalling the resources")
+		}
+
+		if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return errors.Wrap(err, "error printing the resources")
+		}
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ package subctl
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -35,104 +36,138 @@ import (
 	"github.com/submariner-io/submariner-operator/pkg/join"
 	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 var (
 	joinFlags         join.Options
+	joinDryRun        dryRunOptions
 	labelGateway      bool
 	ignoredColorCodes string
 )
 
-var joinCmd = &cobra.Command{
-	Use:     "join",
-	Short:   "Connect a cluster to an existing broker",
-	Args:    cobra.MaximumNArgs(1),
-	PreRunE: restConfigProducer.CheckVersionMismatch,
-	Run: func(cmd *cobra.Command, args []string) {
-		status := cli.NewReporter()
-		checkArgumentPassed(args)
+// newJoinCommand returns a new instance of the join command.
+func newJoinCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join",
+		Short: "Connect a cluster to an existing broker",
+		Args:  cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := joinDryRun.validate(); err != nil {
+				return err
+			}
 
-		brokerInfo, err := broker.ReadInfoFromFile(args[0])
-		exit.OnError(status.Error(err, "Error loading the broker information from the given file"))
-		status.Success("%s indicates broker is at %s", args[0], brokerInfo.BrokerURL)
+			if joinDryRun.renderOnly {
+				return nil
+			}
 
-		determineClusterID(status)
+			return restConfigProducer.CheckVersionMismatch(cmd, args)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			status := cli.NewReporter()
+			checkArgumentPassed(args)
 
-		clientConfig, err := restConfigProducer.ForCluster()
-		exit.OnError(status.Error(err, "Error creating the REST config"))
+			brokerInfo, err := broker.ReadInfoFromFile(args[0])
+			exit.OnError(status.Error(err, "Error loading the broker information from the given file"))
+			status.Success("%s indicates broker is at %s", args[0], brokerInfo.BrokerURL)
 
-		clientProducer, err := client.NewProducerFromRestConfig(clientConfig)
-		exit.OnError(status.Error(err, "Error creating the client producer"))
+			if brokerInfo.Bootstrap != nil && joinFlags.ClusterID == "" {
+				joinFlags.ClusterID = brokerInfo.Bootstrap.ClusterID
+			}
 
-		networkDetails := getNetworkDetails(clientProducer, status)
-		determinePodCIDR(networkDetails, status)
-		determineServiceCIDR(networkDetails, status)
+			determineClusterID(status)
 
-		if brokerInfo.IsConnectivityEnabled() && labelGateway {
-			possiblyLabelGateway(clientProducer.ForKubernetes(), status)
-		}
+			var clientConfig *rest.Config
+			if !joinDryRun.renderOnly {
+				clientConfig, err = restConfigProducer.ForCluster()
+				exit.OnError(status.Error(err, "Error creating the REST config"))
+			}
 
-		if joinFlags.CustomDomains == nil && brokerInfo.CustomDomains != nil {
-			joinFlags.CustomDomains = *brokerInfo.CustomDomains
-		}
+			clientProducer, err := joinDryRun.producerFor(clientConfig)
+			exit.OnError(status.Error(err, "Error creating the client producer"))
 
-		err = join.ClusterToBroker(brokerInfo, &joinFlags, clientProducer, status)
-		exit.OnError(err)
-	},
-}
+			networkDetails := getNetworkDetails(clientProducer, status)
+			determinePodCIDR(networkDetails, status)
+			determineServiceCIDR(networkDetails, status)
 
-func init() {
-	addJoinFlags(joinCmd)
-	restConfigProducer.AddKubeContextFlag(joinCmd)
-	rootCmd.AddCommand(joinCmd)
-}
+			if brokerInfo.IsConnectivityEnabled() && labelGateway {
+				if joinDryRun.enabled() {
+					status.Warning("Gateway nodes aren't labeled when only printing the resources")
+				} else {
+					possiblyLabelGateway(clientProducer.ForKubernetes(), status)
+				}
+			}
+
+			if joinFlags.CustomDomains == nil && brokerInfo.CustomDomains != nil {
+				joinFlags.CustomDomains = *brokerInfo.CustomDomains
+			}
 
-func addJoinFlags(cmd *cobra.Command) {
-	cmd.Flags().StringVar(&joinFlags.ClusterID, "clusterid", "", "cluster ID used to identify the tunnels")
-	cmd.Flags().StringVar(&joinFlags.ServiceCIDR, "servicecidr", "", "service CIDR")
-	cmd.Flags().StringVar(&joinFlags.ClusterCIDR, "clustercidr", "", "cluster CIDR")
-	cmd.Flags().StringVar(&joinFlags.Repository, "repository", "", "image repository")
-	cmd.Flags().StringVar(&joinFlags.ImageVersion, "version", "", "image version")
+			err = join.ClusterToBroker(brokerInfo, &joinFlags, clientProducer, status)
+			exit.OnError(err)
+
+			exit.OnError(status.Error(joinDryRun.printRecorded(os.Stdout, clientProducer), "Error printing the resources"))
+		},
+	}
+
+	addJoinFlags(cmd, &joinFlags)
 	cmd.Flags().StringVar(&ignoredColorCodes, "colorcodes", "", "color codes")
 	_ = cmd.Flags().MarkDeprecated("colorcodes", "--colorcodes has no effect and is deprecated")
-	cmd.Flags().IntVar(&joinFlags.NATTPort, "nattport", 4500, "IPsec NATT port")
-	cmd.Flags().IntVar(&joinFlags.IKEPort, "ikeport", 500, "IPsec IKE port")
-	cmd.Flags().BoolVar(&joinFlags.NATTraversal, "natt", true, "enable NAT traversal for IPsec")
+	cmd.Flags().BoolVar(&labelGateway, "label-gateway", true, "label gateways if necessary")
+	addDryRunFlags(cmd, &joinDryRun, "; when joining with a join token, it isn't used up and the printed broker Secret"+
+		" holds a placeholder token")
+	restConfigProducer.AddKubeContextFlag(cmd)
+
+	return cmd
+}
 
-	cmd.Flags().BoolVar(&joinFlags.PreferredServer, "preferred-server", false,
+func init() {
+	rootCmd.AddCommand(newJoinCommand())
+}
+
+func addJoinFlags(cmd *cobra.Command, options *join.Options) {
+	cmd.Flags().StringVar(&options.ClusterID, "clusterid", "", "cluster ID used to identify the tunnels")
+	cmd.Flags().StringVar(&options.ServiceCIDR, "servicecidr", "", "service CIDR")
+	cmd.Flags().StringVar(&options.ClusterCIDR, "clustercidr", "", "cluster CIDR")
+	cmd.Flags().StringVar(&options.Repository, "repository", "", "image repository")
+	cmd.Flags().StringVar(&options.ImageVersion, "version", "", "image version")
+	cmd.Flags().IntVar(&options.NATTPort, "nattport", 4500, "IPsec NATT port")
+	cmd.Flags().IntVar(&options.IKEPort, "ikeport", 500, "IPsec IKE port")
+	cmd.Flags().BoolVar(&options.NATTraversal, "natt", true, "enable NAT traversal for IPsec")
+
+	cmd.Flags().BoolVar(&options.PreferredServer, "preferred-server", false,
 		"enable this cluster as a preferred server for dataplane connections")
 
-	cmd.Flags().BoolVar(&joinFlags.LoadBalancerEnabled, "load-balancer", false,
+	cmd.Flags().BoolVar(&options.LoadBalancerEnabled, "load-balancer", false,
 		"enable automatic LoadBalancer in front of the gateways")
 
-	cmd.Flags().BoolVar(&joinFlags.ForceUDPEncaps, "force-udp-encaps", false, "force UDP encapsulation for IPSec")
+	cmd.Flags().BoolVar(&options.ForceUDPEncaps, "force-udp-encaps", false, "force UDP encapsulation for IPSec")
 
-	cmd.Flags().BoolVar(&joinFlags.IPSecDebug, "ipsec-debug", false, "enable IPsec debugging (verbose logging)")
-	cmd.Flags().BoolVar(&joinFlags.SubmarinerDebug, "pod-debug", false,
+	cmd.Flags().BoolVar(&options.IPSecDebug, "ipsec-debug", false, "enable IPsec debugging (verbose logging)")
+	cmd.Flags().BoolVar(&options.SubmarinerDebug, "pod-debug", false,
 		"enable Submariner pod debugging (verbose logging in the deployed pods)")
-	cmd.Flags().BoolVar(&joinFlags.OperatorDebug, "operator-debug", false, "enable operator debugging (verbose logging)")
-	cmd.Flags().BoolVar(&labelGateway, "label-gateway", true, "label gateways if necessary")
-	cmd.Flags().StringVar(&joinFlags.CableDriver, "cable-driver", "", "cable driver implementation")
-	cmd.Flags().UintVar(&joinFlags.GlobalnetClusterSize, "globalnet-cluster-size", 0,
+	cmd.Flags().BoolVar(&options.OperatorDebug, "operator-debug", false, "enable operator debugging (verbose logging)")
+	cmd.Flags().BoolVar(&options.ForceCRDUpdate, "force", false, "replace CRDs installed by a newer version of Submariner")
+	cmd.Flags().StringVar(&options.CableDriver, "cable-driver", "", "cable driver implementation")
+	cmd.Flags().UintVar(&options.GlobalnetClusterSize, "globalnet-cluster-size", 0,
 		"cluster size for GlobalCIDR allocated to this cluster (amount of global IPs)")
-	cmd.Flags().StringVar(&joinFlags.GlobalnetCIDR, "globalnet-cidr", "",
+	cmd.Flags().StringVar(&options.GlobalnetCIDR, "globalnet-cidr", "",
 		"GlobalCIDR to be allocated to the cluster")
-	cmd.Flags().StringSliceVar(&joinFlags.CustomDomains, "custom-domains", nil,
+	cmd.Flags().StringSliceVar(&options.CustomDomains, "custom-domains", nil,
 		"list of domains to use for multicluster service discovery")
-	cmd.Flags().StringSliceVar(&joinFlags.ImageOverrideArr, "image-override", nil,
+	cmd.Flags().StringSliceVar(&options.ImageOverrideArr, "image-override", nil,
 		"override component image")
-	cmd.Flags().BoolVar(&joinFlags.HealthCheckEnabled, "health-check", true,
+	cmd.Flags().BoolVar(&options.HealthCheckEnabled, "health-check", true,
 		"enable Gateway health check")
-	cmd.Flags().Uint64Var(&joinFlags.HealthCheckInterval, "health-check-interval", 1,
+	cmd.Flags().Uint64Var(&options.HealthCheckInterval, "health-check-interval", 1,
 		"interval in seconds between health check packets")
-	cmd.Flags().Uint64Var(&joinFlags.HealthCheckMaxPacketLossCount, "health-check-max-packet-loss-count", 5,
+	cmd.Flags().Uint64Var(&options.HealthCheckMaxPacketLossCount, "health-check-max-packet-loss-count", 5,
 		"maximum number of packets lost before the connection is marked as down")
-	cmd.Flags().BoolVar(&joinFlags.GlobalnetEnabled, "globalnet", true,
+	cmd.Flags().BoolVar(&options.GlobalnetEnabled, "globalnet", true,
 		"enable/disable Globalnet for this cluster")
-	cmd.Flags().StringVar(&joinFlags.CoreDNSCustomConfigMap, "coredns-custom-configmap", "",
+	cmd.Flags().StringVar(&options.CoreDNSCustomConfigMap, "coredns-custom-configmap", "",
 		"Name of the custom CoreDNS configmap to configure forwarding to lighthouse. It should be in "+
 			"<namespace>/<name> format where <namespace> is optional and defaults to kube-system")
-	cmd.Flags().BoolVar(&joinFlags.IgnoreRequirements, "ignore-requirements", false, "ignore requirement failures (unsupported)")
+	cmd.Flags().BoolVar(&options.IgnoreRequirements, "ignore-requirements", false, "ignore requirement failures (unsupported)")
 }
 
 func possiblyLabelGateway(kubeClient kubernetes.Interface, status reporter.Interface) {
@@ -280,6 +315,10 @@ func determineClusterID(status reporter.Interface) {
 }
 
 func getNetworkDetails(clientProducer client.Producer, status reporter.Interface) *network.ClusterNetwork {
+	if client.ModeOf(clientProducer) == client.RenderOnly {
+		return nil
+	}
+
 	status.Start("Discovering network details")
 
 	networkDetails, err := network.Discover(clientProducer.ForDynamic(), clientProducer.ForKubernetes(), clientProducer.ForOperator(),
@@ -293,7 +332,8 @@ func getNetworkDetails(clientProducer client.Producer, status reporter.Interface
 	status.End()
 
 	if networkDetails != nil {
-		networkDetails.Show()
+		// Keep the standard output for the resources printed in dry-run mode
+		networkDetails.ShowTo(os.Stderr)
 	}
 
 	return networkDetails
@@ -43,6 +43,16 @@ var rootCmd = &cobra.Command{
 	Short: "An installer for Submariner",
 }
 
+// NewReleasedCommands returns new instances of the commands which the released subctl, built from pkg/subctl, takes
+// from this tree; they can't be shared with this tree's root command since a cobra command only has a single parent.
+// The instances bind their flags to the same variables, which is fine since a process only ever runs one root command.
+func NewReleasedCommands() []*cobra.Command {
+	return []*cobra.Command{
+		newDeployBrokerCommand(), newJoinCommand(), newBrokerCommand(), newBrokerInfoCommand(), newUninstallCommand(),
+		newApplyCommand(),
+	}
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -0,0 +1,102 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subctl
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/internal/constants"
+	"github.com/submariner-io/submariner-operator/internal/exit"
+	"github.com/submariner-io/submariner-operator/pkg/client"
+	"github.com/submariner-io/submariner-operator/pkg/uninstall"
+)
+
+var (
+	uninstallOptions uninstall.Options
+	uninstallYes     bool
+)
+
+// newUninstallCommand returns a new instance of the uninstall command.
+func newUninstallCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall",
+		Short: "Uninstall Submariner and its components",
+		Long: "This command uninstalls Submariner and its components from the cluster: the Submariner, ServiceDiscovery" +
+			" and Broker resources, the operator, its RBAC resources, the gateway node labels and the namespaces." +
+			" The CRDs are only removed with --delete-crds.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			status := cli.NewReporter()
+
+			config, err := restConfigProducer.ForCluster()
+			exit.OnError(status.Error(err, "Error creating the REST config"))
+
+			clientProducer, err := client.NewProducerFromRestConfig(config)
+			exit.OnError(status.Error(err, "Error creating the client producer"))
+
+			if !uninstallOptions.DryRun && !uninstallYes && !confirmUninstall() {
+				return
+			}
+
+			err = uninstall.All(&uninstallOptions, clientProducer, status)
+			exit.OnError(err)
+		},
+	}
+
+	cmd.Flags().StringVar(&uninstallOptions.OperatorNamespace, "namespace", constants.OperatorNamespace,
+		"namespace in which Submariner is installed")
+	cmd.Flags().StringVar(&uninstallOptions.BrokerNamespace, "broker-namespace", constants.DefaultBrokerNamespace,
+		"namespace of the broker, if it is deployed in this cluster")
+	cmd.Flags().BoolVar(&uninstallOptions.DeleteCRDs, "delete-crds", false,
+		"also delete the Submariner CRDs, removing all Submariner resources from the cluster")
+	cmd.Flags().BoolVar(&uninstallOptions.DryRun, "dry-run", false,
+		"only report the resources which would be removed")
+	cmd.Flags().DurationVar(&uninstallOptions.Timeout, "timeout", uninstall.DefaultTimeout,
+		"how long to wait for the operator to uninstall the Submariner components")
+	cmd.Flags().BoolVarP(&uninstallYes, "yes", "y", false, "automatically answer yes to confirmation prompts")
+	restConfigProducer.AddKubeContextFlag(cmd)
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newUninstallCommand())
+}
+
+func confirmUninstall() bool {
+	confirmed := false
+
+	err := survey.AskOne(&survey.Confirm{
+		Message: "This will completely uninstall Submariner from the cluster. Are you sure you want to continue?",
+	}, &confirmed)
+	if isNonInteractive(err) {
+		exit.WithMessage("subctl is running non-interactively and cannot prompt for confirmation; specify --yes to uninstall")
+	}
+
+	exit.OnErrorWithMessage(err, "Prompt failure:")
+
+	if !confirmed {
+		fmt.Println("Uninstall cancelled")
+	}
+
+	return confirmed
+}
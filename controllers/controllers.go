@@ -35,12 +35,14 @@ func AddToManager(mgr manager.Manager) error {
 	operatorClient, _ := operatorclient.NewClient(mgr.GetConfig())
 
 	if err := submariner.NewReconciler(&submariner.Config{
-		Client:     mgr.GetClient(),
-		RestConfig: mgr.GetConfig(),
-		Scheme:     mgr.GetScheme(),
-		KubeClient: kubeClient,
-		SubmClient: submarinerclientset.NewForConfigOrDie(mgr.GetConfig()),
-		DynClient:  dynamic.NewForConfigOrDie(mgr.GetConfig()),
+		Client:                     mgr.GetClient(),
+		RestConfig:                 mgr.GetConfig(),
+		Scheme:                     mgr.GetScheme(),
+		KubeClient:                 kubeClient,
+		SubmClient:                 submarinerclientset.NewForConfigOrDie(mgr.GetConfig()),
+		DynClient:                  dynamic.NewForConfigOrDie(mgr.GetConfig()),
+		EventRecorder:              mgr.GetEventRecorderFor("submariner-controller"),
+		NetworkRediscoveryInterval: submariner.DefaultNetworkRediscoveryInterval,
 	}).SetupWithManager(mgr); err != nil {
 		return err
 	}
@@ -51,5 +53,6 @@ func AddToManager(mgr manager.Manager) error {
 		Scheme:         mgr.GetScheme(),
 		KubeClient:     kubeClient,
 		OperatorClient: operatorClient,
+		EventRecorder:  mgr.GetEventRecorderFor("servicediscovery-controller"),
 	}).SetupWithManager(mgr)
 }
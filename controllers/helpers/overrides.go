@@ -0,0 +1,99 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ApplyComponentOverrides merges the user-provided overrides for a component into the pod template generated for it.
+// Tolerations are added to the defaults, node selector entries and annotations are merged (with the overrides
+// winning), each type of affinity which is set replaces the default, and extra labels never replace the labels
+// generated by the operator since those are used in selectors.
+func ApplyComponentOverrides(template *corev1.PodTemplateSpec, overrides *v1alpha1.ComponentOverrides) {
+	if overrides == nil {
+		return
+	}
+
+	overrides = overrides.DeepCopy()
+	podSpec := &template.Spec
+
+	if overrides.Resources != nil {
+		for i := range podSpec.InitContainers {
+			podSpec.InitContainers[i].Resources = *overrides.Resources.DeepCopy()
+		}
+
+		for i := range podSpec.Containers {
+			podSpec.Containers[i].Resources = *overrides.Resources.DeepCopy()
+		}
+	}
+
+	podSpec.Tolerations = append(podSpec.Tolerations, overrides.Tolerations...)
+	podSpec.NodeSelector = mergeStringMaps(podSpec.NodeSelector, overrides.NodeSelector, true)
+
+	if overrides.Affinity != nil {
+		affinity := &corev1.Affinity{}
+		if podSpec.Affinity != nil {
+			affinity = podSpec.Affinity.DeepCopy()
+		}
+
+		if overrides.Affinity.NodeAffinity != nil {
+			affinity.NodeAffinity = overrides.Affinity.NodeAffinity
+		}
+
+		if overrides.Affinity.PodAffinity != nil {
+			affinity.PodAffinity = overrides.Affinity.PodAffinity
+		}
+
+		if overrides.Affinity.PodAntiAffinity != nil {
+			affinity.PodAntiAffinity = overrides.Affinity.PodAntiAffinity
+		}
+
+		podSpec.Affinity = affinity
+	}
+
+	if overrides.PriorityClassName != "" {
+		podSpec.PriorityClassName = overrides.PriorityClassName
+	}
+
+	template.Labels = mergeStringMaps(template.Labels, overrides.Labels, false)
+	template.Annotations = mergeStringMaps(template.Annotations, overrides.Annotations, true)
+}
+
+// mergeStringMaps returns a new map containing the entries of both maps; existing entries are only replaced if
+// replace is true. The existing map isn't modified since the generated resources share label maps with selectors.
+func mergeStringMaps(existing, extra map[string]string, replace bool) map[string]string {
+	if len(extra) == 0 {
+		return existing
+	}
+
+	merged := make(map[string]string, len(existing)+len(extra))
+	for k, v := range existing {
+		merged[k] = v
+	}
+
+	for k, v := range extra {
+		if _, found := merged[k]; !found || replace {
+			merged[k] = v
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,132 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// CredentialsHashAnnotation is set on pod templates to a hash of the credentials they mount, so that the pods are rolled
+// when the credentials are rotated.
+const CredentialsHashAnnotation = "submariner.io/credentials-hash"
+
+// DefaultBrokerSecretName is the name of the Secret used to hold inline broker credentials when the resource being migrated
+// doesn't reference one.
+const DefaultBrokerSecretName = "submariner-broker-secret"
+
+// BrokerSecretData returns the broker Secret entries corresponding to inline credentials, with the CA base64-encoded as
+// it is in the resource specs.
+func BrokerSecretData(token, ca string) (map[string][]byte, error) {
+	data := map[string][]byte{}
+
+	if token != "" {
+		data[broker.SecretTokenKey] = []byte(token)
+	}
+
+	if ca != "" {
+		decoded, err := base64.StdEncoding.DecodeString(ca)
+		if err != nil {
+			return nil, errors.Wrap(err, "error decoding the inline broker CA")
+		}
+
+		data[broker.SecretCAKey] = decoded
+	}
+
+	return data, nil
+}
+
+// EnsureSecretData creates the given Secret if necessary and adds the entries it doesn't already contain. Existing entries
+// are left untouched since they are kept up-to-date from the broker.
+func EnsureSecretData(ctx context.Context, client controllerClient.Client, namespace, name string, data map[string][]byte) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+	}}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, client, secret, func() error {
+		if secret.Type == "" {
+			secret.Type = corev1.SecretTypeOpaque
+		}
+
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+
+		for k, v := range data {
+			if _, found := secret.Data[k]; !found {
+				secret.Data[k] = v
+			}
+		}
+
+		return nil
+	})
+
+	return errors.Wrapf(err, "error creating or updating Secret %s/%s", namespace, name)
+}
+
+// SecretsHash returns a hash of the contents of the given Secrets; missing Secrets and empty names are skipped.
+func SecretsHash(ctx context.Context, client controllerClient.Client, namespace string, names ...string) (string, error) {
+	hash := sha256.New()
+
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+
+		err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return "", errors.Wrapf(err, "error retrieving Secret %s/%s", namespace, name)
+		}
+
+		keys := make([]string, 0, len(secret.Data))
+		for k := range secret.Data {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		hash.Write([]byte(name))
+
+		for _, k := range keys {
+			hash.Write([]byte(k))
+			hash.Write(secret.Data[k])
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
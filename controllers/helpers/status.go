@@ -0,0 +1,254 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WorkloadStatus summarizes the rollout state of a component's DaemonSet or Deployment.
+type WorkloadStatus struct {
+	Name                      string
+	Desired                   int32
+	Ready                     int32
+	Updated                   int32
+	GenerationObserved        bool
+	NonReadyContainerStates   []corev1.ContainerState
+	MismatchedContainerImages bool
+}
+
+// Container waiting reasons which are expected while pods are starting up.
+var transientWaitingReasons = map[string]bool{
+	"ContainerCreating": true,
+	"PodInitializing":   true,
+}
+
+func NewDaemonSetWorkloadStatus(daemonSet *appsv1.DaemonSet, status *v1alpha1.DaemonSetStatus) WorkloadStatus {
+	workload := WorkloadStatus{
+		Name:               daemonSet.Name,
+		Desired:            daemonSet.Status.DesiredNumberScheduled,
+		Ready:              daemonSet.Status.NumberReady,
+		Updated:            daemonSet.Status.UpdatedNumberScheduled,
+		GenerationObserved: daemonSet.Status.ObservedGeneration >= daemonSet.Generation,
+	}
+
+	if status != nil {
+		workload.MismatchedContainerImages = status.MismatchedContainerImages
+		if status.NonReadyContainerStates != nil {
+			workload.NonReadyContainerStates = *status.NonReadyContainerStates
+		}
+	}
+
+	return workload
+}
+
+func NewDeploymentWorkloadStatus(deployment *appsv1.Deployment, mismatchedContainerImages bool,
+	nonReadyContainerStates []corev1.ContainerState) WorkloadStatus {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	return WorkloadStatus{
+		Name:                      deployment.Name,
+		Desired:                   desired,
+		Ready:                     deployment.Status.ReadyReplicas,
+		Updated:                   deployment.Status.UpdatedReplicas,
+		GenerationObserved:        deployment.Status.ObservedGeneration >= deployment.Generation,
+		NonReadyContainerStates:   nonReadyContainerStates,
+		MismatchedContainerImages: mismatchedContainerImages,
+	}
+}
+
+// IsRolledOut returns true if all the workload's pods have been updated to its current template and are ready.
+func (w *WorkloadStatus) IsRolledOut() bool {
+	return !w.isProgressing() && w.Ready >= w.Desired
+}
+
+func (w *WorkloadStatus) isProgressing() bool {
+	return !w.GenerationObserved || w.Updated < w.Desired
+}
+
+func (w *WorkloadStatus) notReadyReason() string {
+	if w.Desired == 0 {
+		return fmt.Sprintf("%s has no scheduled pods", w.Name)
+	}
+
+	if w.Ready < w.Desired {
+		return fmt.Sprintf("%s has %d of %d pods ready", w.Name, w.Ready, w.Desired)
+	}
+
+	return ""
+}
+
+func (w *WorkloadStatus) failureReasons() []string {
+	reasons := []string{}
+
+	for i := range w.NonReadyContainerStates {
+		state := &w.NonReadyContainerStates[i]
+
+		if state.Waiting != nil && !transientWaitingReasons[state.Waiting.Reason] {
+			reasons = append(reasons, fmt.Sprintf("%s container waiting: %s %s", w.Name, state.Waiting.Reason,
+				state.Waiting.Message))
+		} else if state.Terminated != nil {
+			reasons = append(reasons, fmt.Sprintf("%s container terminated: %s (exit code %d)", w.Name,
+				state.Terminated.Reason, state.Terminated.ExitCode))
+		}
+	}
+
+	// Different images are expected while a new version is being rolled out
+	if w.MismatchedContainerImages && !w.isProgressing() {
+		reasons = append(reasons, fmt.Sprintf("%s pods are running different container images", w.Name))
+	}
+
+	return reasons
+}
+
+// SetWorkloadConditions sets the Ready, Progressing and Degraded conditions based on the given workloads.
+func SetWorkloadConditions(conditions *[]metav1.Condition, workloads []WorkloadStatus) {
+	var progressing, notReady, failures []string
+
+	for i := range workloads {
+		if workloads[i].isProgressing() {
+			progressing = append(progressing, workloads[i].Name)
+		}
+
+		if reason := workloads[i].notReadyReason(); reason != "" {
+			notReady = append(notReady, reason)
+		}
+
+		failures = append(failures, workloads[i].failureReasons()...)
+	}
+
+	if len(progressing) > 0 {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionProgressing,
+			Status:  metav1.ConditionTrue,
+			Reason:  "RollingOut",
+			Message: "Rolling out " + strings.Join(progressing, ", "),
+		})
+	} else {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionProgressing,
+			Status:  metav1.ConditionFalse,
+			Reason:  "RolloutComplete",
+			Message: "All components are rolled out",
+		})
+	}
+
+	if len(failures) > 0 {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionDegraded,
+			Status:  metav1.ConditionTrue,
+			Reason:  "ComponentsFailing",
+			Message: strings.Join(failures, "; "),
+		})
+	} else {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionDegraded,
+			Status:  metav1.ConditionFalse,
+			Reason:  "AsExpected",
+			Message: "No component failures detected",
+		})
+	}
+
+	if len(notReady) > 0 || len(progressing) > 0 {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  "ComponentsNotReady",
+			Message: strings.Join(append(notReady, progressing...), "; "),
+		})
+	} else {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  "AllComponentsReady",
+			Message: "All components are ready",
+		})
+	}
+}
+
+// SetReconcileFailedConditions marks the resource as degraded and not ready following a reconciliation error.
+func SetReconcileFailedConditions(conditions *[]metav1.Condition, err error) {
+	for _, conditionType := range []string{v1alpha1.ConditionReady, v1alpha1.ConditionDegraded} {
+		status := metav1.ConditionFalse
+		if conditionType == v1alpha1.ConditionDegraded {
+			status = metav1.ConditionTrue
+		}
+
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    conditionType,
+			Status:  status,
+			Reason:  "ReconcileFailed",
+			Message: err.Error(),
+		})
+	}
+}
+
+// CheckPodContainers checks the containers in the pods matching the given selector, and returns whether they
+// are running different images, along with the states of the containers which haven't started.
+func CheckPodContainers(ctx context.Context, clnt controllerClient.Reader, namespace string,
+	labelSelector *metav1.LabelSelector) (bool, []corev1.ContainerState, error) {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, nil, errors.Wrap(err, "error creating label selector")
+	}
+
+	pods := &corev1.PodList{}
+
+	err = clnt.List(ctx, pods, controllerClient.InNamespace(namespace), controllerClient.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return false, nil, errors.Wrap(err, "error listing pods")
+	}
+
+	var containerImageManifest *string
+
+	mismatchedContainerImages := false
+	nonReadyContainerStates := []corev1.ContainerState{}
+
+	for i := range pods.Items {
+		for j := range pods.Items[i].Status.ContainerStatuses {
+			containerStatus := &pods.Items[i].Status.ContainerStatuses[j]
+			if containerImageManifest == nil {
+				containerImageManifest = &(containerStatus.ImageID)
+			} else if *containerImageManifest != containerStatus.ImageID {
+				// Container mismatch
+				mismatchedContainerImages = true
+			}
+
+			if containerStatus.Started == nil || !*containerStatus.Started {
+				// Not (yet) ready
+				nonReadyContainerStates = append(nonReadyContainerStates, containerStatus.State)
+			}
+		}
+	}
+
+	return mismatchedContainerImages, nonReadyContainerStates, nil
+}
@@ -0,0 +1,85 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	SubmarinerController       = "submariner"
+	ServiceDiscoveryController = "servicediscovery"
+	BrokerController           = "broker"
+
+	controllerLabel = "controller"
+)
+
+var (
+	reconcileDurationHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "submariner_operator_reconcile_duration_seconds",
+			Help: "Duration of reconciliations (by controller)",
+		},
+		[]string{controllerLabel},
+	)
+	reconcileErrorsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "submariner_operator_reconcile_errors_total",
+			Help: "Number of failed reconciliations (by controller)",
+		},
+		[]string{controllerLabel},
+	)
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(reconcileDurationHistogram, reconcileErrorsCounter)
+}
+
+type instrumentedReconciler struct {
+	controller string
+	reconciler reconcile.Reconciler
+}
+
+// InstrumentReconciler returns a reconciler recording the duration and the errors of the given reconciler's
+// reconciliations, labelled with the given controller name.
+func InstrumentReconciler(controller string, reconciler reconcile.Reconciler) reconcile.Reconciler {
+	return &instrumentedReconciler{
+		controller: controller,
+		reconciler: reconciler,
+	}
+}
+
+func (r *instrumentedReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	start := time.Now()
+
+	result, err := r.reconciler.Reconcile(ctx, request)
+
+	reconcileDurationHistogram.WithLabelValues(r.controller).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		reconcileErrorsCounter.WithLabelValues(r.controller).Inc()
+	}
+
+	return result, err // nolint:wrapcheck // No need to wrap here
+}
@@ -64,6 +64,7 @@ func (r *Reconciler) doCleanup(ctx context.Context, instance *operatorv1alpha1.S
 
 	components := []*uninstall.Component{
 		{
+			Name: "LighthouseAgent",
 			Resource: &appsv1.Deployment{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      names.ServiceDiscoveryComponent,
@@ -81,11 +82,26 @@ func (r *Reconciler) doCleanup(ctx context.Context, instance *operatorv1alpha1.S
 		Log:        log,
 	}
 
-	requeue, _, err := uninstallInfo.Run(ctx)
+	if instance.Spec.UninstallTimeout != nil {
+		uninstallInfo.Timeout = instance.Spec.UninstallTimeout.Duration
+	}
+
+	initialStatus := instance.Status.DeepCopy()
+
+	requeue, timedOut, err := uninstallInfo.Run(ctx)
+
+	uninstallInfo.SetStatusConditions(&instance.Status.Conditions)
+	r.updateStatus(ctx, instance, initialStatus, log)
+
 	if err != nil {
 		return reconcile.Result{}, err // nolint:wrapcheck // No need to wrap
 	}
 
+	if timedOut {
+		// Keep the finalizer so that the uninstall can be retried with a longer timeout.
+		return reconcile.Result{}, nil
+	}
+
 	if requeue {
 		return reconcile.Result{RequeueAfter: time.Millisecond * 100}, nil
 	}
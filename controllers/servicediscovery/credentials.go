@@ -0,0 +1,63 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	submarinerv1alpha1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/controllers/helpers"
+)
+
+// migrateInlineCredentials moves the broker credentials which older versions of subctl stored in the ServiceDiscovery spec
+// into the Secret referenced by the spec, and clears the inline values.
+func (r *Reconciler) migrateInlineCredentials(ctx context.Context, instance *submarinerv1alpha1.ServiceDiscovery,
+	reqLogger logr.Logger) error {
+	spec := &instance.Spec
+
+	if spec.BrokerK8sApiServerToken == "" && spec.BrokerK8sCA == "" {
+		return nil
+	}
+
+	data, err := helpers.BrokerSecretData(spec.BrokerK8sApiServerToken, spec.BrokerK8sCA)
+	if err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	if spec.BrokerK8sSecret == "" {
+		spec.BrokerK8sSecret = helpers.DefaultBrokerSecretName
+	}
+
+	if err := helpers.EnsureSecretData(ctx, r.config.Client, instance.Namespace, spec.BrokerK8sSecret, data); err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	spec.BrokerK8sApiServerToken = ""
+	spec.BrokerK8sCA = ""
+
+	if err := r.config.Client.Update(ctx, instance); err != nil {
+		return errors.Wrap(err, "error removing the inline credentials from the ServiceDiscovery resource")
+	}
+
+	reqLogger.Info("Moved the inline broker credentials into a Secret", "BrokerSecret", spec.BrokerK8sSecret)
+
+	return nil
+}
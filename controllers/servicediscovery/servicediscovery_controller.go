@@ -49,16 +49,22 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	controllerClient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 var log = logf.Log.WithName("controller_servicediscovery")
 
+// ReasonCoreDNSConfigUpdated is the reason for the events recorded when the DNS configuration is updated.
+const ReasonCoreDNSConfigUpdated = "CoreDNSConfigUpdated"
+
 const (
 	componentName                 = "submariner-lighthouse"
 	lighthouseCoreDNSName         = "submariner-lighthouse-coredns"
@@ -78,6 +84,7 @@ type Config struct {
 	Scheme         *runtime.Scheme
 	KubeClient     clientset.Interface
 	OperatorClient controllerClient.Client
+	EventRecorder  record.EventRecorder
 }
 
 // Reconciler reconciles a ServiceDiscovery object.
@@ -91,6 +98,11 @@ var _ reconcile.Reconciler = &Reconciler{}
 
 // NewReconciler returns a new Reconciler.
 func NewReconciler(config *Config) *Reconciler {
+	if config.EventRecorder == nil {
+		// Events are dropped
+		config.EventRecorder = &record.FakeRecorder{}
+	}
+
 	return &Reconciler{
 		config: *config,
 		log:    ctrl.Log.WithName("controllers").WithName("ServiceDiscovery"),
@@ -138,33 +150,44 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.doCleanup(ctx, instance)
 	}
 
-	err = r.ensureLightHouseAgent(instance, reqLogger)
+	initialStatus := instance.Status.DeepCopy()
+
+	if err := r.migrateInlineCredentials(ctx, instance, reqLogger); err != nil {
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
+	}
+
+	agentDeployment, err := r.ensureLightHouseAgent(ctx, instance, reqLogger)
 	if err != nil {
-		return reconcile.Result{}, err
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 	}
 
 	lighthouseDNSConfigMap := newLighthouseDNSConfigMap(instance)
 	if _, err = helpers.ReconcileConfigMap(instance, lighthouseDNSConfigMap, reqLogger,
 		r.config.Client, r.config.Scheme); err != nil {
 		log.Error(err, "Error creating the lighthouseCoreDNS configMap")
-		return reconcile.Result{}, errors.Wrap(err, "error reconciling ConfigMap")
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, errors.Wrap(err, "error reconciling ConfigMap"))
 	}
 
-	err = r.ensureLighthouseCoreDNSDeployment(instance, reqLogger)
+	coreDNSDeployment, err := r.ensureLighthouseCoreDNSDeployment(instance, reqLogger)
 	if err != nil {
-		return reconcile.Result{}, err
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 	}
 
 	err = r.ensureLighthouseCoreDNSService(ctx, instance, reqLogger)
 	if err != nil {
-		return reconcile.Result{}, err
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
+	}
+
+	err = r.setWorkloadConditions(ctx, instance, agentDeployment, coreDNSDeployment)
+	if err != nil {
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 	}
 
 	if instance.Spec.CoreDNSCustomConfig != nil && instance.Spec.CoreDNSCustomConfig.ConfigMapName != "" {
 		err = r.updateDNSCustomConfigMap(ctx, instance, reqLogger)
 		if err != nil {
 			reqLogger.Error(err, "Error updating the 'custom-coredns' ConfigMap")
-			return reconcile.Result{}, err
+			return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 		}
 	} else {
 		err = r.configureDNSConfigMap(ctx, instance, defaultCoreDNSNamespace, coreDNSName)
@@ -172,12 +195,61 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if apierrors.IsNotFound(err) {
 		// Try to update Openshift-DNS
-		return reconcile.Result{}, r.configureOpenshiftClusterDNSOperator(ctx, instance)
+		err = r.configureOpenshiftClusterDNSOperator(ctx, instance)
 	}
 
+	if err != nil {
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
+	}
+
+	r.updateStatus(ctx, instance, initialStatus, reqLogger)
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) setWorkloadConditions(ctx context.Context, instance *submarinerv1alpha1.ServiceDiscovery,
+	deployments ...*appsv1.Deployment) error {
+	workloads := []helpers.WorkloadStatus{}
+
+	for _, deployment := range deployments {
+		mismatchedContainerImages, nonReadyContainerStates, err := helpers.CheckPodContainers(ctx, r.config.Client,
+			deployment.Namespace, deployment.Spec.Selector)
+		if err != nil {
+			return errors.Wrapf(err, "error checking the containers for Deployment %q", deployment.Name)
+		}
+
+		workloads = append(workloads, helpers.NewDeploymentWorkloadStatus(deployment, mismatchedContainerImages, nonReadyContainerStates))
+	}
+
+	helpers.SetWorkloadConditions(&instance.Status.Conditions, workloads)
+	instance.Status.ObservedGeneration = instance.Generation
+
+	return nil
+}
+
+// failReconcile records the given reconciliation error in the ServiceDiscovery status and returns it.
+func (r *Reconciler) failReconcile(ctx context.Context, instance *submarinerv1alpha1.ServiceDiscovery,
+	initialStatus *submarinerv1alpha1.ServiceDiscoveryStatus, reqLogger logr.Logger, err error) (reconcile.Result, error) {
+	helpers.SetReconcileFailedConditions(&instance.Status.Conditions, err)
+	instance.Status.ObservedGeneration = instance.Generation
+
+	r.updateStatus(ctx, instance, initialStatus, reqLogger)
+
 	return reconcile.Result{}, err
 }
 
+func (r *Reconciler) updateStatus(ctx context.Context, instance *submarinerv1alpha1.ServiceDiscovery,
+	initialStatus *submarinerv1alpha1.ServiceDiscoveryStatus, reqLogger logr.Logger) {
+	if reflect.DeepEqual(instance.Status, *initialStatus) {
+		return
+	}
+
+	if err := r.config.Client.Status().Update(ctx, instance); err != nil {
+		// Log the error, but indicate success, to avoid reconciliation storms
+		reqLogger.Error(err, "failed to update the ServiceDiscovery status")
+	}
+}
+
 func (r *Reconciler) getServiceDiscovery(ctx context.Context, key types.NamespacedName) (*submarinerv1alpha1.ServiceDiscovery, error) {
 	instance := &submarinerv1alpha1.ServiceDiscovery{}
 
@@ -233,7 +305,7 @@ func newLighthouseAgent(cr *submarinerv1alpha1.ServiceDiscovery, name string) *a
 		})
 	}
 
-	return &appsv1.Deployment{
+	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: cr.Namespace,
 			Name:      name,
@@ -261,9 +333,7 @@ func newLighthouseAgent(cr *submarinerv1alpha1.ServiceDiscovery, name string) *a
 								{Name: "SUBMARINER_DEBUG", Value: strconv.FormatBool(cr.Spec.Debug)},
 								{Name: "SUBMARINER_GLOBALNET_ENABLED", Value: strconv.FormatBool(cr.Spec.GlobalnetEnabled)},
 								{Name: broker.EnvironmentVariable("ApiServer"), Value: cr.Spec.BrokerK8sApiServer},
-								{Name: broker.EnvironmentVariable("ApiServerToken"), Value: cr.Spec.BrokerK8sApiServerToken},
 								{Name: broker.EnvironmentVariable("RemoteNamespace"), Value: cr.Spec.BrokerK8sRemoteNamespace},
-								{Name: broker.EnvironmentVariable("CA"), Value: cr.Spec.BrokerK8sCA},
 								{Name: broker.EnvironmentVariable("Insecure"), Value: strconv.FormatBool(cr.Spec.BrokerK8sInsecure)},
 								{Name: broker.EnvironmentVariable("Secret"), Value: cr.Spec.BrokerK8sSecret},
 							},
@@ -278,6 +348,10 @@ func newLighthouseAgent(cr *submarinerv1alpha1.ServiceDiscovery, name string) *a
 			},
 		},
 	}
+
+	helpers.ApplyComponentOverrides(&deployment.Spec.Template, getComponentOverrides(cr).LighthouseAgent)
+
+	return deployment
 }
 
 func newLighthouseDNSConfigMap(cr *submarinerv1alpha1.ServiceDiscovery) *corev1.ConfigMap {
@@ -334,7 +408,7 @@ func newLighthouseCoreDNSDeployment(cr *submarinerv1alpha1.ServiceDiscovery) *ap
 	allowPrivilegeEscalation := false
 	readOnlyRootFilesystem := true
 
-	return &appsv1.Deployment{
+	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: cr.Namespace,
 			Name:      lighthouseCoreDNSName,
@@ -391,6 +465,10 @@ func newLighthouseCoreDNSDeployment(cr *submarinerv1alpha1.ServiceDiscovery) *ap
 			},
 		},
 	}
+
+	helpers.ApplyComponentOverrides(&deployment.Spec.Template, getComponentOverrides(cr).LighthouseCoreDNS)
+
+	return deployment
 }
 
 func newLighthouseCoreDNSService(cr *submarinerv1alpha1.ServiceDiscovery) *corev1.Service {
@@ -503,6 +581,8 @@ func (r *Reconciler) configureDNSConfigMap(ctx context.Context, cr *submarinerv1
 
 func (r *Reconciler) updateLighthouseConfigInConfigMap(ctx context.Context, cr *submarinerv1alpha1.ServiceDiscovery,
 	configMapNamespace, configMapName, clusterIP string) error {
+	changed := false
+
 	// nolint:wrapcheck // No need to wrap errors here
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		configMap, err := r.config.KubeClient.CoreV1().ConfigMaps(configMapNamespace).Get(ctx, configMapName, metav1.GetOptions{})
@@ -511,6 +591,7 @@ func (r *Reconciler) updateLighthouseConfigInConfigMap(ctx context.Context, cr *
 		}
 
 		coreFile := configMap.Data["Corefile"]
+		originalCoreFile := coreFile
 		newCoreStr := ""
 		if strings.Contains(coreFile, "lighthouse-start") {
 			// Assume this means we've already set the ConfigMap up, first remove existing lighthouse config
@@ -548,12 +629,23 @@ func (r *Reconciler) updateLighthouseConfigInConfigMap(ctx context.Context, cr *
 
 		log.Info("Updated coredns ConfigMap " + coreFile)
 		configMap.Data["Corefile"] = coreFile
+		changed = strings.TrimSpace(coreFile) != strings.TrimSpace(originalCoreFile)
 
 		// Potentially retried
 		_, err = r.config.KubeClient.CoreV1().ConfigMaps(configMapNamespace).Update(ctx, configMap, metav1.UpdateOptions{})
 		return err
 	})
 
+	if retryErr == nil && changed {
+		if clusterIP == "" {
+			r.config.EventRecorder.Eventf(cr, corev1.EventTypeNormal, ReasonCoreDNSConfigUpdated,
+				"Removed the Lighthouse configuration from the DNS ConfigMap %s/%s", configMapNamespace, configMapName)
+		} else {
+			r.config.EventRecorder.Eventf(cr, corev1.EventTypeNormal, ReasonCoreDNSConfigUpdated,
+				"Updated the Lighthouse configuration in the DNS ConfigMap %s/%s", configMapNamespace, configMapName)
+		}
+	}
+
 	return errors.Wrap(retryErr, "error updating DNS ConfigMap")
 }
 
@@ -693,6 +785,14 @@ func getImagePath(submariner *submarinerv1alpha1.ServiceDiscovery, imageName, co
 		submariner.Spec.ImageOverrides)
 }
 
+func getComponentOverrides(serviceDiscovery *submarinerv1alpha1.ServiceDiscovery) *submarinerv1alpha1.ServiceDiscoveryComponentOverrides {
+	if serviceDiscovery.Spec.ComponentOverrides == nil {
+		return &submarinerv1alpha1.ServiceDiscoveryComponentOverrides{}
+	}
+
+	return serviceDiscovery.Spec.ComponentOverrides
+}
+
 // nolint:wrapcheck // No need to wrap errors here.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// These are required so that we can manipulate DNS ConfigMap
@@ -706,40 +806,62 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&submarinerv1alpha1.ServiceDiscovery{}).
 		// Watch for changes to secondary resource Deployment and requeue the owner ServiceDiscovery
 		Owns(&appsv1.Deployment{}).
-		Complete(r)
+		// Watch for changes to the broker credentials, so that rotations roll the agent
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(
+			func(object controllerClient.Object) []reconcile.Request {
+				return []reconcile.Request{{NamespacedName: types.NamespacedName{
+					Name:      names.ServiceDiscoveryCrName,
+					Namespace: object.GetNamespace(),
+				}}}
+			})).
+		Complete(metrics.InstrumentReconciler(metrics.ServiceDiscoveryController, r))
 }
 
-func (r *Reconciler) ensureLightHouseAgent(instance *submarinerv1alpha1.ServiceDiscovery, reqLogger logr.Logger) error {
-	lightHouseAgent := newLighthouseAgent(instance, names.ServiceDiscoveryComponent)
-	if _, err := helpers.ReconcileDeployment(instance, lightHouseAgent, reqLogger,
-		r.config.Client, r.config.Scheme); err != nil {
-		return errors.Wrap(err, "error reconciling agent deployment")
+func (r *Reconciler) ensureLightHouseAgent(ctx context.Context, instance *submarinerv1alpha1.ServiceDiscovery,
+	reqLogger logr.Logger) (*appsv1.Deployment, error) {
+	credentialsHash, err := helpers.SecretsHash(ctx, r.config.Client, instance.Namespace, instance.Spec.BrokerK8sSecret)
+	if err != nil {
+		return nil, err // nolint:wrapcheck // No need to wrap here
+	}
+
+	agentDeployment := newLighthouseAgent(instance, names.ServiceDiscoveryComponent)
+	if agentDeployment.Spec.Template.Annotations == nil {
+		agentDeployment.Spec.Template.Annotations = map[string]string{}
 	}
 
-	err := metrics.Setup(instance.Namespace, instance, lightHouseAgent.GetLabels(), 8082, r.config.Client,
+	// Rotated broker credentials are only picked up by a restarted agent
+	agentDeployment.Spec.Template.Annotations[helpers.CredentialsHashAnnotation] = credentialsHash
+
+	lightHouseAgent, err := helpers.ReconcileDeployment(instance, agentDeployment, reqLogger, r.config.Client, r.config.Scheme)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reconciling agent deployment")
+	}
+
+	err = metrics.Setup(instance.Namespace, instance, lightHouseAgent.GetLabels(), 8082, r.config.Client,
 		r.config.RestConfig, r.config.Scheme, reqLogger)
 	if err != nil {
-		return errors.Wrap(err, "error setting up metrics")
+		return nil, errors.Wrap(err, "error setting up metrics")
 	}
 
-	return nil
+	return lightHouseAgent, nil
 }
 
-func (r *Reconciler) ensureLighthouseCoreDNSDeployment(instance *submarinerv1alpha1.ServiceDiscovery, reqLogger logr.Logger) error {
-	lighthouseCoreDNSDeployment := newLighthouseCoreDNSDeployment(instance)
-	if _, err := helpers.ReconcileDeployment(instance, lighthouseCoreDNSDeployment, reqLogger,
-		r.config.Client, r.config.Scheme); err != nil {
+func (r *Reconciler) ensureLighthouseCoreDNSDeployment(instance *submarinerv1alpha1.ServiceDiscovery,
+	reqLogger logr.Logger) (*appsv1.Deployment, error) {
+	lighthouseCoreDNSDeployment, err := helpers.ReconcileDeployment(instance, newLighthouseCoreDNSDeployment(instance), reqLogger,
+		r.config.Client, r.config.Scheme)
+	if err != nil {
 		log.Error(err, "Error creating the lighthouseCoreDNS deployment")
-		return errors.Wrap(err, "error reconciling coredns deployment")
+		return nil, errors.Wrap(err, "error reconciling coredns deployment")
 	}
 
-	err := metrics.Setup(instance.Namespace, instance, lighthouseCoreDNSDeployment.GetLabels(), 9153, r.config.Client, r.config.RestConfig,
+	err = metrics.Setup(instance.Namespace, instance, lighthouseCoreDNSDeployment.GetLabels(), 9153, r.config.Client, r.config.RestConfig,
 		r.config.Scheme, reqLogger)
 	if err != nil {
-		return errors.Wrap(err, "error setting up coredns metrics")
+		return nil, errors.Wrap(err, "error setting up coredns metrics")
 	}
 
-	return nil
+	return lighthouseCoreDNSDeployment, nil
 }
 
 func (r *Reconciler) ensureLighthouseCoreDNSService(ctx context.Context, instance *submarinerv1alpha1.ServiceDiscovery,
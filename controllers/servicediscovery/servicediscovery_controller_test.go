@@ -19,17 +19,23 @@ package servicediscovery_test
 
 import (
 	"context"
+	"encoding/base64"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/submariner-io/admiral/pkg/test"
 	submariner_v1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
 	"github.com/submariner-io/submariner-operator/controllers/constants"
+	"github.com/submariner-io/submariner-operator/controllers/helpers"
 	"github.com/submariner-io/submariner-operator/controllers/resource"
+	"github.com/submariner-io/submariner-operator/controllers/servicediscovery"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
 	"github.com/submariner-io/submariner-operator/pkg/names"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 var _ = Describe("Service discovery controller", func() {
@@ -48,6 +54,127 @@ func testReconciliation() {
 		t.awaitFinalizer()
 	})
 
+	When("the lighthouse DNS service IP isn't yet assigned", func() {
+		BeforeEach(func() {
+			t.InitClientObjs = append(t.InitClientObjs, newDNSConfig(""))
+		})
+
+		It("should report the ServiceDiscovery resource as degraded", func() {
+			t.AssertReconcileError()
+
+			t.assertCondition(submariner_v1.ConditionReady, metav1.ConditionFalse)
+			t.assertCondition(submariner_v1.ConditionDegraded, metav1.ConditionTrue)
+		})
+	})
+
+	When("the lighthouse Deployments are ready", func() {
+		BeforeEach(func() {
+			t.InitClientObjs = append(t.InitClientObjs, newDNSConfig(""), newDNSService(clusterIP))
+		})
+
+		It("should report the ServiceDiscovery resource as ready", func() {
+			t.AssertReconcileSuccess()
+			t.assertCondition(submariner_v1.ConditionReady, metav1.ConditionFalse)
+
+			t.UpdateDeploymentToReady(t.AssertDeployment(names.ServiceDiscoveryComponent))
+
+			coreDNSDeployment, err := t.GetDeployment(lighthouseDNSServiceName)
+			Expect(err).To(Succeed())
+			t.UpdateDeploymentToReady(coreDNSDeployment)
+
+			t.AssertReconcileSuccess()
+
+			t.assertCondition(submariner_v1.ConditionReady, metav1.ConditionTrue)
+			t.assertCondition(submariner_v1.ConditionDegraded, metav1.ConditionFalse)
+			Expect(t.getServiceDiscovery().Status.ObservedGeneration).To(Equal(t.getServiceDiscovery().Generation))
+		})
+	})
+
+	When("the ServiceDiscovery resource has inline broker credentials", func() {
+		BeforeEach(func() {
+			t.serviceDiscovery.Spec.BrokerK8sApiServerToken = "MIIDADCCAeigAw"
+			t.serviceDiscovery.Spec.BrokerK8sCA = base64.StdEncoding.EncodeToString([]byte("client.crt"))
+			t.serviceDiscovery.Spec.BrokerK8sSecret = ""
+
+			t.InitClientObjs = append(t.InitClientObjs, newDNSConfig(""), newDNSService(clusterIP))
+		})
+
+		It("should move them into a Secret", func() {
+			t.AssertReconcileSuccess()
+
+			updated := t.getServiceDiscovery()
+			Expect(updated.Spec.BrokerK8sApiServerToken).To(BeEmpty())
+			Expect(updated.Spec.BrokerK8sCA).To(BeEmpty())
+			Expect(updated.Spec.BrokerK8sSecret).To(Equal(helpers.DefaultBrokerSecretName))
+
+			secret := &corev1.Secret{}
+			Expect(t.Client.Get(context.TODO(), types.NamespacedName{Namespace: submarinerNamespace, Name: helpers.DefaultBrokerSecretName},
+				secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue(broker.SecretTokenKey, []byte("MIIDADCCAeigAw")))
+			Expect(secret.Data).To(HaveKeyWithValue(broker.SecretCAKey, []byte("client.crt")))
+
+			Expect(t.AssertDeployment(names.ServiceDiscoveryComponent).Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name:         "brokersecret",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: helpers.DefaultBrokerSecretName}},
+			}))
+		})
+	})
+
+	When("the broker credentials are rotated", func() {
+		var brokerSecret *corev1.Secret
+
+		BeforeEach(func() {
+			brokerSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: t.serviceDiscovery.Spec.BrokerK8sSecret, Namespace: submarinerNamespace},
+				Data:       map[string][]byte{broker.SecretTokenKey: []byte("old-token")},
+			}
+
+			t.InitClientObjs = append(t.InitClientObjs, newDNSConfig(""), newDNSService(clusterIP), brokerSecret)
+		})
+
+		It("should restart the lighthouse agent", func() {
+			t.AssertReconcileSuccess()
+
+			credentialsHash := func() string {
+				return t.AssertDeployment(names.ServiceDiscoveryComponent).Spec.Template.Annotations[helpers.CredentialsHashAnnotation]
+			}
+
+			oldHash := credentialsHash()
+			Expect(oldHash).ToNot(BeEmpty())
+
+			brokerSecret.Data[broker.SecretTokenKey] = []byte("new-token")
+			Expect(t.Client.Update(context.TODO(), brokerSecret)).To(Succeed())
+
+			t.AssertReconcileSuccess()
+			Expect(credentialsHash()).ToNot(Equal(oldHash))
+		})
+	})
+
+	When("component overrides are specified", func() {
+		BeforeEach(func() {
+			t.serviceDiscovery.Spec.ComponentOverrides = &submariner_v1.ServiceDiscoveryComponentOverrides{
+				LighthouseCoreDNS: &submariner_v1.ComponentOverrides{
+					Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+					NodeSelector: map[string]string{"node-role": "infra"},
+				},
+			}
+
+			t.InitClientObjs = append(t.InitClientObjs, newDNSConfig(""), newDNSService(clusterIP))
+		})
+
+		It("should merge them into the CoreDNS pod template", func() {
+			t.AssertReconcileSuccess()
+
+			coreDNSDeployment, err := t.GetDeployment(lighthouseDNSServiceName)
+			Expect(err).To(Succeed())
+			Expect(coreDNSDeployment.Spec.Template.Spec.Tolerations).To(Equal(
+				t.serviceDiscovery.Spec.ComponentOverrides.LighthouseCoreDNS.Tolerations))
+			Expect(coreDNSDeployment.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("node-role", "infra"))
+
+			Expect(t.AssertDeployment(names.ServiceDiscoveryComponent).Spec.Template.Spec.NodeSelector).To(BeEmpty())
+		})
+	})
+
 	When("the openshift DNS config exists", func() {
 		Context("and the lighthouse config isn't present", func() {
 			BeforeEach(func() {
@@ -103,6 +230,19 @@ func testReconciliation() {
 				t.AssertReconcileSuccess()
 
 				Expect(strings.TrimSpace(t.assertCoreDNSConfigMap().Data["Corefile"])).To(Equal(coreDNSCorefileData(clusterIP)))
+				t.AssertEvent(corev1.EventTypeNormal, servicediscovery.ReasonCoreDNSConfigUpdated)
+			})
+		})
+
+		Context("and the lighthouse config is already up-to-date", func() {
+			BeforeEach(func() {
+				t.InitClientObjs = append(t.InitClientObjs, newDNSService(clusterIP))
+				t.createConfigMap(newCoreDNSConfigMap(coreDNSCorefileData(clusterIP)))
+			})
+
+			It("should not record an event", func() {
+				t.AssertReconcileSuccess()
+				t.AssertNoEvent(servicediscovery.ReasonCoreDNSConfigUpdated)
 			})
 		})
 
@@ -304,6 +444,7 @@ func testDeploymentUninstall() {
 			t.AssertReconcileRequeue()
 
 			t.AssertNoDeployment(names.ServiceDiscoveryComponent)
+			t.assertCondition("LighthouseAgent"+submariner_v1.ConditionUninstalled, metav1.ConditionFalse)
 
 			t.UpdateDeploymentToReady(t.assertUninstallServiceDiscoveryDeployment())
 
@@ -314,6 +455,27 @@ func testDeploymentUninstall() {
 			t.AssertNoDeployment(names.AppendUninstall(names.ServiceDiscoveryComponent))
 
 			t.awaitNoFinalizer()
+			t.assertCondition(submariner_v1.ConditionUninstalled, metav1.ConditionTrue)
+		})
+	})
+
+	When("the uninstall Deployment does not complete in time", func() {
+		BeforeEach(func() {
+			t.serviceDiscovery.Spec.UninstallTimeout = &metav1.Duration{Duration: time.Minute}
+
+			ts := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+			t.serviceDiscovery.SetDeletionTimestamp(&ts)
+
+			t.InitClientObjs = append(t.InitClientObjs, t.NewDeployment(names.ServiceDiscoveryComponent))
+		})
+
+		It("should keep the finalizer and report the timeout", func() {
+			t.AssertReconcileSuccess()
+
+			t.AssertNoDeployment(names.AppendUninstall(names.ServiceDiscoveryComponent))
+
+			t.awaitFinalizer()
+			t.assertCondition(submariner_v1.ConditionUninstalled, metav1.ConditionFalse)
 		})
 	})
 
@@ -35,6 +35,7 @@ import (
 	"github.com/submariner-io/submariner-operator/pkg/names"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	fakeKubeClient "k8s.io/client-go/kubernetes/fake"
@@ -102,6 +103,7 @@ func newTestDriver() *testDriver {
 			Scheme:         scheme.Scheme,
 			KubeClient:     t.kubeClient,
 			OperatorClient: t.Client,
+			EventRecorder:  t.EventRecorder,
 		})
 	})
 
@@ -116,6 +118,20 @@ func (t *testDriver) awaitNoFinalizer() {
 	t.AwaitNoFinalizer(t.serviceDiscovery, constants.CleanupFinalizer)
 }
 
+func (t *testDriver) getServiceDiscovery() *submariner_v1.ServiceDiscovery {
+	obj := &submariner_v1.ServiceDiscovery{}
+	err := t.Client.Get(context.TODO(), types.NamespacedName{Name: serviceDiscoveryName, Namespace: submarinerNamespace}, obj)
+	Expect(err).To(Succeed())
+
+	return obj
+}
+
+func (t *testDriver) assertCondition(conditionType string, status metav1.ConditionStatus) {
+	condition := meta.FindStatusCondition(t.getServiceDiscovery().Status.Conditions, conditionType)
+	Expect(condition).ToNot(BeNil(), "Condition %q not found", conditionType)
+	Expect(condition.Status).To(Equal(status), "Unexpected status for condition %q: %s", conditionType, condition.Message)
+}
+
 func (t *testDriver) assertUninstallServiceDiscoveryDeployment() *appsv1.Deployment {
 	deployment := t.AssertDeployment(names.AppendUninstall(names.ServiceDiscoveryComponent))
 
@@ -214,8 +230,7 @@ func newServiceDiscovery() *submariner_v1.ServiceDiscovery {
 			Version:                  "1.0.0",
 			BrokerK8sRemoteNamespace: "submariner-broker",
 			BrokerK8sApiServer:       "https://192.168.99.110:8443",
-			BrokerK8sApiServerToken:  "MIIDADCCAeigAw",
-			BrokerK8sCA:              "client.crt",
+			BrokerK8sSecret:          "broker-secret",
 			ClusterID:                "east",
 			Namespace:                "submariner_ns",
 			Debug:                    true,
@@ -20,22 +20,31 @@ package submariner
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/controllers/metrics"
 	"github.com/submariner-io/submariner-operator/pkg/broker"
+	"github.com/submariner-io/submariner-operator/pkg/brokercr"
 	"github.com/submariner-io/submariner-operator/pkg/crd"
 	"github.com/submariner-io/submariner-operator/pkg/discovery/globalnet"
 	"github.com/submariner-io/submariner-operator/pkg/gateway"
 	"github.com/submariner-io/submariner-operator/pkg/lighthouse"
+	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // BrokerReconciler reconciles a Broker object.
@@ -44,15 +53,19 @@ type BrokerReconciler struct {
 	Config *rest.Config
 	Log    logr.Logger
 	Scheme *runtime.Scheme
+	// KubeClient is optional; if it isn't set, one is created from Config.
+	KubeClient kubernetes.Interface
 }
 
 // TODO skitt: these rbac declarations (and others, see submariner_controller.go) need to be separated
 // from methods in order to be taken into account; but they produce ClusterRoles, not the Roles we want
 // +kubebuilder:rbac:groups=submariner.io,resources=brokers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=submariner.io,resources=brokers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=submariner.io,resources=clusters;endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=submariner.io,resources=globalnetallocations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=submariner.io,resources=globalnetallocations/status,verbs=get;update;patch
 func (r *BrokerReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
-	_ = context.Background()
-	_ = r.Log.WithValues("broker", request.NamespacedName)
+	reqLogger := r.Log.WithValues("broker", request.NamespacedName)
 
 	// Fetch the Broker instance
 	instance := &v1alpha1.Broker{}
@@ -74,43 +87,105 @@ func (r *BrokerReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		return reconcile.Result{}, nil
 	}
 
-	kubeClient, err := kubernetes.NewForConfig(r.Config)
-	if err != nil {
-		return ctrl.Result{}, errors.Wrap(err, "error creating kube client")
+	kubeClient := r.KubeClient
+	if kubeClient == nil {
+		kubeClient, err = kubernetes.NewForConfig(r.Config)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "error creating kube client")
+		}
 	}
 
+	initialStatus := instance.Status.DeepCopy()
+
+	err = r.reconcileBroker(ctx, instance, kubeClient, request.Namespace)
+
+	r.updateBrokerStatus(ctx, instance, initialStatus, kubeClient, reqLogger)
+
+	return ctrl.Result{}, err
+}
+
+func (r *BrokerReconciler) reconcileBroker(ctx context.Context, instance *v1alpha1.Broker, kubeClient kubernetes.Interface,
+	namespace string) error {
 	// Broker CRDs
-	crdUpdater := crd.UpdaterFromControllerClient(r.Client)
+	crdUpdater := crd.WithSkewPolicy(crd.UpdaterFromControllerClient(r.Client), crd.SkipOlder)
 
-	err = gateway.Ensure(crdUpdater)
-	if err != nil {
-		return ctrl.Result{}, err // nolint:wrapcheck // Errors are already wrapped
+	err := gateway.Ensure(crdUpdater)
+	if err == nil {
+		// Lighthouse CRDs
+		_, err = lighthouse.Ensure(crdUpdater, lighthouse.BrokerCluster)
 	}
 
-	// Lighthouse CRDs
-	_, err = lighthouse.Ensure(crdUpdater, lighthouse.BrokerCluster)
+	setBrokerCondition(instance, v1alpha1.BrokerCRDsReady, err, "CRDsInstalled", "CRDInstallationFailed")
+
 	if err != nil {
-		return ctrl.Result{}, err // nolint:wrapcheck // Errors are already wrapped
+		return err // nolint:wrapcheck // Errors are already wrapped
 	}
 
 	// Globalnet
-	err = globalnet.ValidateExistingGlobalNetworks(kubeClient, request.Namespace)
+	err = globalnet.ValidateExistingGlobalNetworks(kubeClient, namespace)
+	if err == nil {
+		err = broker.CreateGlobalnetConfigMap(kubeClient, instance.Spec.GlobalnetEnabled, instance.Spec.GlobalnetCIDRRange,
+			instance.Spec.DefaultGlobalnetClusterSize, namespace)
+	}
+
+	setBrokerCondition(instance, v1alpha1.BrokerGlobalnetConfigValid, err, "GlobalnetConfigValid", "GlobalnetConfigInvalid")
+
 	if err != nil {
-		return ctrl.Result{}, err // nolint:wrapcheck // Errors are already wrapped
+		return err // nolint:wrapcheck // Errors are already wrapped
 	}
 
-	err = broker.CreateGlobalnetConfigMap(kubeClient, instance.Spec.GlobalnetEnabled, instance.Spec.GlobalnetCIDRRange,
-		instance.Spec.DefaultGlobalnetClusterSize, request.Namespace)
+	return r.reconcileGlobalnetAllocations(ctx, instance, kubeClient, namespace)
+}
+
+func (r *BrokerReconciler) updateBrokerStatus(ctx context.Context, instance *v1alpha1.Broker, initialStatus *v1alpha1.BrokerStatus,
+	kubeClient kubernetes.Interface, reqLogger logr.Logger) {
+	clusters, err := r.buildClusterInventory(ctx, kubeClient, instance.Namespace)
 	if err != nil {
-		return ctrl.Result{}, err // nolint:wrapcheck // Errors are already wrapped
+		reqLogger.Error(err, "failed to build the cluster inventory")
+
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.BrokerClustersHealthy,
+			Status:  metav1.ConditionUnknown,
+			Reason:  "InventoryFailed",
+			Message: err.Error(),
+		})
+	} else {
+		instance.Status.Clusters = clusters
+		setClustersHealthyCondition(instance)
+	}
+
+	if reflect.DeepEqual(&instance.Status, initialStatus) {
+		return
 	}
 
-	return ctrl.Result{}, nil
+	if err := r.Client.Status().Update(ctx, instance); err != nil {
+		// Log the error, but indicate success, to avoid reconciliation storms
+		reqLogger.Error(err, "failed to update the Broker status")
+	}
 }
 
 // nolint:wrapcheck // No need to wrap here.
 func (r *BrokerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// These are required so that we can retrieve Cluster and Endpoint objects
+	if err := submv1.AddToScheme(mgr.GetScheme()); err != nil {
+		return errors.Wrap(err, "error adding to the scheme")
+	}
+
+	// Watch for changes to the Clusters, Endpoints and GlobalnetAllocations in the broker namespace
+	mapFn := handler.MapFunc(
+		func(object client.Object) []reconcile.Request {
+			return []reconcile.Request{
+				{NamespacedName: types.NamespacedName{
+					Name:      brokercr.Name,
+					Namespace: object.GetNamespace(),
+				}},
+			}
+		})
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Broker{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &submv1.Cluster{}}, handler.EnqueueRequestsFromMapFunc(mapFn)).
+		Watches(&source.Kind{Type: &submv1.Endpoint{}}, handler.EnqueueRequestsFromMapFunc(mapFn)).
+		Watches(&source.Kind{Type: &v1alpha1.GlobalnetAllocation{}}, handler.EnqueueRequestsFromMapFunc(mapFn)).
+		Complete(metrics.InstrumentReconciler(metrics.BrokerController, r))
 }
@@ -0,0 +1,398 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	operatorv1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	submarinerController "github.com/submariner-io/submariner-operator/controllers/submariner"
+	"github.com/submariner-io/submariner-operator/controllers/test"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	"github.com/submariner-io/submariner-operator/pkg/brokercr"
+	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	fakeKubeClient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	controllerClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const brokerNamespace = "submariner-k8s-broker"
+
+var _ = Describe("Broker controller tests", func() {
+	t := newBrokerTestDriver()
+
+	When("no clusters have joined", func() {
+		It("should report the CRDs as ready and the clusters as healthy", func() {
+			t.AssertReconcileSuccess()
+
+			status := t.getBroker().Status
+			Expect(status.Clusters).To(BeEmpty())
+			t.assertCondition(operatorv1.BrokerCRDsReady, metav1.ConditionTrue)
+			t.assertCondition(operatorv1.BrokerGlobalnetConfigValid, metav1.ConditionTrue)
+			t.assertCondition(operatorv1.BrokerClustersHealthy, metav1.ConditionTrue)
+		})
+	})
+
+	When("clusters have joined with Endpoints", func() {
+		BeforeEach(func() {
+			t.InitClientObjs = append(t.InitClientObjs, newCluster("west"), newCluster("east"),
+				newEndpoint("east", "libreswan"), newEndpoint("west", "vxlan"))
+		})
+
+		It("should report them in the cluster inventory", func() {
+			t.AssertReconcileSuccess()
+
+			clusters := t.getBroker().Status.Clusters
+			Expect(clusters).To(HaveLen(2))
+			Expect(clusters[0].ClusterID).To(Equal("east"))
+			Expect(clusters[0].CableDrivers).To(Equal([]string{"libreswan"}))
+			Expect(clusters[0].Connected).To(BeTrue())
+			Expect(clusters[0].LastHeartbeat).ToNot(BeNil())
+			Expect(clusters[1].ClusterID).To(Equal("west"))
+			Expect(clusters[1].CableDrivers).To(Equal([]string{"vxlan"}))
+
+			t.assertCondition(operatorv1.BrokerClustersHealthy, metav1.ConditionTrue)
+		})
+	})
+
+	When("a cluster has joined without an Endpoint", func() {
+		BeforeEach(func() {
+			t.InitClientObjs = append(t.InitClientObjs, newCluster("east"), newCluster("west"), newEndpoint("east", "libreswan"))
+		})
+
+		It("should report the clusters as unhealthy", func() {
+			t.AssertReconcileSuccess()
+
+			clusters := t.getBroker().Status.Clusters
+			Expect(clusters).To(HaveLen(2))
+			Expect(clusters[1].ClusterID).To(Equal("west"))
+			Expect(clusters[1].Connected).To(BeFalse())
+
+			t.assertCondition(operatorv1.BrokerClustersHealthy, metav1.ConditionFalse)
+		})
+	})
+
+	When("globalnet CIDRs have been allocated", func() {
+		BeforeEach(func() {
+			t.broker.Spec.GlobalnetEnabled = true
+			t.InitClientObjs = append(t.InitClientObjs, newCluster("east"), newEndpoint("east", "libreswan"))
+
+			configMap, err := broker.NewGlobalnetConfigMap(true, "242.0.0.0/8", 65536, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			configMap, err = t.kubeClient.CoreV1().ConfigMaps(brokerNamespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+
+			Expect(broker.UpdateGlobalnetConfigMap(t.kubeClient, brokerNamespace, configMap, broker.ClusterInfo{
+				ClusterID:  "east",
+				GlobalCidr: []string{"242.0.0.0/16"},
+			})).To(Succeed())
+		})
+
+		It("should report the allocations in the cluster inventory", func() {
+			t.AssertReconcileSuccess()
+
+			clusters := t.getBroker().Status.Clusters
+			Expect(clusters).To(HaveLen(1))
+			Expect(clusters[0].GlobalCIDRs).To(Equal([]string{"242.0.0.0/16"}))
+		})
+	})
+
+	When("a cluster requests a globalnet allocation", func() {
+		var allocation *operatorv1.GlobalnetAllocation
+
+		BeforeEach(func() {
+			t.broker.Spec.GlobalnetEnabled = true
+			t.broker.Spec.GlobalnetCIDRRange = "242.0.0.0/8"
+			t.broker.Spec.DefaultGlobalnetClusterSize = 65536
+
+			configMap, err := broker.NewGlobalnetConfigMap(true, "242.0.0.0/8", 65536, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			configMap, err = t.kubeClient.CoreV1().ConfigMaps(brokerNamespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+
+			Expect(broker.UpdateGlobalnetConfigMap(t.kubeClient, brokerNamespace, configMap, broker.ClusterInfo{
+				ClusterID:  "east",
+				GlobalCidr: []string{"242.0.0.0/16"},
+			})).To(Succeed())
+
+			allocation = newGlobalnetAllocation("west")
+			t.InitClientObjs = append(t.InitClientObjs, allocation)
+		})
+
+		It("should allocate the next available CIDR and record it in the globalnet ConfigMap", func() {
+			t.AssertReconcileSuccess()
+
+			allocation = t.getGlobalnetAllocation("west")
+			Expect(allocation.Status.GlobalCIDR).To(Equal("242.1.0.0/16"))
+			Expect(allocation.Finalizers).To(ContainElement("controllers.submariner.io/globalnet-allocation"))
+			t.assertAllocatedCondition(allocation, metav1.ConditionTrue)
+
+			Expect(t.getBroker().Status.Clusters).To(ContainElement(operatorv1.BrokerClusterStatus{
+				ClusterID:   "west",
+				GlobalCIDRs: []string{"242.1.0.0/16"},
+			}))
+		})
+
+		Context("and the allocation is reconciled again", func() {
+			It("should keep the allocated CIDR", func() {
+				t.AssertReconcileSuccess()
+				t.AssertReconcileSuccess()
+
+				Expect(t.getGlobalnetAllocation("west").Status.GlobalCIDR).To(Equal("242.1.0.0/16"))
+			})
+		})
+
+		Context("with a specific CIDR", func() {
+			BeforeEach(func() {
+				allocation.Spec.GlobalCIDR = "242.5.0.0/16"
+			})
+
+			It("should allocate the requested CIDR", func() {
+				t.AssertReconcileSuccess()
+				Expect(t.getGlobalnetAllocation("west").Status.GlobalCIDR).To(Equal("242.5.0.0/16"))
+			})
+		})
+
+		Context("with a CIDR overlapping another cluster's", func() {
+			BeforeEach(func() {
+				allocation.Spec.GlobalCIDR = "242.0.1.0/24"
+			})
+
+			It("should report the allocation as failed", func() {
+				t.AssertReconcileSuccess()
+
+				allocation = t.getGlobalnetAllocation("west")
+				Expect(allocation.Status.GlobalCIDR).To(BeEmpty())
+				t.assertAllocatedCondition(allocation, metav1.ConditionFalse)
+			})
+		})
+
+		Context("for another cluster's ID", func() {
+			BeforeEach(func() {
+				// A join token for "west" can only update the allocation named "west"
+				allocation.Spec.ClusterID = "east"
+			})
+
+			It("should reject the allocation and leave the other cluster's CIDR alone", func() {
+				t.AssertReconcileSuccess()
+
+				allocation = t.getGlobalnetAllocation("west")
+				Expect(allocation.Status.GlobalCIDR).To(BeEmpty())
+				condition := t.assertAllocatedCondition(allocation, metav1.ConditionFalse)
+				Expect(condition.Reason).To(Equal(operatorv1.GlobalnetClusterIDMismatchReason))
+
+				Expect(t.getBroker().Status.Clusters).To(Equal([]operatorv1.BrokerClusterStatus{{
+					ClusterID:   "east",
+					GlobalCIDRs: []string{"242.0.0.0/16"},
+				}}))
+			})
+		})
+
+		Context("which is pending, along with a join token", func() {
+			BeforeEach(func() {
+				allocation.Annotations = map[string]string{operatorv1.GlobalnetAllocationPendingAnnotation: "true"}
+			})
+
+			It("should not allocate a CIDR until the cluster joins", func() {
+				t.AssertReconcileSuccess()
+
+				allocation = t.getGlobalnetAllocation("west")
+				Expect(allocation.Status.GlobalCIDR).To(BeEmpty())
+				Expect(allocation.Finalizers).To(BeEmpty())
+			})
+		})
+
+		Context("and the allocation is being deleted", func() {
+			BeforeEach(func() {
+				now := metav1.Now()
+				allocation.DeletionTimestamp = &now
+				allocation.Finalizers = []string{"controllers.submariner.io/globalnet-allocation"}
+				allocation.Status.GlobalCIDR = "242.1.0.0/16"
+
+				configMap, err := broker.GetGlobalnetConfigMap(t.kubeClient, brokerNamespace)
+				Expect(err).To(Succeed())
+
+				Expect(broker.UpdateGlobalnetConfigMap(t.kubeClient, brokerNamespace, configMap, broker.ClusterInfo{
+					ClusterID:  "west",
+					GlobalCidr: []string{"242.1.0.0/16"},
+				})).To(Succeed())
+			})
+
+			It("should release the CIDR and remove the finalizer", func() {
+				t.AssertReconcileSuccess()
+
+				Expect(t.getGlobalnetAllocation("west").Finalizers).To(BeEmpty())
+
+				clusters := t.getBroker().Status.Clusters
+				Expect(clusters).To(HaveLen(1))
+				Expect(clusters[0].ClusterID).To(Equal("east"))
+			})
+		})
+	})
+
+	When("a cluster requests a globalnet allocation and globalnet is disabled", func() {
+		BeforeEach(func() {
+			t.InitClientObjs = append(t.InitClientObjs, newGlobalnetAllocation("west"))
+		})
+
+		It("should report that globalnet is disabled", func() {
+			t.AssertReconcileSuccess()
+
+			allocation := t.getGlobalnetAllocation("west")
+			condition := t.assertAllocatedCondition(allocation, metav1.ConditionFalse)
+			Expect(condition.Reason).To(Equal(operatorv1.GlobalnetDisabledReason))
+		})
+	})
+
+	When("the existing globalnet configuration is invalid", func() {
+		BeforeEach(func() {
+			configMap, err := broker.NewGlobalnetConfigMap(true, "bogus", 65536, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			_, err = t.kubeClient.CoreV1().ConfigMaps(brokerNamespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+		})
+
+		It("should return an error and report the configuration as invalid", func() {
+			t.AssertReconcileError()
+			t.assertCondition(operatorv1.BrokerGlobalnetConfigValid, metav1.ConditionFalse)
+		})
+	})
+})
+
+type brokerTestDriver struct {
+	test.Driver
+	broker     *operatorv1.Broker
+	kubeClient kubernetes.Interface
+}
+
+func newBrokerTestDriver() *brokerTestDriver {
+	t := &brokerTestDriver{
+		Driver: test.Driver{
+			Namespace:    brokerNamespace,
+			ResourceName: brokercr.Name,
+		},
+	}
+
+	BeforeEach(func() {
+		t.BeforeEach()
+		t.broker = &operatorv1.Broker{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      brokercr.Name,
+				Namespace: brokerNamespace,
+			},
+		}
+		t.InitClientObjs = []controllerClient.Object{t.broker}
+		t.kubeClient = fakeKubeClient.NewSimpleClientset()
+	})
+
+	JustBeforeEach(func() {
+		t.JustBeforeEach()
+
+		t.Controller = &submarinerController.BrokerReconciler{
+			Client:     t.Client,
+			Log:        ctrl.Log.WithName("controllers").WithName("Broker"),
+			Scheme:     scheme.Scheme,
+			KubeClient: t.kubeClient,
+		}
+	})
+
+	return t
+}
+
+func (t *brokerTestDriver) getBroker() *operatorv1.Broker {
+	obj := &operatorv1.Broker{}
+	err := t.Client.Get(context.TODO(), types.NamespacedName{Name: brokercr.Name, Namespace: brokerNamespace}, obj)
+	Expect(err).To(Succeed())
+
+	return obj
+}
+
+func (t *brokerTestDriver) assertCondition(conditionType string, status metav1.ConditionStatus) {
+	broker := t.getBroker()
+	condition := meta.FindStatusCondition(broker.Status.Conditions, conditionType)
+	Expect(condition).ToNot(BeNil(), "Condition %q not found", conditionType)
+	Expect(condition.Status).To(Equal(status), "Unexpected status for condition %q: %s", conditionType, condition.Message)
+	Expect(condition.ObservedGeneration).To(Equal(broker.Generation))
+}
+
+func (t *brokerTestDriver) getGlobalnetAllocation(name string) *operatorv1.GlobalnetAllocation {
+	obj := &operatorv1.GlobalnetAllocation{}
+	err := t.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: brokerNamespace}, obj)
+	Expect(err).To(Succeed())
+
+	return obj
+}
+
+func (t *brokerTestDriver) assertAllocatedCondition(allocation *operatorv1.GlobalnetAllocation,
+	status metav1.ConditionStatus) *metav1.Condition {
+	condition := meta.FindStatusCondition(allocation.Status.Conditions, operatorv1.GlobalnetAllocated)
+	Expect(condition).ToNot(BeNil(), "Condition %q not found", operatorv1.GlobalnetAllocated)
+	Expect(condition.Status).To(Equal(status), "Unexpected status for condition %q: %s", operatorv1.GlobalnetAllocated,
+		condition.Message)
+
+	return condition
+}
+
+func newGlobalnetAllocation(clusterID string) *operatorv1.GlobalnetAllocation {
+	return &operatorv1.GlobalnetAllocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterID,
+			Namespace: brokerNamespace,
+		},
+		Spec: operatorv1.GlobalnetAllocationSpec{
+			ClusterID: clusterID,
+		},
+	}
+}
+
+func newCluster(clusterID string) *submarinerv1.Cluster {
+	return &submarinerv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterID,
+			Namespace: brokerNamespace,
+		},
+		Spec: submarinerv1.ClusterSpec{
+			ClusterID: clusterID,
+		},
+	}
+}
+
+func newEndpoint(clusterID, backend string) *submarinerv1.Endpoint {
+	return &submarinerv1.Endpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              clusterID + "-" + backend,
+			Namespace:         brokerNamespace,
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: submarinerv1.EndpointSpec{
+			ClusterID: clusterID,
+			Backend:   backend,
+		},
+	}
+}
@@ -0,0 +1,200 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/admiral/pkg/finalizer"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/controllers/resource"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	"github.com/submariner-io/submariner-operator/pkg/discovery/globalnet"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileGlobalnetAllocations allocates global CIDRs to the clusters which requested them with a GlobalnetAllocation,
+// and releases the CIDRs of deleted GlobalnetAllocations. Allocations are mirrored in the globalnet ConfigMap, which
+// still records the CIDRs allocated by older versions of subctl. The allocations are processed one at a time, oldest
+// first, so they can't conflict.
+func (r *BrokerReconciler) reconcileGlobalnetAllocations(ctx context.Context, instance *v1alpha1.Broker, kubeClient kubernetes.Interface,
+	namespace string) error {
+	allocationList := &v1alpha1.GlobalnetAllocationList{}
+
+	err := r.Client.List(ctx, allocationList, client.InNamespace(namespace))
+	if err != nil {
+		return errors.Wrap(err, "error listing GlobalnetAllocations")
+	}
+
+	if len(allocationList.Items) == 0 {
+		return nil
+	}
+
+	globalnetInfo, configMap, err := globalnet.GetGlobalNetworks(kubeClient, namespace)
+	if err != nil {
+		return err // nolint:wrapcheck // Errors are already wrapped
+	}
+
+	// The Broker spec takes precedence over the ConfigMap, which isn't updated once created
+	globalnetInfo.Enabled = instance.Spec.GlobalnetEnabled
+
+	if instance.Spec.GlobalnetCIDRRange != "" {
+		globalnetInfo.CidrRange = instance.Spec.GlobalnetCIDRRange
+	}
+
+	if instance.Spec.DefaultGlobalnetClusterSize != 0 {
+		globalnetInfo.ClusterSize = instance.Spec.DefaultGlobalnetClusterSize
+	}
+
+	allocations := allocationList.Items
+	sort.Slice(allocations, func(i, j int) bool {
+		created, otherCreated := &allocations[i].CreationTimestamp, &allocations[j].CreationTimestamp
+		if !created.Equal(otherCreated) {
+			return created.Before(otherCreated)
+		}
+
+		return allocations[i].Name < allocations[j].Name
+	})
+
+	for i := range allocations {
+		if allocations[i].DeletionTimestamp != nil {
+			err = r.releaseGlobalnetAllocation(ctx, &allocations[i], globalnetInfo, configMap, kubeClient)
+		} else {
+			err = r.processGlobalnetAllocation(ctx, &allocations[i], globalnetInfo, configMap, kubeClient)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *BrokerReconciler) processGlobalnetAllocation(ctx context.Context, allocation *v1alpha1.GlobalnetAllocation,
+	globalnetInfo *globalnet.Info, configMap *corev1.ConfigMap, kubeClient kubernetes.Interface) error {
+	if _, pending := allocation.Annotations[v1alpha1.GlobalnetAllocationPendingAnnotation]; pending {
+		return nil
+	}
+
+	added, err := finalizer.Add(ctx, resource.ForControllerClient(r.Client, allocation.Namespace, &v1alpha1.GlobalnetAllocation{}),
+		allocation, v1alpha1.GlobalnetAllocationFinalizer)
+	if err != nil {
+		return err // nolint:wrapcheck // No need to wrap
+	}
+
+	if added {
+		err = r.Client.Get(ctx, types.NamespacedName{Namespace: allocation.Namespace, Name: allocation.Name}, allocation)
+		if err != nil {
+			return errors.Wrap(err, "error retrieving GlobalnetAllocation")
+		}
+	}
+
+	initialStatus := allocation.Status.DeepCopy()
+	clusterID := allocation.Spec.ClusterID
+
+	condition := metav1.Condition{
+		Type:               v1alpha1.GlobalnetAllocated,
+		Status:             metav1.ConditionTrue,
+		Reason:             "CIDRAllocated",
+		ObservedGeneration: allocation.Generation,
+	}
+
+	switch {
+	// Join tokens can only update the GlobalnetAllocation named after their cluster, so that's the only one trusted
+	case allocation.Name != clusterID:
+		allocation.Status.GlobalCIDR = ""
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = v1alpha1.GlobalnetClusterIDMismatchReason
+		condition.Message = fmt.Sprintf("The GlobalnetAllocation must be named after the cluster ID %q", clusterID)
+	case globalnetInfo.Enabled:
+		netconfig := globalnet.Config{
+			ClusterID:   clusterID,
+			GlobalCIDR:  allocation.Spec.GlobalCIDR,
+			ClusterSize: allocation.Spec.ClusterSize,
+		}
+
+		// Once allocated, a cluster keeps its global CIDR
+		if allocation.Status.GlobalCIDR != "" {
+			netconfig.GlobalCIDR = allocation.Status.GlobalCIDR
+			netconfig.ClusterSize = 0
+		}
+
+		previous := globalnetInfo.CidrInfo[clusterID]
+
+		allocation.Status.GlobalCIDR, err = globalnet.AllocateClusterGlobalCIDR(globalnetInfo, netconfig)
+		if err != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "AllocationFailed"
+			condition.Message = err.Error()
+		} else {
+			condition.Message = "Allocated global CIDR " + allocation.Status.GlobalCIDR
+
+			if previous == nil || len(previous.GlobalCIDRs) == 0 || previous.GlobalCIDRs[0] != allocation.Status.GlobalCIDR {
+				err = broker.UpdateGlobalnetConfigMap(kubeClient, allocation.Namespace, configMap, broker.ClusterInfo{
+					ClusterID:  clusterID,
+					GlobalCidr: []string{allocation.Status.GlobalCIDR},
+				})
+				if err != nil {
+					return err // nolint:wrapcheck // Errors are already wrapped
+				}
+			}
+		}
+	default:
+		allocation.Status.GlobalCIDR = ""
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = v1alpha1.GlobalnetDisabledReason
+		condition.Message = "Globalnet is not enabled on the Broker"
+	}
+
+	meta.SetStatusCondition(&allocation.Status.Conditions, condition)
+
+	if reflect.DeepEqual(&allocation.Status, initialStatus) {
+		return nil
+	}
+
+	return errors.Wrap(r.Client.Status().Update(ctx, allocation), "error updating the GlobalnetAllocation status")
+}
+
+func (r *BrokerReconciler) releaseGlobalnetAllocation(ctx context.Context, allocation *v1alpha1.GlobalnetAllocation,
+	globalnetInfo *globalnet.Info, configMap *corev1.ConfigMap, kubeClient kubernetes.Interface) error {
+	clusterID := allocation.Spec.ClusterID
+
+	// Only release the CIDR if it was allocated through this GlobalnetAllocation
+	if allocated := globalnetInfo.CidrInfo[clusterID]; allocation.Name == clusterID && allocation.Status.GlobalCIDR != "" &&
+		allocated != nil && len(allocated.GlobalCIDRs) > 0 && allocated.GlobalCIDRs[0] == allocation.Status.GlobalCIDR {
+		if err := broker.RemoveFromGlobalnetConfigMap(kubeClient, allocation.Namespace, configMap, clusterID); err != nil {
+			return err // nolint:wrapcheck // Errors are already wrapped
+		}
+
+		delete(globalnetInfo.CidrInfo, clusterID)
+	}
+
+	return finalizer.Remove(ctx, resource.ForControllerClient(r.Client, allocation.Namespace, &v1alpha1.GlobalnetAllocation{}),
+		allocation, v1alpha1.GlobalnetAllocationFinalizer)
+}
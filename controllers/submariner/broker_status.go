@@ -0,0 +1,176 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/pkg/discovery/globalnet"
+	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// buildClusterInventory lists the Clusters and Endpoints synced to the broker namespace, along with the
+// globalnet allocations, and returns the resulting per-cluster status sorted by cluster ID.
+func (r *BrokerReconciler) buildClusterInventory(ctx context.Context, kubeClient kubernetes.Interface,
+	namespace string) ([]v1alpha1.BrokerClusterStatus, error) {
+	clusterList := &submv1.ClusterList{}
+
+	err := r.Client.List(ctx, clusterList, client.InNamespace(namespace))
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing Clusters")
+	}
+
+	endpointList := &submv1.EndpointList{}
+
+	err = r.Client.List(ctx, endpointList, client.InNamespace(namespace))
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing Endpoints")
+	}
+
+	globalnetInfo, _, err := globalnet.GetGlobalNetworks(kubeClient, namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err // nolint:wrapcheck // Errors are already wrapped
+	}
+
+	inventory := map[string]*v1alpha1.BrokerClusterStatus{}
+
+	getOrAdd := func(clusterID string) *v1alpha1.BrokerClusterStatus {
+		status, ok := inventory[clusterID]
+		if !ok {
+			status = &v1alpha1.BrokerClusterStatus{ClusterID: clusterID}
+			inventory[clusterID] = status
+		}
+
+		return status
+	}
+
+	for i := range clusterList.Items {
+		getOrAdd(clusterList.Items[i].Spec.ClusterID)
+	}
+
+	for i := range endpointList.Items {
+		endpoint := &endpointList.Items[i]
+		status := getOrAdd(endpoint.Spec.ClusterID)
+		status.Connected = true
+
+		if endpoint.Spec.Backend != "" && !containsString(status.CableDrivers, endpoint.Spec.Backend) {
+			status.CableDrivers = append(status.CableDrivers, endpoint.Spec.Backend)
+		}
+
+		heartbeat := lastUpdateTime(endpoint)
+		if status.LastHeartbeat == nil || status.LastHeartbeat.Before(&heartbeat) {
+			status.LastHeartbeat = &heartbeat
+		}
+	}
+
+	if globalnetInfo != nil {
+		for clusterID, globalNetwork := range globalnetInfo.CidrInfo {
+			getOrAdd(clusterID).GlobalCIDRs = globalNetwork.GlobalCIDRs
+		}
+	}
+
+	clusters := make([]v1alpha1.BrokerClusterStatus, 0, len(inventory))
+	for _, status := range inventory {
+		sort.Strings(status.CableDrivers)
+		clusters = append(clusters, *status)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].ClusterID < clusters[j].ClusterID
+	})
+
+	return clusters, nil
+}
+
+// lastUpdateTime returns the most recent time at which the given object was written, based on its
+// managed fields, falling back to its creation time.
+func lastUpdateTime(obj metav1.Object) metav1.Time {
+	last := obj.GetCreationTimestamp()
+
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Time != nil && last.Before(entry.Time) {
+			last = *entry.Time
+		}
+	}
+
+	return last
+}
+
+func setClustersHealthyCondition(instance *v1alpha1.Broker) {
+	disconnected := []string{}
+
+	for i := range instance.Status.Clusters {
+		if !instance.Status.Clusters[i].Connected {
+			disconnected = append(disconnected, instance.Status.Clusters[i].ClusterID)
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               v1alpha1.BrokerClustersHealthy,
+		Status:             metav1.ConditionTrue,
+		Reason:             "AllClustersConnected",
+		Message:            fmt.Sprintf("%d cluster(s) joined", len(instance.Status.Clusters)),
+		ObservedGeneration: instance.Generation,
+	}
+
+	if len(disconnected) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ClustersMissingEndpoints"
+		condition.Message = fmt.Sprintf("The following clusters have no gateway Endpoint: %s", strings.Join(disconnected, ", "))
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, condition)
+}
+
+func setBrokerCondition(instance *v1alpha1.Broker, conditionType string, err error, successReason, failureReason string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             successReason,
+		ObservedGeneration: instance.Generation,
+	}
+
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = failureReason
+		condition.Message = err.Error()
+	}
+
+	meta.SetStatusCondition(&instance.Status.Conditions, condition)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
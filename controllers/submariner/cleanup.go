@@ -40,6 +40,8 @@ func (r *Reconciler) runComponentCleanup(ctx context.Context, instance *operator
 		return reconcile.Result{}, r.removeFinalizer(ctx, instance)
 	}
 
+	initialStatus := instance.Status.DeepCopy()
+
 	// This has the side effect of setting the CIDRs in the Submariner instance.
 	clusterNetwork, err := r.discoverNetwork(instance)
 	if err != nil {
@@ -48,14 +50,17 @@ func (r *Reconciler) runComponentCleanup(ctx context.Context, instance *operator
 
 	components := []*uninstall.Component{
 		{
+			Name:              "Gateway",
 			Resource:          newDaemonSet(names.GatewayComponent, instance.Namespace),
 			UninstallResource: newGatewayDaemonSet(instance, names.AppendUninstall(names.GatewayComponent)),
 		},
 		{
+			Name:              "RouteAgent",
 			Resource:          newDaemonSet(names.RouteAgentComponent, instance.Namespace),
 			UninstallResource: newRouteAgentDaemonSet(instance, names.AppendUninstall(names.RouteAgentComponent)),
 		},
 		{
+			Name:              "Globalnet",
 			Resource:          newDaemonSet(names.GlobalnetComponent, instance.Namespace),
 			UninstallResource: newGlobalnetDaemonSet(instance, names.AppendUninstall(names.GlobalnetComponent)),
 			CheckInstalled: func() bool {
@@ -63,6 +68,7 @@ func (r *Reconciler) runComponentCleanup(ctx context.Context, instance *operator
 			},
 		},
 		{
+			Name:     "NetworkPluginSyncer",
 			Resource: newDeployment(names.NetworkPluginSyncerComponent, instance.Namespace),
 			UninstallResource: newNetworkPluginSyncerDeployment(instance, clusterNetwork,
 				names.AppendUninstall(names.NetworkPluginSyncerComponent)),
@@ -79,12 +85,25 @@ func (r *Reconciler) runComponentCleanup(ctx context.Context, instance *operator
 		Log:        log,
 	}
 
+	if instance.Spec.UninstallTimeout != nil {
+		uninstallInfo.Timeout = instance.Spec.UninstallTimeout.Duration
+	}
+
 	requeue, timedOut, err := uninstallInfo.Run(ctx)
+
+	uninstallInfo.SetStatusConditions(&instance.Status.Conditions)
+	r.updateStatus(ctx, instance, initialStatus, log)
+
 	if err != nil {
 		return reconcile.Result{}, err // nolint:wrapcheck // No need to wrap
 	}
 
-	if !timedOut && instance.Spec.ServiceDiscoveryEnabled {
+	if timedOut {
+		// Keep the finalizer so that the uninstall can be retried with a longer timeout.
+		return reconcile.Result{}, nil
+	}
+
+	if instance.Spec.ServiceDiscoveryEnabled {
 		requeue = r.ensureServiceDiscoveryDeleted(ctx, instance.Namespace) || requeue
 	}
 
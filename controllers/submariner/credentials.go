@@ -0,0 +1,205 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/submariner-io/admiral/pkg/resource"
+	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/controllers/helpers"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+)
+
+// credentialsRecheckInterval is how often the components' restart is checked while they roll out new broker credentials.
+const credentialsRecheckInterval = 10 * time.Second
+
+// migrateInlineCredentials moves the broker credentials and IPsec PSK which older versions of subctl stored in the
+// Submariner spec into the Secrets referenced by the spec, and clears the inline values; the operator and the components
+// it deploys only read credentials from Secrets.
+func (r *Reconciler) migrateInlineCredentials(ctx context.Context, instance *submopv1a1.Submariner, reqLogger logr.Logger) error {
+	spec := &instance.Spec
+
+	if spec.BrokerK8sApiServerToken == "" && spec.BrokerK8sCA == "" && spec.CeIPSecPSK == "" {
+		return nil
+	}
+
+	if spec.BrokerK8sApiServerToken != "" || spec.BrokerK8sCA != "" {
+		data, err := helpers.BrokerSecretData(spec.BrokerK8sApiServerToken, spec.BrokerK8sCA)
+		if err != nil {
+			return err // nolint:wrapcheck // No need to wrap here
+		}
+
+		if spec.BrokerK8sSecret == "" {
+			spec.BrokerK8sSecret = helpers.DefaultBrokerSecretName
+		}
+
+		if err := helpers.EnsureSecretData(ctx, r.config.Client, instance.Namespace, spec.BrokerK8sSecret, data); err != nil {
+			return err // nolint:wrapcheck // No need to wrap here
+		}
+	}
+
+	if spec.CeIPSecPSK != "" {
+		psk, err := base64.StdEncoding.DecodeString(spec.CeIPSecPSK)
+		if err != nil {
+			return errors.Wrap(err, "error decoding the inline IPsec PSK")
+		}
+
+		if spec.CeIPSecPSKSecret == "" {
+			spec.CeIPSecPSKSecret = broker.IPSecPSKSecretName
+		}
+
+		if err := helpers.EnsureSecretData(ctx, r.config.Client, instance.Namespace, spec.CeIPSecPSKSecret,
+			map[string][]byte{broker.IPSecPSKSecretKey: psk}); err != nil {
+			return err // nolint:wrapcheck // No need to wrap here
+		}
+	}
+
+	spec.BrokerK8sApiServerToken = ""
+	spec.BrokerK8sCA = ""
+	spec.CeIPSecPSK = ""
+
+	if err := r.config.Client.Update(ctx, instance); err != nil {
+		return errors.Wrap(err, "error removing the inline credentials from the Submariner resource")
+	}
+
+	reqLogger.Info("Moved the inline credentials into Secrets", "BrokerSecret", spec.BrokerK8sSecret,
+		"PSKSecret", spec.CeIPSecPSKSecret)
+
+	return nil
+}
+
+// brokerRestConfig returns the configuration used to access the broker with the credentials in the broker Secret, along
+// with the broker token.
+func (r *Reconciler) brokerRestConfig(ctx context.Context, instance *submopv1a1.Submariner) (*rest.Config, string, error) {
+	brokerSecret := &corev1.Secret{}
+	if err := r.config.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.BrokerK8sSecret},
+		brokerSecret); err != nil {
+		return nil, "", errors.Wrapf(err, "error retrieving the broker Secret %q", instance.Spec.BrokerK8sSecret)
+	}
+
+	token, ca := broker.CredentialsFromSecret(brokerSecret)
+
+	brokerConfig, err := resource.BuildRestConfigFromData(instance.Spec.BrokerK8sApiServer, token, ca,
+		&rest.TLSClientConfig{Insecure: instance.Spec.BrokerK8sInsecure})
+
+	return brokerConfig, token, errors.Wrap(err, "error building the broker RestConfig")
+}
+
+// acknowledgeBrokerCredentials records on the cluster's Endpoints on the broker, using the broker token, that every
+// component using the token has restarted with it; after a rotation, subctl waits for this before revoking the
+// previous tokens. It returns false while the components are restarting.
+func (r *Reconciler) acknowledgeBrokerCredentials(ctx context.Context, instance *submopv1a1.Submariner,
+	gatewayDaemonSet *appsv1.DaemonSet, reqLogger logr.Logger) (bool, error) {
+	if instance.Spec.BrokerK8sSecret == "" {
+		return true, nil
+	}
+
+	gateways := helpers.NewDaemonSetWorkloadStatus(gatewayDaemonSet, nil)
+	if gateways.Desired == 0 {
+		// The cluster's Endpoints are maintained by its gateways
+		return true, nil
+	}
+
+	if !gateways.IsRolledOut() {
+		return false, nil
+	}
+
+	rolledOut, err := r.isLighthouseAgentRolledOut(ctx, instance)
+	if err != nil || !rolledOut {
+		return false, err
+	}
+
+	brokerConfig, token, err := r.brokerRestConfig(ctx, instance)
+	if err != nil {
+		return false, err
+	}
+
+	brokerClient, err := r.config.BrokerEndpointsClientFor(brokerConfig)
+	if err != nil {
+		return false, errors.Wrap(err, "error building the broker client")
+	}
+
+	brokerEndpoints := brokerClient.SubmarinerV1().Endpoints(instance.Spec.BrokerK8sRemoteNamespace)
+
+	endpoints, err := brokerEndpoints.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, errors.Wrap(err, "error listing the broker Endpoints")
+	}
+
+	tokenHash := broker.TokenHash([]byte(token))
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, broker.CredentialsAnnotation, tokenHash)
+
+	for i := range endpoints.Items {
+		endpoint := &endpoints.Items[i]
+		if endpoint.Spec.ClusterID != instance.Spec.ClusterID || endpoint.Annotations[broker.CredentialsAnnotation] == tokenHash {
+			continue
+		}
+
+		if _, err := brokerEndpoints.Patch(ctx, endpoint.Name, types.MergePatchType, []byte(patch),
+			metav1.PatchOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "error acknowledging the broker credentials on Endpoint %q", endpoint.Name)
+		}
+
+		reqLogger.Info("Acknowledged the broker credentials on the broker Endpoint", "Endpoint", endpoint.Name)
+	}
+
+	return true, nil
+}
+
+// isLighthouseAgentRolledOut returns true if the lighthouse agent, which also uses the broker credentials, has been
+// restarted with the current credentials, or isn't deployed.
+func (r *Reconciler) isLighthouseAgentRolledOut(ctx context.Context, instance *submopv1a1.Submariner) (bool, error) {
+	agent := &appsv1.Deployment{}
+
+	err := r.config.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: names.ServiceDiscoveryComponent}, agent)
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
+
+	if err != nil {
+		return false, errors.Wrap(err, "error retrieving the lighthouse agent Deployment")
+	}
+
+	credentialsHash, err := helpers.SecretsHash(ctx, r.config.Client, instance.Namespace, instance.Spec.BrokerK8sSecret)
+	if err != nil {
+		return false, err // nolint:wrapcheck // No need to wrap here
+	}
+
+	// The ServiceDiscovery controller might not have caught up with the credentials yet
+	if agent.Spec.Template.Annotations[helpers.CredentialsHashAnnotation] != credentialsHash {
+		return false, nil
+	}
+
+	workload := helpers.NewDeploymentWorkloadStatus(agent, false, nil)
+
+	return workload.IsRolledOut() && agent.Status.Replicas == agent.Status.UpdatedReplicas, nil
+}
@@ -0,0 +1,195 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/controllers/helpers"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	submclientset "github.com/submariner-io/submariner/pkg/client/clientset/versioned"
+	fakeSubmClient "github.com/submariner-io/submariner/pkg/client/clientset/versioned/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("acknowledgeBrokerCredentials", func() {
+	const (
+		namespace       = "submariner-operator"
+		brokerNamespace = "submariner-k8s-broker"
+	)
+
+	var (
+		instance     *submopv1a1.Submariner
+		gateways     *appsv1.DaemonSet
+		agent        *appsv1.Deployment
+		brokerSecret *corev1.Secret
+		brokerClient *fakeSubmClient.Clientset
+		acknowledged bool
+	)
+
+	BeforeEach(func() {
+		Expect(submopv1a1.AddToScheme(scheme.Scheme)).To(Succeed())
+
+		instance = &submopv1a1.Submariner{
+			ObjectMeta: metav1.ObjectMeta{Name: "submariner", Namespace: namespace},
+			Spec: submopv1a1.SubmarinerSpec{
+				ClusterID:                "east",
+				BrokerK8sApiServer:       "broker:6443",
+				BrokerK8sSecret:          "broker-secret",
+				BrokerK8sRemoteNamespace: brokerNamespace,
+			},
+		}
+
+		brokerSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: instance.Spec.BrokerK8sSecret, Namespace: namespace},
+			Data:       map[string][]byte{broker.SecretTokenKey: []byte("new-token")},
+		}
+
+		gateways = &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: names.GatewayComponent, Namespace: namespace, Generation: 1},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration:     1,
+				DesiredNumberScheduled: 1,
+				UpdatedNumberScheduled: 1,
+				NumberReady:            1,
+			},
+		}
+
+		agent = nil
+
+		brokerClient = fakeSubmClient.NewSimpleClientset(
+			&submv1.Endpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: "east", Namespace: brokerNamespace},
+				Spec:       submv1.EndpointSpec{ClusterID: "east"},
+			},
+			&submv1.Endpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: "west", Namespace: brokerNamespace},
+				Spec:       submv1.EndpointSpec{ClusterID: "west"},
+			})
+	})
+
+	JustBeforeEach(func() {
+		objects := []client.Object{instance, brokerSecret}
+		if agent != nil {
+			objects = append(objects, agent)
+		}
+
+		reconciler := NewReconciler(&Config{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+			BrokerEndpointsClientFor: func(config *rest.Config) (submclientset.Interface, error) {
+				Expect(config.BearerToken).To(Equal("new-token"))
+				return brokerClient, nil
+			},
+		})
+
+		var err error
+
+		acknowledged, err = reconciler.acknowledgeBrokerCredentials(context.TODO(), instance, gateways, ctrl.Log)
+		Expect(err).To(Succeed())
+	})
+
+	credentialsAnnotation := func(name string) string {
+		endpoint, err := brokerClient.SubmarinerV1().Endpoints(brokerNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+		Expect(err).To(Succeed())
+
+		return endpoint.Annotations[broker.CredentialsAnnotation]
+	}
+
+	When("the gateways have restarted with the broker credentials", func() {
+		It("should acknowledge them on the cluster's Endpoints only", func() {
+			Expect(acknowledged).To(BeTrue())
+			Expect(credentialsAnnotation("east")).To(Equal(broker.TokenHash([]byte("new-token"))))
+			Expect(credentialsAnnotation("west")).To(BeEmpty())
+		})
+	})
+
+	When("the gateways are still restarting", func() {
+		BeforeEach(func() {
+			gateways.Status.UpdatedNumberScheduled = 0
+		})
+
+		It("should not acknowledge the broker credentials", func() {
+			Expect(acknowledged).To(BeFalse())
+			Expect(credentialsAnnotation("east")).To(BeEmpty())
+		})
+	})
+
+	When("the lighthouse agent hasn't been updated with the broker credentials", func() {
+		BeforeEach(func() {
+			agent = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: names.ServiceDiscoveryComponent, Namespace: namespace},
+			}
+		})
+
+		It("should not acknowledge the broker credentials", func() {
+			Expect(acknowledged).To(BeFalse())
+			Expect(credentialsAnnotation("east")).To(BeEmpty())
+		})
+	})
+
+	When("the lighthouse agent has restarted with the broker credentials", func() {
+		BeforeEach(func() {
+			credentialsHash, err := helpers.SecretsHash(context.TODO(),
+				fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(brokerSecret).Build(), namespace, brokerSecret.Name)
+			Expect(err).To(Succeed())
+
+			replicas := int32(1)
+			agent = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: names.ServiceDiscoveryComponent, Namespace: namespace, Generation: 1},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{helpers.CredentialsHashAnnotation: credentialsHash},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1},
+			}
+		})
+
+		It("should acknowledge the broker credentials", func() {
+			Expect(acknowledged).To(BeTrue())
+			Expect(credentialsAnnotation("east")).To(Equal(broker.TokenHash([]byte("new-token"))))
+		})
+	})
+
+	When("the cluster has no gateways", func() {
+		BeforeEach(func() {
+			gateways.Status = appsv1.DaemonSetStatus{ObservedGeneration: 1}
+		})
+
+		It("should not block on the acknowledgement", func() {
+			Expect(acknowledged).To(BeTrue())
+			Expect(credentialsAnnotation("east")).To(BeEmpty())
+		})
+	})
+})
@@ -23,9 +23,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/controllers/helpers"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -56,62 +56,11 @@ func updateDaemonSetStatus(ctx context.Context, clnt client.Reader, daemonSet *a
 
 func checkDaemonSetContainers(ctx context.Context, clnt client.Reader, daemonSet *appsv1.DaemonSet,
 	namespace string) (bool, *[]corev1.ContainerState, error) {
-	containerStatuses, err := retrieveDaemonSetContainerStatuses(ctx, clnt, daemonSet, namespace)
+	mismatchedContainerImages, nonReadyContainerStates, err := helpers.CheckPodContainers(ctx, clnt, namespace,
+		daemonSet.Spec.Selector)
 	if err != nil {
-		return false, nil, err
-	}
-
-	var containerImageManifest *string
-
-	mismatchedContainerImages := false
-	nonReadyContainerStates := []corev1.ContainerState{}
-
-	for i := range *containerStatuses {
-		containerStatus := (*containerStatuses)[i]
-		if containerImageManifest == nil {
-			containerImageManifest = &(containerStatus.ImageID)
-		} else if *containerImageManifest != containerStatus.ImageID {
-			// Container mismatch
-			mismatchedContainerImages = true
-		}
-
-		if containerStatus.Started == nil || !*containerStatus.Started {
-			// Not (yet) ready
-			nonReadyContainerStates = append(nonReadyContainerStates, containerStatus.State)
-		}
+		return false, nil, errors.Wrapf(err, "error checking the containers for DaemonSet %q", daemonSet.Name)
 	}
 
 	return mismatchedContainerImages, &nonReadyContainerStates, nil
 }
-
-func retrieveDaemonSetContainerStatuses(ctx context.Context, clnt client.Reader, daemonSet *appsv1.DaemonSet,
-	namespace string) (*[]corev1.ContainerStatus, error) {
-	pods, err := findPodsBySelector(ctx, clnt, namespace, daemonSet.Spec.Selector)
-	if err != nil {
-		return nil, err
-	}
-
-	containerStatuses := []corev1.ContainerStatus{}
-	for i := range pods {
-		containerStatuses = append(containerStatuses, pods[i].Status.ContainerStatuses...)
-	}
-
-	return &containerStatuses, nil
-}
-
-func findPodsBySelector(ctx context.Context, clnt client.Reader, namespace string,
-	labelSelector *metav1.LabelSelector) ([]corev1.Pod, error) {
-	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
-	if err != nil {
-		return nil, errors.Wrap(err, "error creating label selector")
-	}
-
-	pods := &corev1.PodList{}
-
-	err = clnt.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector})
-	if err != nil {
-		return nil, errors.Wrap(err, "error listing DaemonSet pods")
-	}
-
-	return pods.Items, nil
-}
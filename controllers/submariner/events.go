@@ -0,0 +1,93 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Reasons for the events recorded on Submariner resources.
+const (
+	ReasonGatewayFailover        = "GatewayFailover"
+	ReasonNetworkDiscovered      = "NetworkDiscovered"
+	ReasonNetworkPluginChanged   = "NetworkPluginChanged"
+	ReasonNetworkCIDRsChanged    = "NetworkCIDRsChanged"
+	ReasonContainerImageMismatch = "ContainerImageMismatch"
+)
+
+// recordStatusChangeEvents records events for the significant differences between the initial and the new status.
+func (r *Reconciler) recordStatusChangeEvents(instance *submopv1a1.Submariner, initialStatus *submopv1a1.SubmarinerStatus) {
+	if initialStatus.NetworkPlugin != instance.Status.NetworkPlugin && instance.Status.NetworkPlugin != "" {
+		if initialStatus.NetworkPlugin == "" {
+			r.config.EventRecorder.Eventf(instance, corev1.EventTypeNormal, ReasonNetworkDiscovered,
+				"Discovered the %q network plugin", instance.Status.NetworkPlugin)
+		} else {
+			r.config.EventRecorder.Eventf(instance, corev1.EventTypeWarning, ReasonNetworkPluginChanged,
+				"The network plugin changed from %q to %q", initialStatus.NetworkPlugin, instance.Status.NetworkPlugin)
+		}
+	}
+
+	r.recordCIDRChangeEvent(instance, "cluster", initialStatus.ClusterCIDR, instance.Status.ClusterCIDR)
+	r.recordCIDRChangeEvent(instance, "service", initialStatus.ServiceCIDR, instance.Status.ServiceCIDR)
+
+	previousGateway := activeGateway(initialStatus.Gateways)
+	currentGateway := activeGateway(instance.Status.Gateways)
+
+	if previousGateway != "" && currentGateway != "" && previousGateway != currentGateway {
+		r.config.EventRecorder.Eventf(instance, corev1.EventTypeWarning, ReasonGatewayFailover,
+			"The active gateway failed over from %q to %q", previousGateway, currentGateway)
+	}
+
+	r.recordImageMismatchEvent(instance, "gateway", &initialStatus.GatewayDaemonSetStatus, &instance.Status.GatewayDaemonSetStatus)
+	r.recordImageMismatchEvent(instance, "route agent", &initialStatus.RouteAgentDaemonSetStatus,
+		&instance.Status.RouteAgentDaemonSetStatus)
+	r.recordImageMismatchEvent(instance, "globalnet", &initialStatus.GlobalnetDaemonSetStatus, &instance.Status.GlobalnetDaemonSetStatus)
+}
+
+func (r *Reconciler) recordCIDRChangeEvent(instance *submopv1a1.Submariner, cidrType, initialCIDR, cidr string) {
+	if initialCIDR != "" && cidr != "" && initialCIDR != cidr {
+		r.config.EventRecorder.Eventf(instance, corev1.EventTypeWarning, ReasonNetworkCIDRsChanged,
+			"The %s CIDR changed from %q to %q", cidrType, initialCIDR, cidr)
+	}
+}
+
+func (r *Reconciler) recordImageMismatchEvent(instance *submopv1a1.Submariner, component string,
+	initialStatus, status *submopv1a1.DaemonSetStatus) {
+	if status.MismatchedContainerImages && !initialStatus.MismatchedContainerImages {
+		r.config.EventRecorder.Eventf(instance, corev1.EventTypeWarning, ReasonContainerImageMismatch,
+			"Some of the %s pods are not running the expected image", component)
+	}
+}
+
+// activeGateway returns the host name of the active gateway, if any.
+func activeGateway(gateways *[]submarinerv1.GatewayStatus) string {
+	if gateways == nil {
+		return ""
+	}
+
+	for i := range *gateways {
+		if (*gateways)[i].HAStatus == submarinerv1.HAStatusActive {
+			return (*gateways)[i].LocalEndpoint.Hostname
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,103 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The maximum number of gateway transitions kept in the Submariner status.
+const gatewayHistoryLength = 10
+
+// Reasons for the gateway transitions recorded in the Submariner status.
+const (
+	// A gateway became active while none was active before.
+	GatewayTransitionActivated = "GatewayActivated"
+	// The active gateway became passive and another gateway took over.
+	GatewayTransitionFailover = "GatewayFailover"
+	// The active gateway disappeared and another gateway took over.
+	GatewayTransitionReplaced = "GatewayReplaced"
+	// No gateway is active any more.
+	GatewayTransitionDeactivated = "GatewayDeactivated"
+)
+
+// recordNewGatewayTransitions updates the gateway transition metric with the transitions in the given history which
+// aren't in the previous history; it is only called once the history is persisted, so that each transition is counted once.
+func recordNewGatewayTransitions(clusterID string, previousHistory, history []submopv1a1.GatewayTransition) {
+	for i := range history {
+		if !containsGatewayTransition(previousHistory, &history[i]) {
+			recordGatewayTransition(clusterID, history[i].Reason)
+		}
+	}
+}
+
+func containsGatewayTransition(history []submopv1a1.GatewayTransition, transition *submopv1a1.GatewayTransition) bool {
+	for i := range history {
+		if history[i].Time.Equal(&transition.Time) && history[i].PreviousActive == transition.PreviousActive &&
+			history[i].NewActive == transition.NewActive && history[i].Reason == transition.Reason {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updateGatewayHistory appends the change of active gateway between the previous and the current gateway statuses, if any,
+// to the given history, dropping the oldest entries beyond gatewayHistoryLength.
+func updateGatewayHistory(history []submopv1a1.GatewayTransition,
+	previousGateways, gateways *[]submv1.GatewayStatus) []submopv1a1.GatewayTransition {
+	previousActive := activeGateway(previousGateways)
+	newActive := activeGateway(gateways)
+
+	if previousActive == newActive {
+		return history
+	}
+
+	history = append(history, submopv1a1.GatewayTransition{
+		Time:           metav1.Now(),
+		PreviousActive: previousActive,
+		NewActive:      newActive,
+		Reason:         gatewayTransitionReason(previousActive, newActive, gateways),
+	})
+
+	if len(history) > gatewayHistoryLength {
+		history = history[len(history)-gatewayHistoryLength:]
+	}
+
+	return history
+}
+
+func gatewayTransitionReason(previousActive, newActive string, gateways *[]submv1.GatewayStatus) string {
+	switch {
+	case previousActive == "":
+		return GatewayTransitionActivated
+	case newActive == "":
+		return GatewayTransitionDeactivated
+	}
+
+	for i := range *gateways {
+		if (*gateways)[i].LocalEndpoint.Hostname == previousActive {
+			return GatewayTransitionFailover
+		}
+	}
+
+	return GatewayTransitionReplaced
+}
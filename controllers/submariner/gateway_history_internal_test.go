@@ -0,0 +1,143 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+)
+
+var _ = Describe("updateGatewayHistory", func() {
+	const clusterID = "east"
+
+	var (
+		history  []submopv1a1.GatewayTransition
+		previous *[]submarinerv1.GatewayStatus
+		current  *[]submarinerv1.GatewayStatus
+	)
+
+	gateway := func(hostname string, haStatus submarinerv1.HAStatus) submarinerv1.GatewayStatus {
+		return submarinerv1.GatewayStatus{
+			HAStatus:      haStatus,
+			LocalEndpoint: submarinerv1.EndpointSpec{ClusterID: clusterID, Hostname: hostname},
+		}
+	}
+
+	transitions := func(reason string) float64 {
+		return testutil.ToFloat64(gatewayTransitionsCounter.With(prometheus.Labels{
+			connectionsLocalClusterLabel: clusterID,
+			gatewayTransitionReasonLabel: reason,
+		}))
+	}
+
+	BeforeEach(func() {
+		gatewayTransitionsCounter.Reset()
+
+		history = nil
+		previous = &[]submarinerv1.GatewayStatus{gateway("gw-1", submarinerv1.HAStatusActive)}
+	})
+
+	assertTransition := func(previousActive, newActive, reason string) {
+		history = updateGatewayHistory(history, previous, current)
+
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].PreviousActive).To(Equal(previousActive))
+		Expect(history[0].NewActive).To(Equal(newActive))
+		Expect(history[0].Reason).To(Equal(reason))
+		Expect(history[0].Time.IsZero()).To(BeFalse())
+		Expect(transitions(reason)).To(BeZero())
+
+		recordNewGatewayTransitions(clusterID, nil, history)
+		Expect(transitions(reason)).To(Equal(float64(1)))
+	}
+
+	When("the active gateway is unchanged", func() {
+		It("should not record a transition", func() {
+			current = &[]submarinerv1.GatewayStatus{gateway("gw-1", submarinerv1.HAStatusActive)}
+			Expect(updateGatewayHistory(history, previous, current)).To(BeEmpty())
+		})
+	})
+
+	When("a gateway becomes active", func() {
+		It("should record an activation", func() {
+			previous = nil
+			current = &[]submarinerv1.GatewayStatus{gateway("gw-1", submarinerv1.HAStatusActive)}
+			assertTransition("", "gw-1", GatewayTransitionActivated)
+		})
+	})
+
+	When("the active gateway becomes passive and another takes over", func() {
+		It("should record a failover", func() {
+			current = &[]submarinerv1.GatewayStatus{
+				gateway("gw-1", submarinerv1.HAStatusPassive),
+				gateway("gw-2", submarinerv1.HAStatusActive),
+			}
+			assertTransition("gw-1", "gw-2", GatewayTransitionFailover)
+		})
+	})
+
+	When("the active gateway disappears and another takes over", func() {
+		It("should record a replacement", func() {
+			current = &[]submarinerv1.GatewayStatus{gateway("gw-2", submarinerv1.HAStatusActive)}
+			assertTransition("gw-1", "gw-2", GatewayTransitionReplaced)
+		})
+	})
+
+	When("no gateway is active any more", func() {
+		It("should record a deactivation", func() {
+			current = &[]submarinerv1.GatewayStatus{}
+			assertTransition("gw-1", "", GatewayTransitionDeactivated)
+		})
+	})
+
+	When("the history is full", func() {
+		It("should drop the oldest transitions", func() {
+			for i := 0; i < gatewayHistoryLength+2; i++ {
+				current = &[]submarinerv1.GatewayStatus{gateway(fmt.Sprintf("gw-%d", i+2), submarinerv1.HAStatusActive)}
+				history = updateGatewayHistory(history, previous, current)
+				previous = current
+			}
+
+			Expect(history).To(HaveLen(gatewayHistoryLength))
+			Expect(history[0].PreviousActive).To(Equal("gw-3"))
+			Expect(history[gatewayHistoryLength-1].NewActive).To(Equal(fmt.Sprintf("gw-%d", gatewayHistoryLength+3)))
+		})
+	})
+
+	When("the transitions are recorded again", func() {
+		It("should only count the new ones", func() {
+			current = &[]submarinerv1.GatewayStatus{gateway("gw-2", submarinerv1.HAStatusActive)}
+			persisted := updateGatewayHistory(history, previous, current)
+			recordNewGatewayTransitions(clusterID, history, persisted)
+
+			previous = current
+			current = &[]submarinerv1.GatewayStatus{gateway("gw-3", submarinerv1.HAStatusActive)}
+			history = updateGatewayHistory(persisted, previous, current)
+			recordNewGatewayTransitions(clusterID, persisted, history)
+
+			Expect(transitions(GatewayTransitionReplaced)).To(Equal(float64(2)))
+		})
+	})
+})
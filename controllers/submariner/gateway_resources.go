@@ -189,12 +189,9 @@ func newGatewayPodTemplate(cr *v1alpha1.Submariner, name string, podSelectorLabe
 						{Name: "SUBMARINER_BROKER", Value: cr.Spec.Broker},
 						{Name: "SUBMARINER_CABLEDRIVER", Value: cr.Spec.CableDriver},
 						{Name: broker.EnvironmentVariable("ApiServer"), Value: cr.Spec.BrokerK8sApiServer},
-						{Name: broker.EnvironmentVariable("ApiServerToken"), Value: cr.Spec.BrokerK8sApiServerToken},
 						{Name: broker.EnvironmentVariable("RemoteNamespace"), Value: cr.Spec.BrokerK8sRemoteNamespace},
-						{Name: broker.EnvironmentVariable("CA"), Value: cr.Spec.BrokerK8sCA},
 						{Name: broker.EnvironmentVariable("Insecure"), Value: strconv.FormatBool(cr.Spec.BrokerK8sInsecure)},
 						{Name: broker.EnvironmentVariable("Secret"), Value: cr.Spec.BrokerK8sSecret},
-						{Name: "CE_IPSEC_PSK", Value: cr.Spec.CeIPSecPSK},
 						{Name: "CE_IPSEC_PSKSECRET", Value: cr.Spec.CeIPSecPSKSecret},
 						{Name: "CE_IPSEC_DEBUG", Value: strconv.FormatBool(cr.Spec.CeIPSecDebug)},
 						{Name: "SUBMARINER_HEALTHCHECKENABLED", Value: strconv.FormatBool(healthCheckEnabled)},
@@ -244,14 +241,29 @@ func newGatewayPodTemplate(cr *v1alpha1.Submariner, name string, podSelectorLabe
 			corev1.EnvVar{Name: "SUBMARINER_PUBLICIP", Value: "lb:" + loadBalancerName})
 	}
 
+	helpers.ApplyComponentOverrides(&podTemplate, getComponentOverrides(cr).Gateway)
+
 	return podTemplate
 }
 
 // nolint:wrapcheck // No need to wrap errors here.
-func (r *Reconciler) reconcileGatewayDaemonSet(
+func (r *Reconciler) reconcileGatewayDaemonSet(ctx context.Context,
 	instance *v1alpha1.Submariner, reqLogger logr.Logger) (*appsv1.DaemonSet, error) {
-	daemonSet, err := helpers.ReconcileDaemonSet(instance, newGatewayDaemonSet(instance, names.GatewayComponent),
-		reqLogger, r.config.Client, r.config.Scheme)
+	credentialsHash, err := helpers.SecretsHash(ctx, r.config.Client, instance.Namespace, instance.Spec.BrokerK8sSecret,
+		instance.Spec.CeIPSecPSKSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayDaemonSet := newGatewayDaemonSet(instance, names.GatewayComponent)
+	if gatewayDaemonSet.Spec.Template.Annotations == nil {
+		gatewayDaemonSet.Spec.Template.Annotations = map[string]string{}
+	}
+
+	// Rotated credentials are only picked up by restarted gateways
+	gatewayDaemonSet.Spec.Template.Annotations[helpers.CredentialsHashAnnotation] = credentialsHash
+
+	daemonSet, err := helpers.ReconcileDaemonSet(instance, gatewayDaemonSet, reqLogger, r.config.Client, r.config.Scheme)
 	if err != nil {
 		return nil, err
 	}
@@ -262,6 +274,8 @@ func (r *Reconciler) reconcileGatewayDaemonSet(
 	return daemonSet, err
 }
 
+// buildGatewayStatusAndUpdateMetrics returns the status of the given gateways; connection status changes
+// are recorded separately by recordConnectionTransitions, once the status is persisted.
 func buildGatewayStatusAndUpdateMetrics(gateways []submarinerv1.Gateway) []submarinerv1.GatewayStatus {
 	gatewayStatuses := []submarinerv1.GatewayStatus{}
 
@@ -277,11 +291,9 @@ func buildGatewayStatusAndUpdateMetrics(gateways []submarinerv1.Gateway) []subma
 			recordGatewayCreationTime(&gateway.Status.LocalEndpoint, gateway.CreationTimestamp.Time)
 
 			for j := range gateway.Status.Connections {
-				recordConnection(
-					&gateway.Status.LocalEndpoint,
-					&gateway.Status.Connections[j].Endpoint,
-					string(gateway.Status.Connections[j].Status),
-				)
+				connection := &gateway.Status.Connections[j]
+				recordConnection(&gateway.Status.LocalEndpoint, &connection.Endpoint, string(connection.Status))
+				recordConnectionLatency(&gateway.Status.LocalEndpoint, &connection.Endpoint, connection.LatencyRTT)
 			}
 		}
 	} else {
@@ -292,6 +304,42 @@ func buildGatewayStatusAndUpdateMetrics(gateways []submarinerv1.Gateway) []subma
 	return gatewayStatuses
 }
 
+// recordConnectionTransitions updates the connection transition metric with the connection status changes between the
+// previous and the current gateway statuses; it is only called once the statuses are persisted, so that each change is
+// counted once.
+func recordConnectionTransitions(previousStatuses, statuses *[]submarinerv1.GatewayStatus) {
+	if previousStatuses == nil || statuses == nil {
+		return
+	}
+
+	previousConnectionStatuses := map[string]submarinerv1.ConnectionStatus{}
+
+	for i := range *previousStatuses {
+		previous := &(*previousStatuses)[i]
+		for j := range previous.Connections {
+			key := connectionKey(&previous.LocalEndpoint, &previous.Connections[j].Endpoint)
+			previousConnectionStatuses[key] = previous.Connections[j].Status
+		}
+	}
+
+	for i := range *statuses {
+		status := &(*statuses)[i]
+		for j := range status.Connections {
+			connection := &status.Connections[j]
+
+			previousStatus, found := previousConnectionStatuses[connectionKey(&status.LocalEndpoint, &connection.Endpoint)]
+			if found && previousStatus != connection.Status {
+				recordConnectionTransition(&status.LocalEndpoint, &connection.Endpoint, string(previousStatus),
+					string(connection.Status))
+			}
+		}
+	}
+}
+
+func connectionKey(localEndpoint, remoteEndpoint *submarinerv1.EndpointSpec) string {
+	return localEndpoint.Hostname + "/" + remoteEndpoint.ClusterID + "/" + remoteEndpoint.Hostname
+}
+
 func (r *Reconciler) retrieveGateways(ctx context.Context, owner metav1.Object,
 	namespace string) ([]submarinerv1.Gateway, error) {
 	foundGateways := &submarinerv1.GatewayList{}
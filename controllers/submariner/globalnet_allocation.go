@@ -0,0 +1,71 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// consumeGlobalnetAllocation keeps the cluster's global CIDR in line with the one the Broker allocated in the cluster's
+// GlobalnetAllocation, which subctl requests when joining; the Broker remains the authority on the CIDR. Clusters
+// without a global CIDR, or which joined a Broker that doesn't serve GlobalnetAllocations, are left alone.
+func (r *Reconciler) consumeGlobalnetAllocation(ctx context.Context, instance *submopv1a1.Submariner, reqLogger logr.Logger) error {
+	if instance.Spec.GlobalCIDR == "" || instance.Spec.BrokerK8sSecret == "" {
+		return nil
+	}
+
+	brokerConfig, _, err := r.brokerRestConfig(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	brokerClient, err := r.config.BrokerSubmClientFor(brokerConfig)
+	if err != nil {
+		return errors.Wrap(err, "error building the broker client")
+	}
+
+	allocation, err := brokerClient.SubmarinerV1alpha1().GlobalnetAllocations(instance.Spec.BrokerK8sRemoteNamespace).Get(ctx,
+		instance.Spec.ClusterID, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error retrieving the cluster's GlobalnetAllocation from the broker")
+	}
+
+	if !meta.IsStatusConditionTrue(allocation.Status.Conditions, submopv1a1.GlobalnetAllocated) ||
+		allocation.Status.GlobalCIDR == "" || allocation.Status.GlobalCIDR == instance.Spec.GlobalCIDR {
+		return nil
+	}
+
+	reqLogger.Info("Using the global CIDR allocated by the broker", "previous", instance.Spec.GlobalCIDR,
+		"allocated", allocation.Status.GlobalCIDR)
+
+	instance.Spec.GlobalCIDR = allocation.Status.GlobalCIDR
+
+	return errors.Wrap(r.config.Client.Update(ctx, instance), "error updating the Submariner global CIDR")
+}
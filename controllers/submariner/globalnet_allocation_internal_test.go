@@ -0,0 +1,145 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	submarinerclientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
+	fakeOperatorClient "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("consumeGlobalnetAllocation", func() {
+	const (
+		namespace       = "submariner-operator"
+		brokerNamespace = "submariner-k8s-broker"
+	)
+
+	var (
+		instance     *submopv1a1.Submariner
+		allocation   *submopv1a1.GlobalnetAllocation
+		brokerClient *fakeOperatorClient.Clientset
+		reconciler   *Reconciler
+	)
+
+	BeforeEach(func() {
+		Expect(submopv1a1.AddToScheme(scheme.Scheme)).To(Succeed())
+
+		instance = &submopv1a1.Submariner{
+			ObjectMeta: metav1.ObjectMeta{Name: "submariner", Namespace: namespace},
+			Spec: submopv1a1.SubmarinerSpec{
+				ClusterID:                "east",
+				GlobalCIDR:               "242.0.0.0/16",
+				BrokerK8sApiServer:       "broker:6443",
+				BrokerK8sSecret:          "broker-secret",
+				BrokerK8sRemoteNamespace: brokerNamespace,
+			},
+		}
+
+		allocation = &submopv1a1.GlobalnetAllocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "east", Namespace: brokerNamespace},
+			Spec:       submopv1a1.GlobalnetAllocationSpec{ClusterID: "east"},
+			Status: submopv1a1.GlobalnetAllocationStatus{
+				GlobalCIDR: "242.1.0.0/16",
+				Conditions: []metav1.Condition{{
+					Type:   submopv1a1.GlobalnetAllocated,
+					Status: metav1.ConditionTrue,
+					Reason: "CIDRAllocated",
+				}},
+			},
+		}
+
+		brokerClient = nil
+	})
+
+	JustBeforeEach(func() {
+		if brokerClient == nil {
+			brokerClient = fakeOperatorClient.NewSimpleClientset(allocation)
+		}
+
+		brokerSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: instance.Spec.BrokerK8sSecret, Namespace: namespace},
+			Data:       map[string][]byte{broker.SecretTokenKey: []byte("token")},
+		}
+
+		reconciler = NewReconciler(&Config{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(instance, brokerSecret).Build(),
+			BrokerSubmClientFor: func(config *rest.Config) (submarinerclientset.Interface, error) {
+				Expect(config.Host).To(Equal("https://" + instance.Spec.BrokerK8sApiServer))
+				return brokerClient, nil
+			},
+		})
+
+		Expect(reconciler.consumeGlobalnetAllocation(context.TODO(), instance, ctrl.Log)).To(Succeed())
+	})
+
+	globalCIDR := func() string {
+		actual := &submopv1a1.Submariner{}
+		Expect(reconciler.config.Client.Get(context.TODO(), client.ObjectKeyFromObject(instance), actual)).To(Succeed())
+
+		return actual.Spec.GlobalCIDR
+	}
+
+	When("the broker allocated a different global CIDR", func() {
+		It("should update the Submariner global CIDR", func() {
+			Expect(globalCIDR()).To(Equal("242.1.0.0/16"))
+		})
+	})
+
+	When("the broker hasn't allocated the global CIDR", func() {
+		BeforeEach(func() {
+			allocation.Status = submopv1a1.GlobalnetAllocationStatus{}
+		})
+
+		It("should keep the Submariner global CIDR", func() {
+			Expect(globalCIDR()).To(Equal("242.0.0.0/16"))
+		})
+	})
+
+	When("the cluster has no GlobalnetAllocation", func() {
+		BeforeEach(func() {
+			brokerClient = fakeOperatorClient.NewSimpleClientset()
+		})
+
+		It("should keep the Submariner global CIDR", func() {
+			Expect(globalCIDR()).To(Equal("242.0.0.0/16"))
+		})
+	})
+
+	When("globalnet isn't enabled on the cluster", func() {
+		BeforeEach(func() {
+			instance.Spec.GlobalCIDR = ""
+		})
+
+		It("should leave the global CIDR empty", func() {
+			Expect(globalCIDR()).To(BeEmpty())
+		})
+	})
+})
@@ -51,7 +51,7 @@ func newGlobalnetDaemonSet(cr *v1alpha1.Submariner, name string) *appsv1.DaemonS
 		"component": "globalnet",
 	}
 
-	return &appsv1.DaemonSet{
+	daemonSet := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: cr.Namespace,
 			Name:      name,
@@ -108,4 +108,8 @@ func newGlobalnetDaemonSet(cr *v1alpha1.Submariner, name string) *appsv1.DaemonS
 			},
 		},
 	}
+
+	helpers.ApplyComponentOverrides(&daemonSet.Spec.Template, getComponentOverrides(cr).Globalnet)
+
+	return daemonSet
 }
@@ -117,6 +117,8 @@ func newNetworkPluginSyncerDeployment(cr *v1alpha1.Submariner, clusterNetwork *n
 		}
 	}
 
+	helpers.ApplyComponentOverrides(&networkPluginSyncerDeployment.Spec.Template, getComponentOverrides(cr).NetworkPluginSyncer)
+
 	return networkPluginSyncerDeployment
 }
 
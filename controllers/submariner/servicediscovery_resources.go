@@ -41,9 +41,8 @@ func (r *Reconciler) serviceDiscoveryReconciler(ctx context.Context, submariner
 				sd.Spec = v1alpha1.ServiceDiscoverySpec{
 					Version:                  submariner.Spec.Version,
 					Repository:               submariner.Spec.Repository,
-					BrokerK8sCA:              submariner.Spec.BrokerK8sCA,
+					BrokerK8sSecret:          submariner.Spec.BrokerK8sSecret,
 					BrokerK8sRemoteNamespace: submariner.Spec.BrokerK8sRemoteNamespace,
-					BrokerK8sApiServerToken:  submariner.Spec.BrokerK8sApiServerToken,
 					BrokerK8sApiServer:       submariner.Spec.BrokerK8sApiServer,
 					BrokerK8sInsecure:        submariner.Spec.BrokerK8sInsecure,
 					Debug:                    submariner.Spec.Debug,
@@ -52,6 +51,11 @@ func (r *Reconciler) serviceDiscoveryReconciler(ctx context.Context, submariner
 					GlobalnetEnabled:         submariner.Spec.GlobalCIDR != "",
 					ImageOverrides:           submariner.Spec.ImageOverrides,
 					CoreDNSCustomConfig:      submariner.Spec.CoreDNSCustomConfig,
+					UninstallTimeout:         submariner.Spec.UninstallTimeout,
+				}
+
+				if submariner.Spec.ComponentOverrides != nil {
+					sd.Spec.ComponentOverrides = submariner.Spec.ComponentOverrides.ServiceDiscoveryComponentOverrides.DeepCopy()
 				}
 
 				if len(submariner.Spec.CustomDomains) > 0 {
@@ -22,6 +22,7 @@ import (
 	"context"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -33,6 +34,8 @@ import (
 	"github.com/submariner-io/admiral/pkg/util"
 	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
 	"github.com/submariner-io/submariner-operator/controllers/constants"
+	"github.com/submariner-io/submariner-operator/controllers/helpers"
+	"github.com/submariner-io/submariner-operator/controllers/metrics"
 	resourceiface "github.com/submariner-io/submariner-operator/controllers/resource"
 	"github.com/submariner-io/submariner-operator/pkg/broker"
 	submarinerclientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
@@ -41,6 +44,7 @@ import (
 	"github.com/submariner-io/submariner-operator/pkg/gateway"
 	"github.com/submariner-io/submariner-operator/pkg/images"
 	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	submclientset "github.com/submariner-io/submariner/pkg/client/clientset/versioned"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
@@ -51,6 +55,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -64,6 +69,9 @@ const (
 	globalnetMetricsServerPort = 8081
 )
 
+// DefaultNetworkRediscoveryInterval is the default interval at which the cluster network is re-discovered.
+const DefaultNetworkRediscoveryInterval = 5 * time.Minute
+
 var log = logf.Log.WithName("controller_submariner")
 
 type Config struct {
@@ -75,6 +83,15 @@ type Config struct {
 	SubmClient     submarinerclientset.Interface
 	DynClient      dynamic.Interface
 	ClusterNetwork *network.ClusterNetwork
+	EventRecorder  record.EventRecorder
+	// The interval at which the cluster network is re-discovered, to detect changes; zero disables re-discovery.
+	NetworkRediscoveryInterval time.Duration
+	// Creates the client used to read the cluster's GlobalnetAllocation from the broker; defaults to
+	// submarinerclientset.NewForConfig.
+	BrokerSubmClientFor func(config *rest.Config) (submarinerclientset.Interface, error)
+	// Creates the client used to acknowledge the broker credentials on the cluster's Endpoints on the broker; defaults to
+	// submclientset.NewForConfig.
+	BrokerEndpointsClientFor func(config *rest.Config) (submclientset.Interface, error)
 }
 
 // Reconciler reconciles a Submariner object.
@@ -96,9 +113,19 @@ type Reconciler struct {
 	// - watch for changes to the secret, and if it changes, update the target secret.
 	// Tokens map back to their SA, so we can do both the above by watching tokens only.
 	// Since the synchronisation ends up being specific to a Submariner CR secret, we track one syncer per Submariner CR secret name.
-	// We don't keep track of the secret syncers themselves, just their cancel functions.
-	secretSyncCancelFuncs map[string]context.CancelFunc
-	syncerMutex           sync.Mutex
+	// We don't keep track of the secret syncers themselves, just their cancel functions and the credentials they were
+	// started with; when the credentials are rotated, the syncer is restarted with the new ones.
+	secretSyncers map[string]*secretSyncerState
+	syncerMutex   sync.Mutex
+
+	// The time at which the cached cluster network was discovered.
+	networkDiscoveryTime time.Time
+}
+
+type secretSyncerState struct {
+	cancel context.CancelFunc
+	token  string
+	ca     string
 }
 
 // blank assignment to verify that Reconciler implements reconcile.Reconciler.
@@ -106,10 +133,27 @@ var _ reconcile.Reconciler = &Reconciler{}
 
 // NewReconciler returns a new Reconciler.
 func NewReconciler(config *Config) *Reconciler {
+	if config.EventRecorder == nil {
+		// Events are dropped
+		config.EventRecorder = &record.FakeRecorder{}
+	}
+
+	if config.BrokerSubmClientFor == nil {
+		config.BrokerSubmClientFor = func(config *rest.Config) (submarinerclientset.Interface, error) {
+			return submarinerclientset.NewForConfig(config) // nolint:wrapcheck // No need to wrap here
+		}
+	}
+
+	if config.BrokerEndpointsClientFor == nil {
+		config.BrokerEndpointsClientFor = func(config *rest.Config) (submclientset.Interface, error) {
+			return submclientset.NewForConfig(config) // nolint:wrapcheck // No need to wrap here
+		}
+	}
+
 	return &Reconciler{
-		config:                *config,
-		log:                   ctrl.Log.WithName("controllers").WithName("Submariner"),
-		secretSyncCancelFuncs: make(map[string]context.CancelFunc),
+		config:        *config,
+		log:           ctrl.Log.WithName("controllers").WithName("Submariner"),
+		secretSyncers: make(map[string]*secretSyncerState),
 	}
 }
 
@@ -150,50 +194,61 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.runComponentCleanup(ctx, instance)
 	}
 
+	initialStatus := instance.Status.DeepCopy()
+
+	if err := r.migrateInlineCredentials(ctx, instance, reqLogger); err != nil {
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
+	}
+
+	if err := r.consumeGlobalnetAllocation(ctx, instance, reqLogger); err != nil {
+		// Not fatal, the cluster keeps its current global CIDR
+		reqLogger.Error(err, "error retrieving the global CIDR allocated by the broker")
+	}
+
 	// Ensure we have a secret syncer
-	if err := r.setupSecretSyncer(instance, reqLogger, request.Namespace); err != nil {
+	if err := r.setupSecretSyncer(ctx, instance, reqLogger, request.Namespace); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	initialStatus := instance.Status.DeepCopy()
-
 	clusterNetwork, err := r.discoverNetwork(instance)
+	setNetworkDiscoveredCondition(instance, err)
+
 	if err != nil {
-		return reconcile.Result{}, err
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 	}
 
-	gatewayDaemonSet, err := r.reconcileGatewayDaemonSet(instance, reqLogger)
+	gatewayDaemonSet, err := r.reconcileGatewayDaemonSet(ctx, instance, reqLogger)
 	if err != nil {
-		return reconcile.Result{}, err
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 	}
 
 	var loadBalancer *corev1.Service
 	if instance.Spec.LoadBalancerEnabled {
 		loadBalancer, err = r.reconcileLoadBalancer(instance, reqLogger)
 		if err != nil {
-			return reconcile.Result{}, err
+			return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 		}
 	}
 
 	routeagentDaemonSet, err := r.reconcileRouteagentDaemonSet(instance, reqLogger)
 	if err != nil {
-		return reconcile.Result{}, err
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 	}
 
 	var globalnetDaemonSet *appsv1.DaemonSet
 
 	if instance.Spec.GlobalCIDR != "" {
 		if globalnetDaemonSet, err = r.reconcileGlobalnetDaemonSet(instance, reqLogger); err != nil {
-			return reconcile.Result{}, err
+			return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 		}
 	}
 
 	if err := r.reconcileNetworkPluginSyncerDeployment(instance, clusterNetwork, reqLogger); err != nil {
-		return reconcile.Result{}, err
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 	}
 
 	if err := r.serviceDiscoveryReconciler(ctx, instance, reqLogger, instance.Spec.ServiceDiscoveryEnabled); err != nil {
-		return reconcile.Result{}, err
+		return r.failReconcile(ctx, instance, initialStatus, reqLogger, err)
 	}
 
 	// Retrieve the gateway information
@@ -205,6 +260,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	gatewayStatuses := buildGatewayStatusAndUpdateMetrics(gateways)
 
+	if err == nil {
+		instance.Status.GatewayHistory = updateGatewayHistory(instance.Status.GatewayHistory, instance.Status.Gateways,
+			&gatewayStatuses)
+	}
+
 	instance.Status.NatEnabled = instance.Spec.NatEnabled
 	instance.Status.ColorCodes = instance.Spec.ColorCodes
 	instance.Status.ClusterID = instance.Spec.ClusterID
@@ -238,18 +298,67 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		instance.Status.LoadBalancerStatus.Status = nil
 	}
 
-	if !reflect.DeepEqual(instance.Status, initialStatus) {
-		err := r.config.Client.Status().Update(ctx, instance)
-		if err != nil {
-			// Log the error, but indicate success, to avoid reconciliation storms
-			// TODO skitt determine what we should really be doing for concurrent updates to the Submariner CR
-			// Updates fail here because the instance is updated between the .Update() at the start of the function
-			// and the status update here
-			reqLogger.Error(err, "failed to update the Submariner status")
-		}
+	workloads := []helpers.WorkloadStatus{
+		helpers.NewDaemonSetWorkloadStatus(gatewayDaemonSet, &instance.Status.GatewayDaemonSetStatus),
+		helpers.NewDaemonSetWorkloadStatus(routeagentDaemonSet, &instance.Status.RouteAgentDaemonSetStatus),
+	}
+
+	if globalnetDaemonSet != nil {
+		workloads = append(workloads, helpers.NewDaemonSetWorkloadStatus(globalnetDaemonSet, &instance.Status.GlobalnetDaemonSetStatus))
+	}
+
+	helpers.SetWorkloadConditions(&instance.Status.Conditions, workloads)
+	instance.Status.ObservedGeneration = instance.Generation
+
+	r.updateStatus(ctx, instance, initialStatus, reqLogger)
+
+	acknowledged, err := r.acknowledgeBrokerCredentials(ctx, instance, gatewayDaemonSet, reqLogger)
+	if err != nil {
+		// Not fatal, only rotations wait for the acknowledgement
+		reqLogger.Error(err, "error acknowledging the broker credentials")
+	}
+
+	if !acknowledged {
+		// Come back to check on the components restarting with the new credentials
+		return reconcile.Result{RequeueAfter: credentialsRecheckInterval}, nil
+	}
+
+	// Come back to check for network changes
+	return reconcile.Result{RequeueAfter: r.config.NetworkRediscoveryInterval}, nil
+}
+
+// failReconcile records the given reconciliation error in the Submariner status and returns it.
+func (r *Reconciler) failReconcile(ctx context.Context, instance *submopv1a1.Submariner, initialStatus *submopv1a1.SubmarinerStatus,
+	reqLogger logr.Logger, err error) (reconcile.Result, error) {
+	helpers.SetReconcileFailedConditions(&instance.Status.Conditions, err)
+	instance.Status.ObservedGeneration = instance.Generation
+
+	r.updateStatus(ctx, instance, initialStatus, reqLogger)
+
+	return reconcile.Result{}, err
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, instance *submopv1a1.Submariner, initialStatus *submopv1a1.SubmarinerStatus,
+	reqLogger logr.Logger) {
+	if reflect.DeepEqual(instance.Status, *initialStatus) {
+		return
 	}
 
-	return reconcile.Result{}, nil
+	err := r.config.Client.Status().Update(ctx, instance)
+	if err != nil {
+		// Log the error, but indicate success, to avoid reconciliation storms
+		// TODO skitt determine what we should really be doing for concurrent updates to the Submariner CR
+		// Updates fail here because the instance is updated between the .Update() at the start of the function
+		// and the status update here
+		reqLogger.Error(err, "failed to update the Submariner status")
+
+		return
+	}
+
+	// The changes are only reported once they're persisted, otherwise they would be reported again on the next attempt
+	r.recordStatusChangeEvents(instance, initialStatus)
+	recordNewGatewayTransitions(instance.Spec.ClusterID, initialStatus.GatewayHistory, instance.Status.GatewayHistory)
+	recordConnectionTransitions(initialStatus.Gateways, instance.Status.Gateways)
 }
 
 func getImagePath(submariner *submopv1a1.Submariner, imageName, componentName string) string {
@@ -257,6 +366,14 @@ func getImagePath(submariner *submopv1a1.Submariner, imageName, componentName st
 		submariner.Spec.ImageOverrides)
 }
 
+func getComponentOverrides(submariner *submopv1a1.Submariner) *submopv1a1.SubmarinerComponentOverrides {
+	if submariner.Spec.ComponentOverrides == nil {
+		return &submopv1a1.SubmarinerComponentOverrides{}
+	}
+
+	return submariner.Spec.ComponentOverrides
+}
+
 func (r *Reconciler) getSubmariner(ctx context.Context, key types.NamespacedName) (*submopv1a1.Submariner, error) {
 	instance := &submopv1a1.Submariner{}
 
@@ -291,7 +408,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return errors.Wrap(err, "error creating CRDUpdater")
 	}
 
-	if err := gateway.Ensure(crdUpdater); err != nil {
+	if err := gateway.Ensure(crd.WithSkewPolicy(crdUpdater, crd.SkipOlder)); err != nil {
 		return err // nolint:wrapcheck // Errors are already wrapped
 	}
 
@@ -324,77 +441,121 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		// Watch for changes to secondary resource DaemonSets and requeue the owner Submariner
 		Owns(&appsv1.DaemonSet{}).
 		Watches(&source.Kind{Type: &submv1.Gateway{}}, handler.EnqueueRequestsFromMapFunc(mapFn)).
-		Complete(r)
+		// Watch for changes to the credentials, so that rotations roll the gateways
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(mapFn)).
+		Complete(metrics.InstrumentReconciler(metrics.SubmarinerController, r))
 }
 
-func (r *Reconciler) setupSecretSyncer(instance *submopv1a1.Submariner, logger logr.Logger, namespace string) error {
+func (r *Reconciler) setupSecretSyncer(ctx context.Context, instance *submopv1a1.Submariner, logger logr.Logger,
+	namespace string) error {
 	r.syncerMutex.Lock()
 	defer r.syncerMutex.Unlock()
 
-	if instance.Spec.BrokerK8sSecret != "" {
-		if _, ok := r.secretSyncCancelFuncs[instance.Spec.BrokerK8sSecret]; !ok {
-			_, gvr, err := util.ToUnstructuredResource(&corev1.Secret{}, r.config.Client.RESTMapper())
-			if err != nil {
-				return errors.Wrap(err, "error calculating the GVR for the Secret type")
-			}
-			// We can't use files here, we don't have a mounted secret
-			brokerConfig, _, err := resource.GetAuthorizedRestConfigFromData(
-				instance.Spec.BrokerK8sApiServer,
-				instance.Spec.BrokerK8sApiServerToken, // TODO Read the secret
-				instance.Spec.BrokerK8sCA,
-				&rest.TLSClientConfig{Insecure: instance.Spec.BrokerK8sInsecure},
-				*gvr,
-				instance.Spec.BrokerK8sRemoteNamespace)
-			if err != nil {
-				return errors.Wrap(err, "error building an authorized RestConfig for the broker")
-			}
+	if instance.Spec.BrokerK8sSecret == "" {
+		return nil
+	}
 
-			brokerClient, err := dynamic.NewForConfig(brokerConfig)
-			if err != nil {
-				return errors.Wrap(err, "error building a dynamic client for the broker")
-			}
+	// We can't use files here, we don't have a mounted secret; read its contents instead
+	brokerSecret := &corev1.Secret{}
+	if err := r.config.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.BrokerK8sSecret},
+		brokerSecret); err != nil {
+		return errors.Wrapf(err, "error retrieving the broker Secret %q", instance.Spec.BrokerK8sSecret)
+	}
+
+	token, ca := broker.CredentialsFromSecret(brokerSecret)
 
-			secretSyncer, err := syncer.NewResourceSyncer(
-				&syncer.ResourceSyncerConfig{
-					Name:            "Broker secret syncer",
-					ResourceType:    &corev1.Secret{},
-					SourceClient:    brokerClient,
-					SourceNamespace: instance.Spec.BrokerK8sRemoteNamespace,
-					Direction:       syncer.None,
-					RestMapper:      r.config.Client.RESTMapper(),
-					Scheme:          r.config.Scheme,
-					Federator: federate.NewCreateOrUpdateFederator(
-						r.config.DynClient, r.config.Client.RESTMapper(), namespace, ""),
-					Transform: func(from runtime.Object, numRequeues int, op syncer.Operation) (runtime.Object, bool) {
-						secret := from.(*corev1.Secret)
-						logger.V(level.TRACE).Info("Transforming secret", "secret", secret)
-						if saName, ok := secret.ObjectMeta.Annotations["kubernetes.io/service-account.name"]; ok &&
-							saName == broker.ClusterSAName(instance.Spec.ClusterID) {
-							transformedSecret := &corev1.Secret{
-								ObjectMeta: metav1.ObjectMeta{
-									Name: instance.Spec.BrokerK8sSecret,
-								},
-								Type: corev1.SecretTypeOpaque,
-								Data: secret.Data,
-							}
-							logger.V(level.TRACE).Info("Transformed secret", "transformedSecret", transformedSecret)
-							return transformedSecret, false
-						}
+	if state, ok := r.secretSyncers[instance.Spec.BrokerK8sSecret]; ok {
+		if state.token == token && state.ca == ca {
+			return nil
+		}
+
+		// The broker credentials were rotated, the running syncer's are about to be revoked
+		logger.Info("The broker credentials changed, restarting the secret syncer", "secret", instance.Spec.BrokerK8sSecret)
+		state.cancel()
+		delete(r.secretSyncers, instance.Spec.BrokerK8sSecret)
+	}
+
+	_, gvr, err := util.ToUnstructuredResource(&corev1.Secret{}, r.config.Client.RESTMapper())
+	if err != nil {
+		return errors.Wrap(err, "error calculating the GVR for the Secret type")
+	}
+
+	brokerConfig, _, err := resource.GetAuthorizedRestConfigFromData(
+		instance.Spec.BrokerK8sApiServer,
+		token,
+		ca,
+		&rest.TLSClientConfig{Insecure: instance.Spec.BrokerK8sInsecure},
+		*gvr,
+		instance.Spec.BrokerK8sRemoteNamespace)
+	if err != nil {
+		return errors.Wrap(err, "error building an authorized RestConfig for the broker")
+	}
+
+	brokerClient, err := dynamic.NewForConfig(brokerConfig)
+	if err != nil {
+		return errors.Wrap(err, "error building a dynamic client for the broker")
+	}
+
+	secretSyncer, err := syncer.NewResourceSyncer(
+		&syncer.ResourceSyncerConfig{
+			Name:            "Broker secret syncer",
+			ResourceType:    &corev1.Secret{},
+			SourceClient:    brokerClient,
+			SourceNamespace: instance.Spec.BrokerK8sRemoteNamespace,
+			Direction:       syncer.None,
+			RestMapper:      r.config.Client.RESTMapper(),
+			Scheme:          r.config.Scheme,
+			Federator: federate.NewCreateOrUpdateFederator(
+				r.config.DynClient, r.config.Client.RESTMapper(), namespace, ""),
+			Transform: func(from runtime.Object, numRequeues int, op syncer.Operation) (runtime.Object, bool) {
+				secret := from.(*corev1.Secret)
+				logger.V(level.TRACE).Info("Transforming secret", "secret", secret)
+				if secret.Name == broker.IPSecPSKSecretName && instance.Spec.CeIPSecPSKSecret != "" {
+					// The PSK is only stored on the broker when it's rotated; never remove it locally
+					if op == syncer.Delete {
 						return nil, false
-					},
-				})
-			if err != nil {
-				return errors.Wrap(err, "error building a resource syncer for secrets")
-			}
+					}
+					return &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: instance.Spec.CeIPSecPSKSecret,
+						},
+						Type: corev1.SecretTypeOpaque,
+						Data: secret.Data,
+					}, false
+				}
+				// Superseded tokens are pending revocation, and tokens without data haven't been populated yet
+				if secret.Annotations[broker.SupersededAnnotation] == "true" || len(secret.Data[broker.SecretTokenKey]) == 0 {
+					return nil, false
+				}
+				if saName, ok := secret.ObjectMeta.Annotations["kubernetes.io/service-account.name"]; ok &&
+					saName == broker.ClusterSAName(instance.Spec.ClusterID) {
+					transformedSecret := &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: instance.Spec.BrokerK8sSecret,
+						},
+						Type: corev1.SecretTypeOpaque,
+						Data: secret.Data,
+					}
+					logger.V(level.TRACE).Info("Transformed secret", "transformedSecret", transformedSecret)
+					return transformedSecret, false
+				}
+				return nil, false
+			},
+		})
+	if err != nil {
+		return errors.Wrap(err, "error building a resource syncer for secrets")
+	}
 
-			ctx, cancelFunc := context.WithCancel(context.TODO())
-			if err := secretSyncer.Start(ctx.Done()); err != nil {
-				cancelFunc()
-				return errors.Wrap(err, "error starting the secret syncer")
-			}
+	syncerCtx, cancelFunc := context.WithCancel(context.TODO())
+	if err := secretSyncer.Start(syncerCtx.Done()); err != nil {
+		cancelFunc()
+		return errors.Wrap(err, "error starting the secret syncer")
+	}
 
-			r.secretSyncCancelFuncs[instance.Spec.BrokerK8sSecret] = cancelFunc
-		}
+	r.secretSyncers[instance.Spec.BrokerK8sSecret] = &secretSyncerState{
+		cancel: cancelFunc,
+		token:  token,
+		ca:     ca,
 	}
 
 	return nil
@@ -405,9 +566,9 @@ func (r *Reconciler) cancelSecretSyncer(instance *submopv1a1.Submariner) {
 	defer r.syncerMutex.Unlock()
 
 	if instance.Spec.BrokerK8sSecret != "" {
-		if cancelFunc, ok := r.secretSyncCancelFuncs[instance.Spec.BrokerK8sSecret]; ok {
-			cancelFunc()
-			delete(r.secretSyncCancelFuncs, instance.Spec.BrokerK8sSecret)
+		if state, ok := r.secretSyncers[instance.Spec.BrokerK8sSecret]; ok {
+			state.cancel()
+			delete(r.secretSyncers, instance.Spec.BrokerK8sSecret)
 		}
 	}
 }
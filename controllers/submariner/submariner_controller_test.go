@@ -20,6 +20,7 @@ package submariner_test
 
 import (
 	"context"
+	"encoding/base64"
 	"reflect"
 	"time"
 
@@ -27,15 +28,23 @@ import (
 	. "github.com/onsi/gomega"
 	operatorv1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
 	"github.com/submariner-io/submariner-operator/controllers/constants"
+	"github.com/submariner-io/submariner-operator/controllers/helpers"
+	submarinerController "github.com/submariner-io/submariner-operator/controllers/submariner"
 	"github.com/submariner-io/submariner-operator/controllers/test"
 	"github.com/submariner-io/submariner-operator/controllers/uninstall"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
 	"github.com/submariner-io/submariner-operator/pkg/names"
+	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 	routeagent "github.com/submariner-io/submariner/pkg/routeagent_driver/constants"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	fakeDynClient "k8s.io/client-go/dynamic/fake"
+	fakeKubeClient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
 )
 
 const (
@@ -71,6 +80,127 @@ func testReconciliation() {
 			Expect(updated.Status.ServiceCIDR).To(Equal(testDetectedServiceCIDR))
 			Expect(updated.Status.ClusterCIDR).To(Equal(testDetectedClusterCIDR))
 		})
+
+		It("should record an event for the discovered network plugin", func() {
+			t.AssertReconcileSuccess()
+			t.AssertEvent(corev1.EventTypeNormal, submarinerController.ReasonNetworkDiscovered)
+		})
+	})
+
+	When("the cluster network changes", func() {
+		const (
+			changedClusterCIDR = "10.32.0.0/12"
+			changedServiceCIDR = "10.96.0.0/12"
+		)
+
+		BeforeEach(func() {
+			t.submariner.Status.NetworkPlugin = t.clusterNetwork.NetworkPlugin
+			t.submariner.Status.ClusterCIDR = testDetectedClusterCIDR
+			t.submariner.Status.ServiceCIDR = testDetectedServiceCIDR
+
+			t.networkRediscoveryInterval = time.Nanosecond
+			t.dynClient = fakeDynClient.NewSimpleDynamicClient(scheme.Scheme)
+			t.kubeClient = fakeKubeClient.NewSimpleClientset(
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "weave-net",
+						Labels: map[string]string{"name": "weave-net"},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: "weave",
+							Env:  []corev1.EnvVar{{Name: "IPALLOC_RANGE", Value: changedClusterCIDR}},
+						}},
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "kube-apiserver",
+						Labels: map[string]string{"component": "kube-apiserver"},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:    "kube-apiserver",
+							Command: []string{"kube-apiserver", "--service-cluster-ip-range=" + changedServiceCIDR},
+						}},
+					},
+				})
+		})
+
+		It("should re-discover the network and update the status", func() {
+			t.AssertReconcileRequeue()
+
+			updated := t.getSubmariner()
+			Expect(updated.Status.NetworkPlugin).To(Equal(routeagent.NetworkPluginWeaveNet))
+			Expect(updated.Status.ClusterCIDR).To(Equal(changedClusterCIDR))
+			Expect(updated.Status.ServiceCIDR).To(Equal(changedServiceCIDR))
+
+			envMap := test.EnvMapFrom(t.AssertDaemonSet(names.RouteAgentComponent))
+			Expect(envMap).To(HaveKeyWithValue("SUBMARINER_NETWORKPLUGIN", routeagent.NetworkPluginWeaveNet))
+			Expect(envMap).To(HaveKeyWithValue("SUBMARINER_CLUSTERCIDR", changedClusterCIDR))
+			Expect(envMap).To(HaveKeyWithValue("SUBMARINER_SERVICECIDR", changedServiceCIDR))
+		})
+
+		It("should record events for the changes", func() {
+			t.AssertReconcileRequeue()
+			t.AssertEvent(corev1.EventTypeWarning, submarinerController.ReasonNetworkPluginChanged)
+			t.AssertEvent(corev1.EventTypeWarning, submarinerController.ReasonNetworkCIDRsChanged)
+		})
+	})
+
+	When("the active gateway changes", func() {
+		BeforeEach(func() {
+			t.submariner.Status.Gateways = &[]submarinerv1.GatewayStatus{{
+				HAStatus:      submarinerv1.HAStatusActive,
+				LocalEndpoint: submarinerv1.EndpointSpec{Hostname: "gateway-1"},
+			}}
+
+			t.InitClientObjs = append(t.InitClientObjs, &submarinerv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-2",
+					Namespace: submarinerNamespace,
+				},
+				Status: submarinerv1.GatewayStatus{
+					HAStatus:      submarinerv1.HAStatusActive,
+					LocalEndpoint: submarinerv1.EndpointSpec{Hostname: "gateway-2"},
+				},
+			})
+		})
+
+		It("should record a gateway failover event", func() {
+			t.AssertReconcileSuccess()
+			t.AssertEvent(corev1.EventTypeWarning, submarinerController.ReasonGatewayFailover)
+		})
+
+		Context("and the status update fails", func() {
+			BeforeEach(func() {
+				t.Client = &failingStatusClient{Client: t.NewClient()}
+			})
+
+			It("should not record a gateway failover event", func() {
+				t.AssertReconcileSuccess()
+				t.AssertNoEvent(submarinerController.ReasonGatewayFailover)
+			})
+		})
+
+		It("should record the transition in the gateway history", func() {
+			t.AssertReconcileSuccess()
+
+			history := t.getSubmariner().Status.GatewayHistory
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].PreviousActive).To(Equal("gateway-1"))
+			Expect(history[0].NewActive).To(Equal("gateway-2"))
+			Expect(history[0].Reason).To(Equal(submarinerController.GatewayTransitionReplaced))
+		})
+	})
+
+	When("the active gateway is unchanged", func() {
+		It("should not record a gateway failover event", func() {
+			t.AssertReconcileSuccess()
+			t.AssertReconcileSuccess()
+			t.AssertNoEvent(submarinerController.ReasonGatewayFailover)
+			Expect(t.getSubmariner().Status.GatewayHistory).To(BeEmpty())
+		})
 	})
 
 	When("the network details are provided", func() {
@@ -169,6 +299,190 @@ func testReconciliation() {
 		})
 	})
 
+	When("the Submariner resource has an inline IPsec PSK", func() {
+		BeforeEach(func() {
+			t.submariner.Spec.CeIPSecPSK = base64.StdEncoding.EncodeToString([]byte("secret-psk"))
+			t.submariner.Spec.CeIPSecPSKSecret = ""
+		})
+
+		It("should move it into a Secret", func() {
+			t.AssertReconcileSuccess()
+
+			updated := t.getSubmariner()
+			Expect(updated.Spec.CeIPSecPSK).To(BeEmpty())
+			Expect(updated.Spec.CeIPSecPSKSecret).To(Equal(broker.IPSecPSKSecretName))
+
+			secret := &corev1.Secret{}
+			Expect(t.Client.Get(context.TODO(), types.NamespacedName{Namespace: submarinerNamespace, Name: broker.IPSecPSKSecretName},
+				secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue(broker.IPSecPSKSecretKey, []byte("secret-psk")))
+
+			Expect(test.EnvMapFrom(t.AssertDaemonSet(names.GatewayComponent))).To(
+				HaveKeyWithValue("CE_IPSEC_PSKSECRET", broker.IPSecPSKSecretName))
+		})
+	})
+
+	When("the Submariner resource has an invalid inline IPsec PSK", func() {
+		BeforeEach(func() {
+			t.submariner.Spec.CeIPSecPSK = "not base64"
+		})
+
+		It("should report the failure in the status", func() {
+			t.AssertReconcileError()
+
+			t.assertCondition(operatorv1.ConditionReady, metav1.ConditionFalse)
+			t.assertCondition(operatorv1.ConditionDegraded, metav1.ConditionTrue)
+		})
+	})
+
+	When("the IPsec PSK is rotated", func() {
+		BeforeEach(func() {
+			t.InitClientObjs = append(t.InitClientObjs, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: submarinerNamespace, Name: t.submariner.Spec.CeIPSecPSKSecret},
+				Data:       map[string][]byte{broker.IPSecPSKSecretKey: []byte("old-psk")},
+			})
+		})
+
+		It("should roll the gateway DaemonSet", func() {
+			t.AssertReconcileSuccess()
+
+			initialHash := t.AssertDaemonSet(names.GatewayComponent).Spec.Template.Annotations[helpers.CredentialsHashAnnotation]
+			Expect(initialHash).ToNot(BeEmpty())
+
+			secret := &corev1.Secret{}
+			Expect(t.Client.Get(context.TODO(), types.NamespacedName{Namespace: submarinerNamespace, Name: t.submariner.Spec.CeIPSecPSKSecret},
+				secret)).To(Succeed())
+			secret.Data[broker.IPSecPSKSecretKey] = []byte("new-psk")
+			Expect(t.Client.Update(context.TODO(), secret)).To(Succeed())
+
+			t.AssertReconcileSuccess()
+
+			Expect(t.AssertDaemonSet(names.GatewayComponent).Spec.Template.Annotations).To(
+				HaveKeyWithValue(helpers.CredentialsHashAnnotation, Not(Equal(initialHash))))
+		})
+	})
+
+	When("component overrides are specified", func() {
+		BeforeEach(func() {
+			t.submariner.Spec.ComponentOverrides = &operatorv1.SubmarinerComponentOverrides{
+				Gateway: &operatorv1.ComponentOverrides{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+					},
+					Tolerations:       []corev1.Toleration{{Key: "dedicated", Value: "gateway", Effect: corev1.TaintEffectNoSchedule}},
+					NodeSelector:      map[string]string{"node-role": "edge"},
+					PriorityClassName: "system-node-critical",
+					Labels:            map[string]string{"app": "bogus", "team": "networking"},
+					Annotations:       map[string]string{"example.io/audit": "true"},
+				},
+			}
+		})
+
+		It("should merge them into the component pod templates", func() {
+			t.AssertReconcileSuccess()
+
+			podTemplate := t.AssertDaemonSet(names.GatewayComponent).Spec.Template
+			Expect(podTemplate.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("100m"))
+			Expect(podTemplate.Spec.Tolerations).To(ContainElement(corev1.Toleration{
+				Key: "dedicated", Value: "gateway", Effect: corev1.TaintEffectNoSchedule,
+			}))
+			Expect(podTemplate.Spec.NodeSelector).To(HaveKeyWithValue("submariner.io/gateway", "true"))
+			Expect(podTemplate.Spec.NodeSelector).To(HaveKeyWithValue("node-role", "edge"))
+			Expect(podTemplate.Spec.Affinity.PodAntiAffinity).ToNot(BeNil())
+			Expect(podTemplate.Spec.PriorityClassName).To(Equal("system-node-critical"))
+			Expect(podTemplate.Labels).To(HaveKeyWithValue("app", names.GatewayComponent))
+			Expect(podTemplate.Labels).To(HaveKeyWithValue("team", "networking"))
+			Expect(podTemplate.Annotations).To(HaveKeyWithValue("example.io/audit", "true"))
+
+			Expect(t.AssertDaemonSet(names.RouteAgentComponent).Spec.Template.Spec.PriorityClassName).To(BeEmpty())
+		})
+
+		It("should update the component when they change", func() {
+			t.AssertReconcileSuccess()
+
+			initial := t.getSubmariner()
+			initial.Spec.ComponentOverrides.RouteAgent = &operatorv1.ComponentOverrides{
+				Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key: "kubernetes.io/os", Operator: corev1.NodeSelectorOpIn, Values: []string{"linux"},
+						}}}},
+					},
+				}},
+			}
+			Expect(t.Client.Update(context.TODO(), initial)).To(Succeed())
+
+			t.AssertReconcileSuccess()
+
+			podSpec := t.AssertDaemonSet(names.RouteAgentComponent).Spec.Template.Spec
+			Expect(podSpec.Affinity).ToNot(BeNil())
+			Expect(podSpec.Affinity.NodeAffinity).To(Equal(initial.Spec.ComponentOverrides.RouteAgent.Affinity.NodeAffinity))
+			Expect(podSpec.Tolerations).To(Equal([]corev1.Toleration{{Operator: corev1.TolerationOpExists}}))
+		})
+	})
+
+	When("the component DaemonSets are not yet ready", func() {
+		It("should report the Submariner resource as not ready", func() {
+			t.AssertReconcileSuccess()
+
+			for _, name := range []string{names.GatewayComponent, names.RouteAgentComponent, names.GlobalnetComponent} {
+				t.UpdateDaemonSetToScheduled(t.AssertDaemonSet(name))
+			}
+
+			t.AssertReconcileSuccess()
+
+			t.assertCondition(operatorv1.ConditionNetworkDiscovered, metav1.ConditionTrue)
+			t.assertCondition(operatorv1.ConditionReady, metav1.ConditionFalse)
+			t.assertCondition(operatorv1.ConditionProgressing, metav1.ConditionTrue)
+			t.assertCondition(operatorv1.ConditionDegraded, metav1.ConditionFalse)
+			Expect(t.getSubmariner().Status.ObservedGeneration).To(Equal(t.getSubmariner().Generation))
+		})
+	})
+
+	When("the component DaemonSets are ready", func() {
+		It("should report the Submariner resource as ready", func() {
+			t.AssertReconcileSuccess()
+
+			for _, name := range []string{names.GatewayComponent, names.RouteAgentComponent, names.GlobalnetComponent} {
+				t.UpdateDaemonSetToReady(t.AssertDaemonSet(name))
+			}
+
+			t.AssertReconcileSuccess()
+
+			t.assertCondition(operatorv1.ConditionReady, metav1.ConditionTrue)
+			t.assertCondition(operatorv1.ConditionProgressing, metav1.ConditionFalse)
+			t.assertCondition(operatorv1.ConditionDegraded, metav1.ConditionFalse)
+		})
+	})
+
+	When("a component container is crash looping", func() {
+		BeforeEach(func() {
+			t.InitClientObjs = append(t.InitClientObjs, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: t.submariner.Namespace,
+					Name:      names.GatewayComponent + "-pod",
+					Labels:    map[string]string{"app": names.GatewayComponent},
+				},
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{
+						{
+							State: corev1.ContainerState{
+								Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+							},
+						},
+					},
+				},
+			})
+		})
+
+		It("should report the Submariner resource as degraded", func() {
+			t.AssertReconcileSuccess()
+
+			condition := t.assertCondition(operatorv1.ConditionDegraded, metav1.ConditionTrue)
+			Expect(condition.Message).To(ContainSubstring("CrashLoopBackOff"))
+		})
+	})
+
 	When("the submariner globalnet DaemonSet doesn't exist", func() {
 		It("should create it", func() {
 			t.AssertReconcileSuccess()
@@ -187,6 +501,22 @@ func testReconciliation() {
 		})
 	})
 
+	When("ServiceDiscovery is enabled", func() {
+		BeforeEach(func() {
+			t.submariner.Spec.ServiceDiscoveryEnabled = true
+			t.submariner.Spec.UninstallTimeout = &metav1.Duration{Duration: 5 * time.Minute}
+		})
+
+		It("should create the ServiceDiscovery resource with the Submariner uninstall timeout", func() {
+			t.AssertReconcileSuccess()
+
+			serviceDiscovery := &operatorv1.ServiceDiscovery{}
+			Expect(t.Client.Get(context.TODO(), types.NamespacedName{Name: names.ServiceDiscoveryCrName, Namespace: submarinerNamespace},
+				serviceDiscovery)).To(Succeed())
+			Expect(serviceDiscovery.Spec.UninstallTimeout).To(Equal(t.submariner.Spec.UninstallTimeout))
+		})
+	})
+
 	When("the Submariner resource doesn't exist", func() {
 		BeforeEach(func() {
 			t.InitClientObjs = nil
@@ -279,6 +609,10 @@ func testDeletion() {
 			t.AssertNoDaemonSet(names.GlobalnetComponent)
 			t.AssertNoDeployment(names.NetworkPluginSyncerComponent)
 
+			Expect(t.assertCondition(operatorv1.ConditionUninstalled, metav1.ConditionFalse).Reason).To(Equal("InProgress"))
+			Expect(t.assertCondition("Gateway"+operatorv1.ConditionUninstalled, metav1.ConditionFalse).Reason).To(
+				Equal("AwaitingPodsDeletion"))
+
 			// Simulate the gateway DaemonSet controller cleaning up its pods.
 			t.DeletePods("app", names.GatewayComponent)
 
@@ -298,6 +632,10 @@ func testDeletion() {
 			// Next, the controller should again requeue b/c the gateway DaemonSet isn't ready yet.
 			t.AssertReconcileRequeue()
 
+			t.assertCondition("RouteAgent"+operatorv1.ConditionUninstalled, metav1.ConditionTrue)
+			Expect(t.assertCondition("Globalnet"+operatorv1.ConditionUninstalled, metav1.ConditionFalse).Reason).To(
+				Equal("AwaitingUninstall"))
+
 			// Now update the globalnet DaemonSet to ready.
 			t.UpdateDaemonSetToReady(globalnetDS)
 
@@ -313,6 +651,7 @@ func testDeletion() {
 			t.AssertNoDeployment(names.AppendUninstall(names.NetworkPluginSyncerComponent))
 
 			t.awaitNoFinalizer()
+			t.assertCondition(operatorv1.ConditionUninstalled, metav1.ConditionTrue)
 		})
 	})
 
@@ -341,6 +680,9 @@ func testDeletion() {
 			t.AssertNoDaemonSet(names.AppendUninstall(names.RouteAgentComponent))
 
 			t.awaitNoFinalizer()
+
+			Expect(t.assertCondition("Globalnet"+operatorv1.ConditionUninstalled, metav1.ConditionTrue).Reason).To(
+				Equal("NotInstalled"))
 		})
 	})
 
@@ -349,22 +691,44 @@ func testDeletion() {
 			t.submariner.Spec.GlobalCIDR = ""
 		})
 
-		It("should delete it", func() {
+		It("should delete it and keep the finalizer until the uninstall is retried", func() {
 			t.AssertReconcileRequeue()
 
 			t.UpdateDaemonSetToReady(t.assertUninstallGatewayDaemonSet())
 			t.UpdateDaemonSetToScheduled(t.assertUninstallRouteAgentDaemonSet())
 
+			t.AssertReconcileRequeue()
+
+			submariner := t.getSubmariner()
 			ts := metav1.NewTime(time.Now().Add(-(uninstall.ComponentReadyTimeout + 10)))
-			t.submariner.SetDeletionTimestamp(&ts)
-			Expect(t.Client.Update(context.TODO(), t.submariner)).To(Succeed())
+			submariner.SetDeletionTimestamp(&ts)
+			Expect(t.Client.Update(context.TODO(), submariner)).To(Succeed())
 
 			t.AssertReconcileSuccess()
 
 			t.AssertNoDaemonSet(names.AppendUninstall(names.GatewayComponent))
 			t.AssertNoDaemonSet(names.AppendUninstall(names.RouteAgentComponent))
 
+			t.awaitFinalizer()
+			Expect(t.assertCondition(operatorv1.ConditionUninstalled, metav1.ConditionFalse).Reason).To(Equal("TimedOut"))
+			t.assertCondition("Gateway"+operatorv1.ConditionUninstalled, metav1.ConditionTrue)
+			Expect(t.assertCondition("RouteAgent"+operatorv1.ConditionUninstalled, metav1.ConditionFalse).Reason).To(
+				Equal("TimedOut"))
+
+			// Retry with a longer timeout.
+			submariner = t.getSubmariner()
+			submariner.Spec.UninstallTimeout = &metav1.Duration{Duration: uninstall.ComponentReadyTimeout * 2}
+			Expect(t.Client.Update(context.TODO(), submariner)).To(Succeed())
+
+			t.AssertReconcileRequeue()
+
+			t.UpdateDaemonSetToReady(t.assertUninstallGatewayDaemonSet())
+			t.UpdateDaemonSetToReady(t.assertUninstallRouteAgentDaemonSet())
+
+			t.AssertReconcileSuccess()
+
 			t.awaitNoFinalizer()
+			t.assertCondition(operatorv1.ConditionUninstalled, metav1.ConditionTrue)
 		})
 	})
 
@@ -32,6 +32,9 @@ const (
 	connectionsRemoteClusterLabel  = "remote_cluster"
 	connectionsRemoteHostnameLabel = "remote_hostname"
 	connectionsStatusLabel         = "status"
+	connectionsFromStatusLabel     = "from_status"
+	connectionsToStatusLabel       = "to_status"
+	gatewayTransitionReasonLabel   = "reason"
 )
 
 var (
@@ -64,10 +67,66 @@ var (
 			connectionsStatusLabel,
 		},
 	)
+	connectionLatencyMinGauge     = newConnectionLatencyGauge("min", "Minimum")
+	connectionLatencyAverageGauge = newConnectionLatencyGauge("average", "Average")
+	connectionLatencyMaxGauge     = newConnectionLatencyGauge("max", "Maximum")
+	connectionLatencyHistogram    = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "submariner_connection_latency_seconds",
+			Help: "Average round-trip time of connections (by cluster), observed whenever the gateway status is reconciled",
+			// 0.5ms to 4s
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		},
+		[]string{
+			connectionsLocalClusterLabel,
+			connectionsRemoteClusterLabel,
+		},
+	)
+	connectionTransitionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "submariner_connection_state_transitions_total",
+			Help: "Number of connection status changes (by endpoint and status)",
+		},
+		[]string{
+			connectionsLocalClusterLabel,
+			connectionsLocalHostnameLabel,
+			connectionsRemoteClusterLabel,
+			connectionsRemoteHostnameLabel,
+			connectionsFromStatusLabel,
+			connectionsToStatusLabel,
+		},
+	)
+	gatewayTransitionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "submariner_gateway_transitions_total",
+			Help: "Number of changes of the active gateway (by cluster and reason)",
+		},
+		[]string{
+			connectionsLocalClusterLabel,
+			gatewayTransitionReasonLabel,
+		},
+	)
 )
 
 func init() {
-	metrics.Registry.MustRegister(gatewaysGauge, connectionsGauge, gatewayCreationTimeGauge)
+	metrics.Registry.MustRegister(gatewaysGauge, connectionsGauge, gatewayCreationTimeGauge, connectionLatencyMinGauge,
+		connectionLatencyAverageGauge, connectionLatencyMaxGauge, connectionLatencyHistogram, connectionTransitionsCounter,
+		gatewayTransitionsCounter)
+}
+
+func newConnectionLatencyGauge(statistic, description string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "submariner_connection_latency_" + statistic + "_seconds",
+			Help: description + " round-trip time of connections (by endpoint)",
+		},
+		[]string{
+			connectionsLocalClusterLabel,
+			connectionsLocalHostnameLabel,
+			connectionsRemoteClusterLabel,
+			connectionsRemoteHostnameLabel,
+		},
+	)
 }
 
 func recordGateways(count int) {
@@ -87,6 +146,9 @@ func recordGatewayCreationTime(localEndpoint *submv1.EndpointSpec, upTime time.T
 
 func recordNoConnections() {
 	connectionsGauge.Reset()
+	connectionLatencyMinGauge.Reset()
+	connectionLatencyAverageGauge.Reset()
+	connectionLatencyMaxGauge.Reset()
 }
 
 func recordConnection(localEndpoint, remoteEndpoint *submv1.EndpointSpec, status string) {
@@ -98,3 +160,51 @@ func recordConnection(localEndpoint, remoteEndpoint *submv1.EndpointSpec, status
 		connectionsStatusLabel:         status,
 	}).Inc()
 }
+
+func recordConnectionLatency(localEndpoint, remoteEndpoint *submv1.EndpointSpec, latency *submv1.LatencyRTTSpec) {
+	if latency == nil {
+		return
+	}
+
+	labels := prometheus.Labels{
+		connectionsLocalClusterLabel:   localEndpoint.ClusterID,
+		connectionsLocalHostnameLabel:  localEndpoint.Hostname,
+		connectionsRemoteClusterLabel:  remoteEndpoint.ClusterID,
+		connectionsRemoteHostnameLabel: remoteEndpoint.Hostname,
+	}
+
+	// The gateways report latencies as durations; anything else is ignored
+	if min, err := time.ParseDuration(latency.Min); err == nil {
+		connectionLatencyMinGauge.With(labels).Set(min.Seconds())
+	}
+
+	if max, err := time.ParseDuration(latency.Max); err == nil {
+		connectionLatencyMaxGauge.With(labels).Set(max.Seconds())
+	}
+
+	if average, err := time.ParseDuration(latency.Average); err == nil {
+		connectionLatencyAverageGauge.With(labels).Set(average.Seconds())
+		connectionLatencyHistogram.With(prometheus.Labels{
+			connectionsLocalClusterLabel:  localEndpoint.ClusterID,
+			connectionsRemoteClusterLabel: remoteEndpoint.ClusterID,
+		}).Observe(average.Seconds())
+	}
+}
+
+func recordConnectionTransition(localEndpoint, remoteEndpoint *submv1.EndpointSpec, fromStatus, toStatus string) {
+	connectionTransitionsCounter.With(prometheus.Labels{
+		connectionsLocalClusterLabel:   localEndpoint.ClusterID,
+		connectionsLocalHostnameLabel:  localEndpoint.Hostname,
+		connectionsRemoteClusterLabel:  remoteEndpoint.ClusterID,
+		connectionsRemoteHostnameLabel: remoteEndpoint.Hostname,
+		connectionsFromStatusLabel:     fromStatus,
+		connectionsToStatusLabel:       toStatus,
+	}).Inc()
+}
+
+func recordGatewayTransition(clusterID, reason string) {
+	gatewayTransitionsCounter.With(prometheus.Labels{
+		connectionsLocalClusterLabel: clusterID,
+		gatewayTransitionReasonLabel: reason,
+	}).Inc()
+}
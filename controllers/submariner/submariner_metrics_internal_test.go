@@ -0,0 +1,176 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submariner
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	controllerClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("buildGatewayStatusAndUpdateMetrics", func() {
+	var (
+		gateways []submarinerv1.Gateway
+		previous *[]submarinerv1.GatewayStatus
+	)
+
+	localEndpoint := submarinerv1.EndpointSpec{ClusterID: "east", Hostname: "gw-east"}
+	remoteEndpoint := submarinerv1.EndpointSpec{ClusterID: "west", Hostname: "gw-west"}
+
+	endpointLabels := prometheus.Labels{
+		connectionsLocalClusterLabel:   "east",
+		connectionsLocalHostnameLabel:  "gw-east",
+		connectionsRemoteClusterLabel:  "west",
+		connectionsRemoteHostnameLabel: "gw-west",
+	}
+
+	gatewayWithConnection := func(status submarinerv1.ConnectionStatus, latency *submarinerv1.LatencyRTTSpec) submarinerv1.Gateway {
+		return submarinerv1.Gateway{
+			Status: submarinerv1.GatewayStatus{
+				LocalEndpoint: localEndpoint,
+				Connections: []submarinerv1.Connection{{
+					Status:     status,
+					Endpoint:   remoteEndpoint,
+					LatencyRTT: latency,
+				}},
+			},
+		}
+	}
+
+	transitions := func(from, to submarinerv1.ConnectionStatus) float64 {
+		labels := prometheus.Labels{connectionsFromStatusLabel: string(from), connectionsToStatusLabel: string(to)}
+		for k, v := range endpointLabels {
+			labels[k] = v
+		}
+
+		return testutil.ToFloat64(connectionTransitionsCounter.With(labels))
+	}
+
+	BeforeEach(func() {
+		connectionTransitionsCounter.Reset()
+		connectionLatencyHistogram.Reset()
+
+		previous = nil
+		gateways = []submarinerv1.Gateway{gatewayWithConnection(submarinerv1.Connected, &submarinerv1.LatencyRTTSpec{
+			Min:     "1ms",
+			Average: "1.5ms",
+			Max:     "2ms",
+		})}
+	})
+
+	JustBeforeEach(func() {
+		statuses := buildGatewayStatusAndUpdateMetrics(gateways)
+		recordConnectionTransitions(previous, &statuses)
+	})
+
+	It("should record the connection latencies in seconds", func() {
+		Expect(testutil.ToFloat64(connectionLatencyMinGauge.With(endpointLabels))).To(Equal(0.001))
+		Expect(testutil.ToFloat64(connectionLatencyAverageGauge.With(endpointLabels))).To(Equal(0.0015))
+		Expect(testutil.ToFloat64(connectionLatencyMaxGauge.With(endpointLabels))).To(Equal(0.002))
+		Expect(testutil.CollectAndCount(connectionLatencyHistogram)).To(Equal(1))
+	})
+
+	When("there is no previous status", func() {
+		It("should not record any state transition", func() {
+			Expect(testutil.CollectAndCount(connectionTransitionsCounter)).To(BeZero())
+		})
+	})
+
+	When("the connection status changed", func() {
+		BeforeEach(func() {
+			previous = &[]submarinerv1.GatewayStatus{gatewayWithConnection(submarinerv1.Connecting, nil).Status}
+		})
+
+		It("should record the state transition", func() {
+			Expect(transitions(submarinerv1.Connecting, submarinerv1.Connected)).To(Equal(float64(1)))
+		})
+	})
+
+	When("the connection status is unchanged", func() {
+		BeforeEach(func() {
+			previous = &[]submarinerv1.GatewayStatus{gatewayWithConnection(submarinerv1.Connected, nil).Status}
+		})
+
+		It("should not record any state transition", func() {
+			Expect(testutil.CollectAndCount(connectionTransitionsCounter)).To(BeZero())
+		})
+	})
+})
+
+var _ = Describe("updateStatus", func() {
+	var (
+		instance *submopv1a1.Submariner
+		client   controllerClient.Client
+	)
+
+	connectionStatus := func(status submarinerv1.ConnectionStatus) *[]submarinerv1.GatewayStatus {
+		return &[]submarinerv1.GatewayStatus{{
+			LocalEndpoint: submarinerv1.EndpointSpec{ClusterID: "east", Hostname: "gw-east"},
+			Connections: []submarinerv1.Connection{{
+				Status:   status,
+				Endpoint: submarinerv1.EndpointSpec{ClusterID: "west", Hostname: "gw-west"},
+			}},
+		}}
+	}
+
+	BeforeEach(func() {
+		Expect(submopv1a1.AddToScheme(scheme.Scheme)).To(Succeed())
+		connectionTransitionsCounter.Reset()
+
+		instance = &submopv1a1.Submariner{
+			ObjectMeta: metav1.ObjectMeta{Name: "submariner", Namespace: "submariner-operator"},
+			Status:     submopv1a1.SubmarinerStatus{Gateways: connectionStatus(submarinerv1.Connecting)},
+		}
+
+		client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(instance.DeepCopy()).Build()
+	})
+
+	JustBeforeEach(func() {
+		initialStatus := instance.Status.DeepCopy()
+		instance.Status.Gateways = connectionStatus(submarinerv1.Connected)
+
+		NewReconciler(&Config{Client: client}).updateStatus(context.TODO(), instance, initialStatus, ctrl.Log)
+	})
+
+	When("the status is updated", func() {
+		It("should record the connection transitions", func() {
+			Expect(testutil.CollectAndCount(connectionTransitionsCounter)).To(Equal(1))
+		})
+	})
+
+	When("the status update fails", func() {
+		BeforeEach(func() {
+			client = fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		})
+
+		It("should not record the connection transitions", func() {
+			Expect(testutil.CollectAndCount(connectionTransitionsCounter)).To(BeZero())
+		})
+	})
+})
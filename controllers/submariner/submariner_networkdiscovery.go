@@ -19,17 +19,23 @@ package submariner
 
 import (
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/pkg/errors"
 	submopv1a1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
 	"github.com/submariner-io/submariner-operator/pkg/discovery/network"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func (r *Reconciler) getClusterNetwork(submariner *submopv1a1.Submariner) (*network.ClusterNetwork, error) {
 	const UnknownPlugin = "unknown"
 
-	// If a previously cached discovery exists, use that
-	if r.config.ClusterNetwork != nil && r.config.ClusterNetwork.NetworkPlugin != UnknownPlugin {
+	knownNetwork := r.config.ClusterNetwork != nil && r.config.ClusterNetwork.NetworkPlugin != UnknownPlugin
+
+	// If a previously cached discovery exists, use that until it's due to be refreshed
+	if knownNetwork && !r.networkRediscoveryDue() {
 		return r.config.ClusterNetwork, nil
 	}
 
@@ -38,12 +44,26 @@ func (r *Reconciler) getClusterNetwork(submariner *submopv1a1.Submariner) (*netw
 		log.Error(err, "Error trying to discover network")
 	}
 
-	if clusterNetwork != nil {
-		log.Info("Cluster network discovered")
+	r.networkDiscoveryTime = time.Now()
+
+	switch {
+	case clusterNetwork != nil:
+		if knownNetwork && !reflect.DeepEqual(clusterNetwork, r.config.ClusterNetwork) {
+			log.Info("Cluster network changed", "previousPlugin", r.config.ClusterNetwork.NetworkPlugin,
+				"previousClusterCIDRs", r.config.ClusterNetwork.PodCIDRs,
+				"previousServiceCIDRs", r.config.ClusterNetwork.ServiceCIDRs)
+		} else {
+			log.Info("Cluster network discovered")
+		}
 
 		r.config.ClusterNetwork = clusterNetwork
 		clusterNetwork.Log(log)
-	} else {
+	case knownNetwork:
+		// Keep using the previous discovery rather than forgetting the network because of a transient failure
+		log.Info("Cluster network re-discovery failed, keeping the previously discovered network")
+
+		return r.config.ClusterNetwork, nil
+	default:
 		r.config.ClusterNetwork = &network.ClusterNetwork{NetworkPlugin: UnknownPlugin}
 		log.Info("No cluster network discovered")
 	}
@@ -51,6 +71,10 @@ func (r *Reconciler) getClusterNetwork(submariner *submopv1a1.Submariner) (*netw
 	return r.config.ClusterNetwork, errors.Wrap(err, "error discovering cluster network")
 }
 
+func (r *Reconciler) networkRediscoveryDue() bool {
+	return r.config.NetworkRediscoveryInterval > 0 && time.Since(r.networkDiscoveryTime) >= r.config.NetworkRediscoveryInterval
+}
+
 func (r *Reconciler) discoverNetwork(submariner *submopv1a1.Submariner) (*network.ClusterNetwork, error) {
 	clusterNetwork, err := r.getClusterNetwork(submariner)
 	submariner.Status.ClusterCIDR = getCIDR(
@@ -71,6 +95,27 @@ func (r *Reconciler) discoverNetwork(submariner *submopv1a1.Submariner) (*networ
 	return clusterNetwork, err
 }
 
+func setNetworkDiscoveredCondition(submariner *submopv1a1.Submariner, err error) {
+	condition := metav1.Condition{
+		Type:    submopv1a1.ConditionNetworkDiscovered,
+		Status:  metav1.ConditionTrue,
+		Reason:  "NetworkDiscovered",
+		Message: fmt.Sprintf("Network plugin %q", submariner.Status.NetworkPlugin),
+	}
+
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "DiscoveryFailed"
+		condition.Message = err.Error()
+	} else if submariner.Status.ClusterCIDR == "" || submariner.Status.ServiceCIDR == "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "CIDRsUnknown"
+		condition.Message = "The cluster and service CIDRs could not be determined"
+	}
+
+	meta.SetStatusCondition(&submariner.Status.Conditions, condition)
+}
+
 func getCIDR(cidrType, currentCIDR string, detectedCIDRs []string) string {
 	detected := getFirstCIDR(detectedCIDRs)
 
@@ -20,9 +20,11 @@ package submariner_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -37,8 +39,11 @@ import (
 	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	controllerClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -60,8 +65,11 @@ func TestSubmariner(t *testing.T) {
 
 type testDriver struct {
 	test.Driver
-	submariner     *operatorv1.Submariner
-	clusterNetwork *network.ClusterNetwork
+	submariner                 *operatorv1.Submariner
+	clusterNetwork             *network.ClusterNetwork
+	kubeClient                 kubernetes.Interface
+	dynClient                  dynamic.Interface
+	networkRediscoveryInterval time.Duration
 }
 
 func newTestDriver() *testDriver {
@@ -82,15 +90,23 @@ func newTestDriver() *testDriver {
 			ServiceCIDRs:  []string{testDetectedServiceCIDR},
 			PodCIDRs:      []string{testDetectedClusterCIDR},
 		}
+
+		t.kubeClient = nil
+		t.dynClient = nil
+		t.networkRediscoveryInterval = 0
 	})
 
 	JustBeforeEach(func() {
 		t.JustBeforeEach()
 
 		t.Controller = submarinerController.NewReconciler(&submarinerController.Config{
-			Client:         t.Client,
-			Scheme:         scheme.Scheme,
-			ClusterNetwork: t.clusterNetwork,
+			Client:                     t.Client,
+			Scheme:                     scheme.Scheme,
+			ClusterNetwork:             t.clusterNetwork,
+			EventRecorder:              t.EventRecorder,
+			KubeClient:                 t.kubeClient,
+			DynClient:                  t.dynClient,
+			NetworkRediscoveryInterval: t.networkRediscoveryInterval,
 		})
 	})
 
@@ -113,6 +129,14 @@ func (t *testDriver) getSubmariner() *operatorv1.Submariner {
 	return obj
 }
 
+func (t *testDriver) assertCondition(conditionType string, status metav1.ConditionStatus) *metav1.Condition {
+	condition := meta.FindStatusCondition(t.getSubmariner().Status.Conditions, conditionType)
+	Expect(condition).ToNot(BeNil(), "Condition %q not found", conditionType)
+	Expect(condition.Status).To(Equal(status), "Unexpected status for condition %q: %s", conditionType, condition.Message)
+
+	return condition
+}
+
 func (t *testDriver) assertRouteAgentDaemonSet() {
 	daemonSet := t.AssertDaemonSet(names.RouteAgentComponent)
 
@@ -165,13 +189,14 @@ func (t *testDriver) assertUninstallGatewayDaemonSet() *appsv1.DaemonSet {
 }
 
 func (t *testDriver) assertGatewayDaemonSetEnv(submariner *operatorv1.Submariner, envMap map[string]string) {
-	Expect(envMap).To(HaveKeyWithValue("CE_IPSEC_PSK", submariner.Spec.CeIPSecPSK))
+	Expect(envMap).To(HaveKeyWithValue("CE_IPSEC_PSKSECRET", submariner.Spec.CeIPSecPSKSecret))
+	Expect(envMap).ToNot(HaveKey("CE_IPSEC_PSK"))
 	Expect(envMap).To(HaveKeyWithValue("CE_IPSEC_IKEPORT", strconv.Itoa(submariner.Spec.CeIPSecIKEPort)))
 	Expect(envMap).To(HaveKeyWithValue("CE_IPSEC_NATTPORT", strconv.Itoa(submariner.Spec.CeIPSecNATTPort)))
 	Expect(envMap).To(HaveKeyWithValue(broker.EnvironmentVariable("RemoteNamespace"), submariner.Spec.BrokerK8sRemoteNamespace))
 	Expect(envMap).To(HaveKeyWithValue(broker.EnvironmentVariable("ApiServer"), submariner.Spec.BrokerK8sApiServer))
-	Expect(envMap).To(HaveKeyWithValue(broker.EnvironmentVariable("ApiServerToken"), submariner.Spec.BrokerK8sApiServerToken))
-	Expect(envMap).To(HaveKeyWithValue(broker.EnvironmentVariable("CA"), submariner.Spec.BrokerK8sCA))
+	Expect(envMap).ToNot(HaveKey(broker.EnvironmentVariable("ApiServerToken")))
+	Expect(envMap).ToNot(HaveKey(broker.EnvironmentVariable("CA")))
 	Expect(envMap).To(HaveKeyWithValue(broker.EnvironmentVariable("Insecure"), strconv.FormatBool(submariner.Spec.BrokerK8sInsecure)))
 	Expect(envMap).To(HaveKeyWithValue(broker.EnvironmentVariable("Secret"), submariner.Spec.BrokerK8sSecret))
 	Expect(envMap).To(HaveKeyWithValue("SUBMARINER_BROKER", submariner.Spec.Broker))
@@ -267,11 +292,9 @@ func newSubmariner() *operatorv1.Submariner {
 			Version:                  "0.12.0",
 			CeIPSecNATTPort:          4500,
 			CeIPSecIKEPort:           500,
-			CeIPSecPSK:               "DJaA2kVW72w8kjQCEpzkDhwZuniDwgePKFE7FaxVNMWqbpmT2qvp68XW52MO70ho",
+			CeIPSecPSKSecret:         "submariner-ipsec-psk",
 			BrokerK8sRemoteNamespace: "submariner-broker",
 			BrokerK8sApiServer:       "https://192.168.99.110:8443",
-			BrokerK8sApiServerToken:  "MIIDADCCAeigAw",
-			BrokerK8sCA:              "client.crt",
 			Broker:                   "k8s",
 			NatEnabled:               true,
 			ClusterID:                "east",
@@ -308,3 +331,23 @@ func getGlobalCIDR(submariner *operatorv1.Submariner, clusterNetwork *network.Cl
 
 	return clusterNetwork.GlobalCIDR
 }
+
+// failingStatusClient fails all status updates.
+type failingStatusClient struct {
+	controllerClient.Client
+}
+
+func (c *failingStatusClient) Status() controllerClient.StatusWriter {
+	return &failingStatusWriter{}
+}
+
+type failingStatusWriter struct{}
+
+func (w *failingStatusWriter) Update(ctx context.Context, obj controllerClient.Object, opts ...controllerClient.UpdateOption) error {
+	return errors.New("fake status update failure")
+}
+
+func (w *failingStatusWriter) Patch(ctx context.Context, obj controllerClient.Object, patch controllerClient.Patch,
+	opts ...controllerClient.PatchOption) error {
+	return errors.New("fake status patch failure")
+}
@@ -20,7 +20,10 @@ package test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	admtest "github.com/submariner-io/admiral/pkg/test"
 	"github.com/submariner-io/submariner-operator/controllers/resource"
@@ -33,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -42,6 +46,7 @@ type Driver struct {
 	InitClientObjs []client.Object
 	Client         client.Client
 	Controller     reconcile.Reconciler
+	EventRecorder  *record.FakeRecorder
 	Namespace      string
 	ResourceName   string
 }
@@ -50,6 +55,7 @@ func (d *Driver) BeforeEach() {
 	d.Client = nil
 	d.InitClientObjs = []client.Object{}
 	d.Controller = nil
+	d.EventRecorder = record.NewFakeRecorder(100)
 }
 
 func (d *Driver) JustBeforeEach() {
@@ -88,6 +94,34 @@ func (d *Driver) AssertReconcileError() {
 	Expect(err).ToNot(Succeed())
 }
 
+// AssertEvent asserts that an event with the given type and reason was recorded.
+func (d *Driver) AssertEvent(eventType, reason string) {
+	prefix := eventType + " " + reason + " "
+
+	for {
+		select {
+		case event := <-d.EventRecorder.Events:
+			if strings.HasPrefix(event, prefix) {
+				return
+			}
+		default:
+			Fail(fmt.Sprintf("Expected a %s event with reason %q", eventType, reason))
+		}
+	}
+}
+
+// AssertNoEvent asserts that no event with the given reason was recorded.
+func (d *Driver) AssertNoEvent(reason string) {
+	for {
+		select {
+		case event := <-d.EventRecorder.Events:
+			Expect(strings.Fields(event)[1]).ToNot(Equal(reason), "Unexpected event %q", event)
+		default:
+			return
+		}
+	}
+}
+
 func (d *Driver) GetDaemonSet(name string) (*appsv1.DaemonSet, error) {
 	foundDaemonSet := &appsv1.DaemonSet{}
 	err := d.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: d.Namespace}, foundDaemonSet)
@@ -153,6 +187,7 @@ func (d *Driver) AssertUninstallInitContainer(template *corev1.PodTemplateSpec,
 func (d *Driver) UpdateDaemonSetToReady(daemonSet *appsv1.DaemonSet) {
 	d.UpdateDaemonSetToScheduled(daemonSet)
 	daemonSet.Status.NumberReady = daemonSet.Status.DesiredNumberScheduled
+	daemonSet.Status.UpdatedNumberScheduled = daemonSet.Status.DesiredNumberScheduled
 	Expect(d.Client.Update(context.TODO(), daemonSet)).To(Succeed())
 }
 
@@ -172,6 +207,7 @@ func (d *Driver) UpdateDaemonSetToScheduled(daemonSet *appsv1.DaemonSet) {
 func (d *Driver) UpdateDeploymentToReady(deployment *appsv1.Deployment) {
 	deployment.Status.ReadyReplicas = *deployment.Spec.Replicas
 	deployment.Status.AvailableReplicas = *deployment.Spec.Replicas
+	deployment.Status.UpdatedReplicas = *deployment.Spec.Replicas
 	Expect(d.Client.Update(context.TODO(), deployment)).To(Succeed())
 }
 
@@ -21,14 +21,17 @@ package uninstall
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -36,6 +39,9 @@ import (
 const (
 	ComponentReadyTimeout = time.Minute * 2
 	ContainerEnvVar       = "SUBMARINER_UNINSTALL"
+	// TimedOutReason is the ConditionUninstalled reason set when the uninstall times out; the resource then keeps
+	// its finalizer.
+	TimedOutReason = "TimedOut"
 )
 
 type stateType int
@@ -46,32 +52,61 @@ const (
 	createUninstallComponent
 	awaitUninstallComplete
 	uninstallComplete
+	notInstalled
 )
 
+// The condition reasons for each state.
+var stateReasons = map[stateType]string{
+	deleteComponent:          "Deleting",
+	awaitPodsDeleted:         "AwaitingPodsDeletion",
+	createUninstallComponent: "CreatingUninstallResource",
+	awaitUninstallComplete:   "AwaitingUninstall",
+	uninstallComplete:        "Uninstalled",
+	notInstalled:             "NotInstalled",
+}
+
 var minComponentUninstallVersion = semver.New("0.12.0")
 
 type Component struct {
+	// Name identifies the component in the status conditions, for example "Gateway".
+	Name              string
 	Resource          client.Object
 	UninstallResource client.Object
 	CheckInstalled    func() bool
 	state             stateType
+	message           string
+	err               error
 }
 
 type Info struct {
 	Client     client.Client
 	Components []*Component
 	StartTime  time.Time
-	Log        logr.Logger
+	// Timeout is how long to wait for the components to be uninstalled, from StartTime; defaults to ComponentReadyTimeout.
+	Timeout  time.Duration
+	Log      logr.Logger
+	timedOut bool
+	err      error
 }
 
 func (c *Component) isInstalled() bool {
 	return c.CheckInstalled == nil || c.CheckInstalled()
 }
 
+func (i *Info) timeout() time.Duration {
+	if i.Timeout == 0 {
+		return ComponentReadyTimeout
+	}
+
+	return i.Timeout
+}
+
+// Run advances the uninstallation of the components, returning whether it should be requeued and whether it timed out.
+// Once timed out, the uninstall resources are removed; the uninstallation resumes if Run is called with a longer Timeout.
 func (i *Info) Run(ctx context.Context) (bool, bool, error) {
-	timedOut := time.Since(i.StartTime) >= ComponentReadyTimeout
-	if timedOut {
-		i.Log.Info("Timed out waiting for components to complete - aborting")
+	i.timedOut = time.Since(i.StartTime) >= i.timeout()
+	if i.timedOut {
+		i.Log.Info("Timed out waiting for components to complete - aborting", "timeout", i.timeout())
 
 		i.cleanup(ctx)
 
@@ -79,6 +114,8 @@ func (i *Info) Run(ctx context.Context) (bool, bool, error) {
 	}
 
 	requeue, err := i.processComponents(ctx)
+	i.err = err
+
 	if requeue || err != nil {
 		return requeue, false, err
 	}
@@ -93,12 +130,14 @@ func (i *Info) processComponents(ctx context.Context) (bool, error) {
 
 	for _, c := range i.Components {
 		if !c.isInstalled() {
+			c.state = notInstalled
 			continue
 		}
 
 		if c.state == deleteComponent {
 			err := i.ensureDeleted(ctx, c.Resource)
 			if err != nil {
+				c.err = err
 				return false, err
 			}
 
@@ -108,6 +147,7 @@ func (i *Info) processComponents(ctx context.Context) (bool, error) {
 		if c.state == awaitPodsDeleted {
 			podsIncomplete, err := i.ensurePodsDeleted(ctx, c)
 			if err != nil {
+				c.err = err
 				return false, err
 			}
 
@@ -122,6 +162,7 @@ func (i *Info) processComponents(ctx context.Context) (bool, error) {
 		if c.state == createUninstallComponent {
 			err := i.createUninstallResource(ctx, c)
 			if err != nil {
+				c.err = err
 				return false, err
 			}
 
@@ -131,11 +172,14 @@ func (i *Info) processComponents(ctx context.Context) (bool, error) {
 		if c.state == awaitUninstallComplete {
 			uninstallIncomplete, err := i.ensureUninstallResourceComplete(ctx, c)
 			if err != nil {
+				c.err = err
 				return false, err
 			}
 
 			if uninstallIncomplete {
+				c.message = fmt.Sprintf("Waiting for %q to complete", c.UninstallResource.GetName())
 				requeue = true
+
 				continue
 			}
 
@@ -146,6 +190,74 @@ func (i *Info) processComponents(ctx context.Context) (bool, error) {
 	return requeue, nil
 }
 
+// SetStatusConditions records the progress of the last Run in the given conditions: one condition per component,
+// named after the component with the v1alpha1.ConditionUninstalled suffix, and an overall v1alpha1.ConditionUninstalled.
+func (i *Info) SetStatusConditions(conditions *[]metav1.Condition) {
+	pending := []string{}
+
+	for _, c := range i.Components {
+		conditionType := c.Name + v1alpha1.ConditionUninstalled
+
+		if i.timedOut {
+			existing := meta.FindStatusCondition(*conditions, conditionType)
+			if existing == nil || existing.Status != metav1.ConditionTrue {
+				meta.SetStatusCondition(conditions, metav1.Condition{
+					Type:    conditionType,
+					Status:  metav1.ConditionFalse,
+					Reason:  TimedOutReason,
+					Message: "Timed out waiting for the component to be uninstalled",
+				})
+			}
+
+			continue
+		}
+
+		condition := metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  stateReasons[c.state],
+			Message: c.message,
+		}
+
+		switch {
+		case c.err != nil:
+			condition.Reason = "UninstallFailed"
+			condition.Message = c.err.Error()
+		case c.state == uninstallComplete || c.state == notInstalled:
+			condition.Status = metav1.ConditionTrue
+		default:
+			pending = append(pending, c.Name)
+		}
+
+		meta.SetStatusCondition(conditions, condition)
+	}
+
+	condition := metav1.Condition{
+		Type:    v1alpha1.ConditionUninstalled,
+		Status:  metav1.ConditionTrue,
+		Reason:  "AllComponentsUninstalled",
+		Message: "All components were uninstalled",
+	}
+
+	switch {
+	case i.timedOut:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = TimedOutReason
+		condition.Message = fmt.Sprintf("Timed out after %v waiting for the components to be uninstalled;"+
+			" increase spec.uninstallTimeout to retry, or remove the finalizer to abandon the uninstall", i.timeout())
+	case i.err != nil:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "UninstallFailed"
+		condition.Message = i.err.Error()
+	case len(pending) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "InProgress"
+		condition.Message = "Waiting for " + strings.Join(pending, ", ")
+	}
+
+	meta.SetStatusCondition(conditions, condition)
+}
+
 func (i *Info) ensureDeleted(ctx context.Context, obj client.Object) error {
 	err := i.Client.Delete(ctx, obj)
 	if apierrors.IsNotFound(err) {
@@ -172,6 +284,8 @@ func (i *Info) ensurePodsDeleted(ctx context.Context, c *Component) (bool, error
 		i.Log.Info(fmt.Sprintf("%T still has pods - requeueing: ", c.Resource), "name", c.Resource.GetName(),
 			"namespace", c.Resource.GetNamespace(), "numPods", numPods)
 
+		c.message = fmt.Sprintf("Waiting for %d pod(s) of %q to be deleted", numPods, c.Resource.GetName())
+
 		return true, nil
 	}
 
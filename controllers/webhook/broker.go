@@ -0,0 +1,76 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	"github.com/submariner-io/submariner-operator/pkg/deploy"
+	"github.com/submariner-io/submariner-operator/pkg/discovery/globalnet"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// nolint:lll // Markers can't be split
+// +kubebuilder:webhook:path=/mutate-submariner-io-v1alpha1-broker,mutating=true,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=submariner.io,resources=brokers,verbs=create;update,versions=v1alpha1,name=mbroker.submariner.io
+// +kubebuilder:webhook:path=/validate-submariner-io-v1alpha1-broker,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=submariner.io,resources=brokers,verbs=create;update,versions=v1alpha1,name=vbroker.submariner.io
+
+func defaultBroker(instance *v1alpha1.Broker) {
+	if !instance.Spec.GlobalnetEnabled {
+		return
+	}
+
+	if instance.Spec.GlobalnetCIDRRange == "" {
+		instance.Spec.GlobalnetCIDRRange = broker.DefaultGlobalnetCIDR
+	}
+
+	if instance.Spec.DefaultGlobalnetClusterSize == 0 {
+		instance.Spec.DefaultGlobalnetClusterSize = broker.DefaultGlobalnetClusterSize
+	}
+}
+
+func validateBroker(instance *v1alpha1.Broker) field.ErrorList {
+	var errs field.ErrorList
+
+	spec := &instance.Spec
+	specPath := field.NewPath("spec")
+
+	for i, component := range spec.Components {
+		if !containsString(deploy.ValidComponents, component) {
+			errs = append(errs, field.NotSupported(specPath.Child("components").Index(i), component, deploy.ValidComponents))
+		}
+	}
+
+	if !spec.GlobalnetEnabled || spec.GlobalnetCIDRRange == "" {
+		return errs
+	}
+
+	cidrErrs := validateCIDR(spec.GlobalnetCIDRRange, specPath.Child("globalnetCIDRRange"))
+	if len(cidrErrs) > 0 {
+		return append(errs, cidrErrs...)
+	}
+
+	if spec.DefaultGlobalnetClusterSize != 0 {
+		if _, err := globalnet.GetValidClusterSize(spec.GlobalnetCIDRRange, spec.DefaultGlobalnetClusterSize); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("defaultGlobalnetClusterSize"), spec.DefaultGlobalnetClusterSize,
+				err.Error()))
+		}
+	}
+
+	return errs
+}
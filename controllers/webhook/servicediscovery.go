@@ -0,0 +1,47 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// nolint:lll // Markers can't be split
+// +kubebuilder:webhook:path=/mutate-submariner-io-v1alpha1-servicediscovery,mutating=true,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=submariner.io,resources=servicediscoveries,verbs=create;update,versions=v1alpha1,name=mservicediscovery.submariner.io
+// +kubebuilder:webhook:path=/validate-submariner-io-v1alpha1-servicediscovery,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=submariner.io,resources=servicediscoveries,verbs=create;update,versions=v1alpha1,name=vservicediscovery.submariner.io
+
+func defaultServiceDiscovery(serviceDiscovery *v1alpha1.ServiceDiscovery) {
+	if serviceDiscovery.Spec.Repository == "" {
+		serviceDiscovery.Spec.Repository = v1alpha1.DefaultRepo
+	}
+
+	if serviceDiscovery.Spec.Version == "" {
+		serviceDiscovery.Spec.Version = v1alpha1.DefaultLighthouseVersion
+	}
+}
+
+func validateServiceDiscovery(serviceDiscovery *v1alpha1.ServiceDiscovery) field.ErrorList {
+	spec := &serviceDiscovery.Spec
+	specPath := field.NewPath("spec")
+
+	errs := validateClusterID(spec.ClusterID, specPath.Child("clusterID"))
+
+	return append(errs, validateImageOverrides(spec.ImageOverrides, specPath.Child("imageOverrides"))...)
+}
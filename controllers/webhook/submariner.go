@@ -0,0 +1,133 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/internal/cluster"
+	"github.com/submariner-io/submariner-operator/internal/image"
+	"github.com/submariner-io/submariner-operator/pkg/discovery/globalnet"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// nolint:lll // Markers can't be split
+// +kubebuilder:webhook:path=/mutate-submariner-io-v1alpha1-submariner,mutating=true,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=submariner.io,resources=submariners,verbs=create;update,versions=v1alpha1,name=msubmariner.submariner.io
+// +kubebuilder:webhook:path=/validate-submariner-io-v1alpha1-submariner,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=submariner.io,resources=submariners,verbs=create;update,versions=v1alpha1,name=vsubmariner.submariner.io
+
+// The cable drivers supported by the gateway; an empty value selects the default (libreswan).
+var validCableDrivers = []string{"", "libreswan", "wireguard", "vxlan"}
+
+func defaultSubmariner(submariner *v1alpha1.Submariner) {
+	if submariner.Spec.Repository == "" {
+		submariner.Spec.Repository = v1alpha1.DefaultRepo
+	}
+
+	if submariner.Spec.Version == "" {
+		submariner.Spec.Version = v1alpha1.DefaultSubmarinerVersion
+	}
+}
+
+func validateSubmariner(submariner *v1alpha1.Submariner) field.ErrorList {
+	spec := &submariner.Spec
+	specPath := field.NewPath("spec")
+
+	errs := validateClusterID(spec.ClusterID, specPath.Child("clusterID"))
+
+	if spec.ClusterCIDR != "" {
+		errs = append(errs, validateCIDR(spec.ClusterCIDR, specPath.Child("clusterCIDR"))...)
+	}
+
+	if spec.ServiceCIDR != "" {
+		errs = append(errs, validateCIDR(spec.ServiceCIDR, specPath.Child("serviceCIDR"))...)
+	}
+
+	if len(errs) == 0 && spec.ClusterCIDR != "" && spec.ServiceCIDR != "" {
+		overlap, err := globalnet.IsOverlappingCIDR([]string{spec.ClusterCIDR}, spec.ServiceCIDR)
+		if err == nil && overlap {
+			errs = append(errs, field.Invalid(specPath.Child("serviceCIDR"), spec.ServiceCIDR,
+				fmt.Sprintf("overlaps with the cluster CIDR %s", spec.ClusterCIDR)))
+		}
+	}
+
+	if spec.GlobalCIDR != "" {
+		errs = append(errs, validateCIDR(spec.GlobalCIDR, specPath.Child("globalCIDR"))...)
+	}
+
+	if !containsString(validCableDrivers, spec.CableDriver) {
+		errs = append(errs, field.NotSupported(specPath.Child("cableDriver"), spec.CableDriver, validCableDrivers[1:]))
+	}
+
+	errs = append(errs, validatePort(spec.CeIPSecIKEPort, specPath.Child("ceIPSecIKEPort"))...)
+	errs = append(errs, validatePort(spec.CeIPSecNATTPort, specPath.Child("ceIPSecNATTPort"))...)
+
+	return append(errs, validateImageOverrides(spec.ImageOverrides, specPath.Child("imageOverrides"))...)
+}
+
+func validateClusterID(clusterID string, path *field.Path) field.ErrorList {
+	if clusterID == "" {
+		return field.ErrorList{field.Required(path, "")}
+	}
+
+	if err := cluster.IsValidID(clusterID); err != nil {
+		return field.ErrorList{field.Invalid(path, clusterID, err.Error())}
+	}
+
+	return nil
+}
+
+func validateCIDR(cidr string, path *field.Path) field.ErrorList {
+	if err := globalnet.IsValidCIDR(cidr); err != nil {
+		return field.ErrorList{field.Invalid(path, cidr, err.Error())}
+	}
+
+	return nil
+}
+
+func validatePort(port int, path *field.Path) field.ErrorList {
+	if port < 0 || port > 65535 {
+		return field.ErrorList{field.Invalid(path, port, "must be a valid port number")}
+	}
+
+	return nil
+}
+
+func validateImageOverrides(imageOverrides map[string]string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for key := range imageOverrides {
+		if !image.IsValidName(key) {
+			errs = append(errs, field.NotSupported(path.Key(key), key, names.ValidImageNames))
+		}
+	}
+
+	return errs
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
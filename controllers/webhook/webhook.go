@@ -0,0 +1,151 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+const (
+	MutateSubmarinerPath         = "/mutate-submariner-io-v1alpha1-submariner"
+	ValidateSubmarinerPath       = "/validate-submariner-io-v1alpha1-submariner"
+	MutateServiceDiscoveryPath   = "/mutate-submariner-io-v1alpha1-servicediscovery"
+	ValidateServiceDiscoveryPath = "/validate-submariner-io-v1alpha1-servicediscovery"
+	MutateBrokerPath             = "/mutate-submariner-io-v1alpha1-broker"
+	ValidateBrokerPath           = "/validate-submariner-io-v1alpha1-broker"
+	ConvertPath                  = "/convert"
+)
+
+// kind describes how to default and validate a resource kind served by the webhooks.
+type kind struct {
+	newObject   func() client.Object
+	setDefaults func(obj client.Object)
+	validate    func(obj client.Object) field.ErrorList
+}
+
+// AddToManager registers the defaulting and validating webhooks for the operator's resources with the
+// Manager's webhook server.
+func AddToManager(mgr manager.Manager) error {
+	decoder, err := admission.NewDecoder(mgr.GetScheme())
+	if err != nil {
+		return errors.Wrap(err, "error creating the admission decoder")
+	}
+
+	server := mgr.GetWebhookServer()
+
+	for path, handler := range NewHandlers(decoder) {
+		server.Register(path, &webhook.Admission{Handler: handler})
+	}
+
+	// Converts between the Submariner API versions, using the manager's scheme which must include them all
+	server.Register(ConvertPath, &conversion.Webhook{})
+
+	return nil
+}
+
+// NewHandlers returns the admission handlers keyed by the path on which they're served.
+func NewHandlers(decoder *admission.Decoder) map[string]admission.Handler {
+	submariner := &kind{
+		newObject:   func() client.Object { return &v1alpha1.Submariner{} },
+		setDefaults: func(obj client.Object) { defaultSubmariner(obj.(*v1alpha1.Submariner)) },
+		validate:    func(obj client.Object) field.ErrorList { return validateSubmariner(obj.(*v1alpha1.Submariner)) },
+	}
+
+	serviceDiscovery := &kind{
+		newObject:   func() client.Object { return &v1alpha1.ServiceDiscovery{} },
+		setDefaults: func(obj client.Object) { defaultServiceDiscovery(obj.(*v1alpha1.ServiceDiscovery)) },
+		validate: func(obj client.Object) field.ErrorList {
+			return validateServiceDiscovery(obj.(*v1alpha1.ServiceDiscovery))
+		},
+	}
+
+	broker := &kind{
+		newObject:   func() client.Object { return &v1alpha1.Broker{} },
+		setDefaults: func(obj client.Object) { defaultBroker(obj.(*v1alpha1.Broker)) },
+		validate:    func(obj client.Object) field.ErrorList { return validateBroker(obj.(*v1alpha1.Broker)) },
+	}
+
+	return map[string]admission.Handler{
+		MutateSubmarinerPath:         &defaultingHandler{kind: submariner, decoder: decoder},
+		ValidateSubmarinerPath:       &validatingHandler{kind: submariner, decoder: decoder},
+		MutateServiceDiscoveryPath:   &defaultingHandler{kind: serviceDiscovery, decoder: decoder},
+		ValidateServiceDiscoveryPath: &validatingHandler{kind: serviceDiscovery, decoder: decoder},
+		MutateBrokerPath:             &defaultingHandler{kind: broker, decoder: decoder},
+		ValidateBrokerPath:           &validatingHandler{kind: broker, decoder: decoder},
+	}
+}
+
+type defaultingHandler struct {
+	*kind
+	decoder *admission.Decoder
+}
+
+func (h *defaultingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj := h.newObject()
+
+	if err := h.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	h.setDefaults(obj)
+
+	marshaled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+type validatingHandler struct {
+	*kind
+	decoder *admission.Decoder
+}
+
+func (h *validatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj := h.newObject()
+
+	if err := h.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := h.validate(obj); len(errs) > 0 {
+		status := apierrors.NewInvalid(obj.GetObjectKind().GroupVersionKind().GroupKind(), obj.GetName(), errs).Status()
+
+		return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result:  &status,
+		}}
+	}
+
+	return admission.Allowed("")
+}
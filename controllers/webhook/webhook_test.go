@@ -0,0 +1,222 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	operatorv1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/controllers/webhook"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Submariner webhooks", func() {
+	var submariner *operatorv1.Submariner
+
+	BeforeEach(func() {
+		submariner = &operatorv1.Submariner{
+			TypeMeta:   metav1.TypeMeta{APIVersion: operatorv1.SchemeGroupVersion.String(), Kind: "Submariner"},
+			ObjectMeta: metav1.ObjectMeta{Name: "submariner", Namespace: "submariner-operator"},
+			Spec: operatorv1.SubmarinerSpec{
+				ClusterID:   "east",
+				ClusterCIDR: "10.244.0.0/16",
+				ServiceCIDR: "10.96.0.0/16",
+				CableDriver: "libreswan",
+			},
+		}
+	})
+
+	When("the Submariner resource is valid", func() {
+		It("should be allowed", func() {
+			assertAllowed(webhook.ValidateSubmarinerPath, submariner)
+		})
+	})
+
+	When("the cluster ID is invalid", func() {
+		It("should be denied", func() {
+			submariner.Spec.ClusterID = "East_1"
+			assertDenied(webhook.ValidateSubmarinerPath, submariner, "spec.clusterID")
+		})
+	})
+
+	When("the cluster and service CIDRs overlap", func() {
+		It("should be denied", func() {
+			submariner.Spec.ServiceCIDR = "10.244.10.0/24"
+			assertDenied(webhook.ValidateSubmarinerPath, submariner, "spec.serviceCIDR")
+		})
+	})
+
+	When("the global CIDR is malformed", func() {
+		It("should be denied", func() {
+			submariner.Spec.GlobalCIDR = "242.0.0.0"
+			assertDenied(webhook.ValidateSubmarinerPath, submariner, "spec.globalCIDR")
+		})
+	})
+
+	When("the cable driver is unknown", func() {
+		It("should be denied", func() {
+			submariner.Spec.CableDriver = "bogus"
+			assertDenied(webhook.ValidateSubmarinerPath, submariner, "spec.cableDriver")
+		})
+	})
+
+	When("an image override key is unknown", func() {
+		It("should be denied", func() {
+			submariner.Spec.ImageOverrides = map[string]string{"bogus": "quay.io/bogus:latest"}
+			assertDenied(webhook.ValidateSubmarinerPath, submariner, "spec.imageOverrides[bogus]")
+		})
+	})
+
+	When("the repository and version aren't set", func() {
+		It("should default them", func() {
+			patches := assertPatched(webhook.MutateSubmarinerPath, submariner)
+			Expect(patches).To(HaveKeyWithValue("/spec/repository", operatorv1.DefaultRepo))
+			Expect(patches).To(HaveKeyWithValue("/spec/version", operatorv1.DefaultSubmarinerVersion))
+		})
+	})
+})
+
+var _ = Describe("ServiceDiscovery webhooks", func() {
+	var serviceDiscovery *operatorv1.ServiceDiscovery
+
+	BeforeEach(func() {
+		serviceDiscovery = &operatorv1.ServiceDiscovery{
+			TypeMeta:   metav1.TypeMeta{APIVersion: operatorv1.SchemeGroupVersion.String(), Kind: "ServiceDiscovery"},
+			ObjectMeta: metav1.ObjectMeta{Name: "service-discovery", Namespace: "submariner-operator"},
+			Spec: operatorv1.ServiceDiscoverySpec{
+				ClusterID: "east",
+			},
+		}
+	})
+
+	When("the ServiceDiscovery resource is valid", func() {
+		It("should be allowed", func() {
+			assertAllowed(webhook.ValidateServiceDiscoveryPath, serviceDiscovery)
+		})
+	})
+
+	When("the cluster ID is missing", func() {
+		It("should be denied", func() {
+			serviceDiscovery.Spec.ClusterID = ""
+			assertDenied(webhook.ValidateServiceDiscoveryPath, serviceDiscovery, "spec.clusterID")
+		})
+	})
+
+	When("the repository and version aren't set", func() {
+		It("should default them", func() {
+			patches := assertPatched(webhook.MutateServiceDiscoveryPath, serviceDiscovery)
+			Expect(patches).To(HaveKeyWithValue("/spec/repository", operatorv1.DefaultRepo))
+			Expect(patches).To(HaveKeyWithValue("/spec/version", operatorv1.DefaultLighthouseVersion))
+		})
+	})
+})
+
+var _ = Describe("Broker webhooks", func() {
+	var brokerCR *operatorv1.Broker
+
+	BeforeEach(func() {
+		brokerCR = &operatorv1.Broker{
+			TypeMeta:   metav1.TypeMeta{APIVersion: operatorv1.SchemeGroupVersion.String(), Kind: "Broker"},
+			ObjectMeta: metav1.ObjectMeta{Name: "submariner-broker", Namespace: "submariner-k8s-broker"},
+			Spec: operatorv1.BrokerSpec{
+				Components:       []string{"service-discovery", "connectivity"},
+				GlobalnetEnabled: true,
+			},
+		}
+	})
+
+	When("the Broker resource is valid", func() {
+		It("should be allowed", func() {
+			brokerCR.Spec.GlobalnetCIDRRange = "242.0.0.0/8"
+			assertAllowed(webhook.ValidateBrokerPath, brokerCR)
+		})
+	})
+
+	When("a component is unknown", func() {
+		It("should be denied", func() {
+			brokerCR.Spec.Components = append(brokerCR.Spec.Components, "bogus")
+			assertDenied(webhook.ValidateBrokerPath, brokerCR, "spec.components[2]")
+		})
+	})
+
+	When("the default cluster size doesn't fit in the globalnet CIDR range", func() {
+		It("should be denied", func() {
+			brokerCR.Spec.GlobalnetCIDRRange = "242.0.0.0/16"
+			brokerCR.Spec.DefaultGlobalnetClusterSize = 65536
+			assertDenied(webhook.ValidateBrokerPath, brokerCR, "spec.defaultGlobalnetClusterSize")
+		})
+	})
+
+	When("globalnet is enabled without a CIDR range", func() {
+		It("should default the globalnet settings", func() {
+			patches := assertPatched(webhook.MutateBrokerPath, brokerCR)
+			Expect(patches).To(HaveKeyWithValue("/spec/globalnetCIDRRange", broker.DefaultGlobalnetCIDR))
+			Expect(patches).To(HaveKeyWithValue("/spec/defaultGlobalnetClusterSize", float64(broker.DefaultGlobalnetClusterSize)))
+		})
+	})
+})
+
+func handle(path string, obj runtime.Object) admission.Response {
+	raw, err := json.Marshal(obj)
+	Expect(err).To(Succeed())
+
+	scheme := runtime.NewScheme()
+	Expect(operatorv1.AddToScheme(scheme)).To(Succeed())
+
+	decoder, err := admission.NewDecoder(scheme)
+	Expect(err).To(Succeed())
+
+	handler, ok := webhook.NewHandlers(decoder)[path]
+	Expect(ok).To(BeTrue(), "No handler for path %q", path)
+
+	return handler.Handle(context.TODO(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}})
+}
+
+func assertAllowed(path string, obj runtime.Object) {
+	response := handle(path, obj)
+	Expect(response.Allowed).To(BeTrue(), "Unexpected denial: %v", response.Result)
+}
+
+func assertDenied(path string, obj runtime.Object, field string) {
+	response := handle(path, obj)
+	Expect(response.Allowed).To(BeFalse())
+	Expect(response.Result.Message).To(ContainSubstring(field))
+}
+
+func assertPatched(path string, obj runtime.Object) map[string]interface{} {
+	response := handle(path, obj)
+	Expect(response.Allowed).To(BeTrue(), "Unexpected denial: %v", response.Result)
+
+	patches := map[string]interface{}{}
+	for _, patch := range response.Patches {
+		patches[patch.Path] = patch.Value
+	}
+
+	return patches
+}
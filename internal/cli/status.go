@@ -57,7 +57,12 @@ type Status struct {
 }
 
 func NewStatus() *Status {
-	var writer io.Writer = os.Stderr
+	return NewStatusTo(os.Stderr)
+}
+
+// NewStatusTo returns a new status object writing to the given writer,
+// with a spinner if the writer is a smart terminal.
+func NewStatusTo(writer io.Writer) *Status {
 	if env.IsSmartTerminal(writer) {
 		writer = NewSpinner(writer)
 	}
@@ -0,0 +1,470 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envelope protects files, such as broker-info.subm, which contain credentials. The content is encrypted with
+// age (https://age-encryption.org), for a passphrase or for an age X25519 recipient. The envelope header is sealed
+// along with the content, so any change to the file is detected when it is opened.
+//
+// The producer signs the header and the ciphertext with its ed25519 signing key, and the signature is verified when the
+// envelope is opened. Anyone who knows a recipient can encrypt for it, so envelopes encrypted for a recipient are only
+// opened if they are signed by a trusted signer; passphrase envelopes can only be created by holders of the passphrase,
+// so their signer only needs to be trusted if trusted signers are given.
+package envelope
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	"github.com/pkg/errors"
+)
+
+const (
+	Format  = "submariner.io/encrypted-file"
+	Version = 1
+
+	MethodPassphrase = "scrypt"
+	MethodRecipient  = "x25519"
+
+	// PassphraseEnvVar names the environment variable holding the passphrase used to open envelopes.
+	PassphraseEnvVar = "SUBCTL_BROKER_INFO_PASSPHRASE"
+	// IdentityEnvVar names the environment variable holding the path to an age identity file used to open envelopes.
+	IdentityEnvVar = "SUBCTL_BROKER_INFO_IDENTITY"
+	// SignersEnvVar names the environment variable holding the comma-separated verification keys of the trusted signers.
+	SignersEnvVar = "SUBCTL_BROKER_INFO_SIGNERS"
+	// SigningKeyEnvVar names the environment variable holding the path to the signing key file used to seal envelopes.
+	SigningKeyEnvVar = "SUBCTL_BROKER_INFO_SIGNING_KEY"
+
+	SigningKeyPrefix      = "SUBCTL-SIGNING-KEY-"
+	VerificationKeyPrefix = "subctl-signer-"
+
+	scryptLogN = 15
+)
+
+// Envelope is the serialized form of an encrypted file.
+type Envelope struct {
+	Format  string    `json:"format"`
+	Version int       `json:"version"`
+	Method  string    `json:"method"`
+	Created time.Time `json:"created"`
+	// Recipient is the age public key the file is encrypted for, for the recipient method.
+	Recipient string `json:"recipient,omitempty"`
+	// Signer is the verification key of the producer's signing key.
+	Signer string `json:"signer"`
+	// Ciphertext is the sealed content, in the age format.
+	Ciphertext []byte `json:"ciphertext"`
+	// Signature is the producer's ed25519 signature of the envelope, without the signature itself.
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// sealedContent is what gets encrypted: the content along with the envelope header, which is checked on opening.
+type sealedContent struct {
+	Header  Envelope `json:"header"`
+	Content []byte   `json:"content"`
+}
+
+// Keys are the secrets which can open envelopes, along with the verification keys of the trusted signers.
+type Keys struct {
+	Passphrase string
+	// Identities are age secret keys.
+	Identities []string
+	// Signers are the verification keys of the trusted signers.
+	Signers []string
+}
+
+// IsEncrypted returns true if the data is an envelope. Legacy files are base64-encoded, so they never start with a
+// JSON object.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("{"))
+}
+
+// Parse parses an envelope without opening it.
+func Parse(data []byte) (*Envelope, error) {
+	e := &Envelope{}
+
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, errors.Wrap(err, "error parsing the encrypted file")
+	}
+
+	if e.Format != Format {
+		return nil, fmt.Errorf("unknown encrypted file format %q", e.Format)
+	}
+
+	if e.Version != Version {
+		return nil, fmt.Errorf("unsupported encrypted file version %d, this version of subctl supports version %d", e.Version, Version)
+	}
+
+	return e, nil
+}
+
+// SealWithPassphrase encrypts the plaintext with the passphrase, and signs it with the signing key.
+func SealWithPassphrase(plaintext []byte, passphrase, signingKey string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("the passphrase can't be empty")
+	}
+
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating the passphrase recipient")
+	}
+
+	recipient.SetWorkFactor(scryptLogN)
+
+	return newEnvelope(MethodPassphrase).seal(recipient, plaintext, signingKey)
+}
+
+// SealForRecipient encrypts the plaintext so that only the holder of the identity matching the given age recipient
+// can open it, and signs it with the signing key.
+func SealForRecipient(plaintext []byte, recipient, signingKey string) ([]byte, error) {
+	x25519Recipient, err := age.ParseX25519Recipient(recipient)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid recipient %q", recipient)
+	}
+
+	e := newEnvelope(MethodRecipient)
+	e.Recipient = x25519Recipient.String()
+
+	return e.seal(x25519Recipient, plaintext, signingKey)
+}
+
+// Open verifies the envelope's signature and decrypts it with the given keys, verifying its integrity.
+func Open(data []byte, keys *Keys) ([]byte, error) {
+	e, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.verify(keys.Signers); err != nil {
+		return nil, err
+	}
+
+	switch e.Method {
+	case MethodPassphrase:
+		if keys.Passphrase == "" {
+			return nil, errors.New("the file is encrypted with a passphrase, but none was provided")
+		}
+
+		identity, err := age.NewScryptIdentity(keys.Passphrase)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating the passphrase identity")
+		}
+
+		plaintext, err := e.open(identity)
+
+		return plaintext, errors.Wrap(err, "the passphrase is incorrect or the file was modified")
+	case MethodRecipient:
+		identities := []age.Identity{}
+
+		for _, key := range keys.Identities {
+			identity, err := age.ParseX25519Identity(key)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid identity")
+			}
+
+			identities = append(identities, identity)
+		}
+
+		if len(identities) == 0 {
+			return nil, fmt.Errorf("the file is encrypted for %s, but no identity was provided", e.Recipient)
+		}
+
+		plaintext, err := e.open(identities...)
+
+		return plaintext, errors.Wrapf(err, "no matching identity was provided for %s, or the file was modified", e.Recipient)
+	}
+
+	return nil, fmt.Errorf("unknown encryption method %q", e.Method)
+}
+
+// Unwrap returns the data as is if it isn't encrypted, otherwise it opens it with the keys from the environment.
+func Unwrap(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+
+	keys, err := KeysFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := Open(data, keys)
+
+	return plaintext, errors.WithMessagef(err, "unable to open the encrypted file (set %s or %s, and %s, or decrypt it"+
+		" with \"subctl broker-info decrypt\")", PassphraseEnvVar, IdentityEnvVar, SignersEnvVar)
+}
+
+// KeysFromEnvironment returns the keys specified in the environment.
+func KeysFromEnvironment() (*Keys, error) {
+	keys := &Keys{Passphrase: os.Getenv(PassphraseEnvVar)}
+
+	for _, signer := range strings.Split(os.Getenv(SignersEnvVar), ",") {
+		if signer = strings.TrimSpace(signer); signer != "" {
+			keys.Signers = append(keys.Signers, signer)
+		}
+	}
+
+	if identityFile := os.Getenv(IdentityEnvVar); identityFile != "" {
+		identities, err := ReadIdentities(identityFile)
+		if err != nil {
+			return nil, err
+		}
+
+		keys.Identities = identities
+	}
+
+	return keys, nil
+}
+
+// ReadIdentities reads the age secret keys from the given file, as written by age-keygen.
+func ReadIdentities(fileName string) ([]string, error) {
+	return readKeys(fileName, "identity")
+}
+
+// ReadSigningKey reads the signing key from the given file, as written by WriteSigningKey.
+func ReadSigningKey(fileName string) (string, error) {
+	keys, err := readKeys(fileName, "signing key")
+	if err != nil {
+		return "", err
+	}
+
+	if len(keys) != 1 {
+		return "", fmt.Errorf("signing key file %q must contain a single key", fileName)
+	}
+
+	return keys[0], nil
+}
+
+// WriteSigningKey writes the signing key to the given file, along with its verification key as a comment.
+func WriteSigningKey(fileName, signingKey string) error {
+	verificationKey, err := VerificationKey(signingKey)
+	if err != nil {
+		return err
+	}
+
+	content := fmt.Sprintf("# created: %s\n# verification key: %s\n%s\n", time.Now().UTC().Format(time.RFC3339), verificationKey,
+		signingKey)
+
+	return errors.Wrapf(os.WriteFile(fileName, []byte(content), 0o600), "error writing signing key file %q", fileName)
+}
+
+func readKeys(fileName, kind string) ([]string, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s file %q", kind, fileName)
+	}
+
+	defer file.Close()
+
+	keys := []string{}
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			keys = append(keys, line)
+		}
+	}
+
+	return keys, errors.Wrapf(scanner.Err(), "error reading %s file %q", kind, fileName)
+}
+
+// NewIdentity generates a new age identity, returning it along with its recipient.
+func NewIdentity() (identity, recipient string, err error) {
+	x25519Identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		return "", "", errors.Wrap(err, "error generating the identity")
+	}
+
+	return x25519Identity.String(), x25519Identity.Recipient().String(), nil
+}
+
+// NewSigningKey generates a new signing key, returning it along with its verification key.
+func NewSigningKey() (signingKey, verificationKey string, err error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", errors.Wrap(err, "error generating the signing key")
+	}
+
+	return SigningKeyPrefix + base64.RawURLEncoding.EncodeToString(privateKey.Seed()),
+		VerificationKeyPrefix + base64.RawURLEncoding.EncodeToString(publicKey), nil
+}
+
+// VerificationKey returns the verification key of the given signing key.
+func VerificationKey(signingKey string) (string, error) {
+	privateKey, err := parseSigningKey(signingKey)
+	if err != nil {
+		return "", err
+	}
+
+	return VerificationKeyPrefix + base64.RawURLEncoding.EncodeToString(privateKey.Public().(ed25519.PublicKey)), nil
+}
+
+func parseSigningKey(signingKey string) (ed25519.PrivateKey, error) {
+	seed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(signingKey, SigningKeyPrefix))
+	if err != nil || !strings.HasPrefix(signingKey, SigningKeyPrefix) || len(seed) != ed25519.SeedSize {
+		return nil, errors.New("invalid signing key")
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+func parseVerificationKey(verificationKey string) (ed25519.PublicKey, error) {
+	key, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(verificationKey, VerificationKeyPrefix))
+	if err != nil || !strings.HasPrefix(verificationKey, VerificationKeyPrefix) || len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid verification key %q", verificationKey)
+	}
+
+	return ed25519.PublicKey(key), nil
+}
+
+func newEnvelope(method string) *Envelope {
+	return &Envelope{
+		Format:  Format,
+		Version: Version,
+		Method:  method,
+		Created: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func (e *Envelope) seal(recipient age.Recipient, plaintext []byte, signingKey string) ([]byte, error) {
+	privateKey, err := parseSigningKey(signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Signer, err = VerificationKey(signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := json.Marshal(&sealedContent{Header: *e, Content: plaintext})
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshalling the content")
+	}
+
+	ciphertext := &bytes.Buffer{}
+
+	w, err := age.Encrypt(ciphertext, recipient)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encrypting the file")
+	}
+
+	if _, err := w.Write(content); err != nil {
+		return nil, errors.Wrap(err, "error encrypting the file")
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, errors.Wrap(err, "error encrypting the file")
+	}
+
+	e.Ciphertext = ciphertext.Bytes()
+
+	signed, err := e.signedData()
+	if err != nil {
+		return nil, err
+	}
+
+	e.Signature = ed25519.Sign(privateKey, signed)
+
+	data, err := json.MarshalIndent(e, "", "  ")
+
+	return data, errors.Wrap(err, "error marshalling the envelope")
+}
+
+// signedData returns what the signature covers: the whole envelope, i.e. the header and the ciphertext, except for the
+// signature itself.
+func (e *Envelope) signedData() ([]byte, error) {
+	unsigned := *e
+	unsigned.Signature = nil
+
+	data, err := json.Marshal(&unsigned)
+
+	return data, errors.Wrap(err, "error marshalling the envelope")
+}
+
+// verify checks the envelope's signature, and that its signer is one of the trusted signers; envelopes encrypted for a
+// recipient must have a trusted signer.
+func (e *Envelope) verify(trustedSigners []string) error {
+	signer, err := parseVerificationKey(e.Signer)
+	if err != nil {
+		return errors.Wrap(err, "the file's signer is invalid")
+	}
+
+	signed, err := e.signedData()
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(signer, signed, e.Signature) {
+		return errors.New("the file's signature is invalid, the file was modified")
+	}
+
+	if len(trustedSigners) == 0 {
+		if e.Method == MethodRecipient {
+			return fmt.Errorf("the file is encrypted for a recipient, so its signer %s must be trusted, but no trusted signers"+
+				" were provided", e.Signer)
+		}
+
+		return nil
+	}
+
+	for _, trusted := range trustedSigners {
+		if trusted == e.Signer {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the file was signed by %s, which isn't a trusted signer", e.Signer)
+}
+
+func (e *Envelope) open(identities ...age.Identity) ([]byte, error) {
+	r, err := age.Decrypt(bytes.NewReader(e.Ciphertext), identities...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decrypting the file")
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decrypting the file")
+	}
+
+	content := &sealedContent{}
+	if err := json.Unmarshal(data, content); err != nil {
+		return nil, errors.Wrap(err, "error parsing the decrypted content")
+	}
+
+	header := *e
+	header.Ciphertext = nil
+	header.Signature = nil
+
+	if !reflect.DeepEqual(&content.Header, &header) {
+		return nil, errors.New("the envelope header doesn't match the encrypted content")
+	}
+
+	return content.Content, nil
+}
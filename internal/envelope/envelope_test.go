@@ -0,0 +1,305 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envelope_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/internal/envelope"
+)
+
+const legacyContent = "eyJicm9rZXJVUkwiOiJodHRwczovL2Jyb2tlcjo2NDQzIn0="
+
+var _ = Describe("Envelope", func() {
+	plaintext := []byte(legacyContent)
+
+	var (
+		signingKey string
+		signer     string
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		signingKey, signer, err = envelope.NewSigningKey()
+		Expect(err).To(Succeed())
+		Expect(signingKey).To(HavePrefix(envelope.SigningKeyPrefix))
+		Expect(signer).To(HavePrefix(envelope.VerificationKeyPrefix))
+	})
+
+	When("sealed with a passphrase", func() {
+		var sealed []byte
+
+		BeforeEach(func() {
+			var err error
+
+			sealed, err = envelope.SealWithPassphrase(plaintext, "correct horse", signingKey)
+			Expect(err).To(Succeed())
+		})
+
+		It("should be recognized as encrypted", func() {
+			Expect(envelope.IsEncrypted(sealed)).To(BeTrue())
+			Expect(envelope.IsEncrypted(plaintext)).To(BeFalse())
+
+			e, err := envelope.Parse(sealed)
+			Expect(err).To(Succeed())
+			Expect(e.Method).To(Equal(envelope.MethodPassphrase))
+			Expect(e.Signer).To(Equal(signer))
+			Expect(string(sealed)).ToNot(ContainSubstring(legacyContent))
+		})
+
+		It("should open with the passphrase", func() {
+			opened, err := envelope.Open(sealed, &envelope.Keys{Passphrase: "correct horse"})
+			Expect(err).To(Succeed())
+			Expect(opened).To(Equal(plaintext))
+		})
+
+		It("should open with the passphrase if its signer is trusted", func() {
+			opened, err := envelope.Open(sealed, &envelope.Keys{Passphrase: "correct horse", Signers: []string{signer}})
+			Expect(err).To(Succeed())
+			Expect(opened).To(Equal(plaintext))
+		})
+
+		It("should not open with another passphrase", func() {
+			_, err := envelope.Open(sealed, &envelope.Keys{Passphrase: "battery staple"})
+			Expect(err).ToNot(Succeed())
+		})
+
+		It("should not open if its signer isn't trusted", func() {
+			_, other, err := envelope.NewSigningKey()
+			Expect(err).To(Succeed())
+
+			_, err = envelope.Open(sealed, &envelope.Keys{Passphrase: "correct horse", Signers: []string{other}})
+			Expect(err).To(MatchError(ContainSubstring("isn't a trusted signer")))
+		})
+
+		It("should detect changes to the envelope header", func() {
+			tampered := tamper(sealed, func(e map[string]interface{}) {
+				e["created"] = "2000-01-01T00:00:00Z"
+			})
+
+			_, err := envelope.Open(tampered, &envelope.Keys{Passphrase: "correct horse"})
+			Expect(err).To(MatchError(ContainSubstring("signature is invalid")))
+		})
+
+		It("should detect changes to the ciphertext", func() {
+			tampered := tamper(sealed, func(e map[string]interface{}) {
+				ciphertext, err := base64.StdEncoding.DecodeString(e["ciphertext"].(string))
+				Expect(err).To(Succeed())
+
+				ciphertext[len(ciphertext)-1] ^= 1
+				e["ciphertext"] = base64.StdEncoding.EncodeToString(ciphertext)
+			})
+
+			_, err := envelope.Open(tampered, &envelope.Keys{Passphrase: "correct horse"})
+			Expect(err).To(MatchError(ContainSubstring("signature is invalid")))
+		})
+
+		It("should detect a missing signature", func() {
+			tampered := tamper(sealed, func(e map[string]interface{}) {
+				delete(e, "signature")
+			})
+
+			_, err := envelope.Open(tampered, &envelope.Keys{Passphrase: "correct horse"})
+			Expect(err).To(MatchError(ContainSubstring("signature is invalid")))
+		})
+
+		It("should detect an envelope re-signed by another signer", func() {
+			forgingKey, forger, err := envelope.NewSigningKey()
+			Expect(err).To(Succeed())
+
+			forged := resign(sealed, forgingKey, forger)
+
+			_, err = envelope.Open(forged, &envelope.Keys{Passphrase: "correct horse"})
+			Expect(err).To(MatchError(ContainSubstring("doesn't match")))
+
+			_, err = envelope.Open(forged, &envelope.Keys{Passphrase: "correct horse", Signers: []string{signer}})
+			Expect(err).To(MatchError(ContainSubstring("isn't a trusted signer")))
+		})
+	})
+
+	When("sealed for a recipient", func() {
+		var (
+			identity  string
+			recipient string
+			sealed    []byte
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			identity, recipient, err = envelope.NewIdentity()
+			Expect(err).To(Succeed())
+			Expect(identity).To(HavePrefix("AGE-SECRET-KEY-1"))
+			Expect(recipient).To(HavePrefix("age1"))
+
+			sealed, err = envelope.SealForRecipient(plaintext, recipient, signingKey)
+			Expect(err).To(Succeed())
+		})
+
+		It("should open with the matching identity if its signer is trusted", func() {
+			other, _, err := envelope.NewIdentity()
+			Expect(err).To(Succeed())
+
+			opened, err := envelope.Open(sealed, &envelope.Keys{Identities: []string{other, identity}, Signers: []string{signer}})
+			Expect(err).To(Succeed())
+			Expect(opened).To(Equal(plaintext))
+		})
+
+		It("should not open with another identity", func() {
+			other, _, err := envelope.NewIdentity()
+			Expect(err).To(Succeed())
+
+			_, err = envelope.Open(sealed, &envelope.Keys{Identities: []string{other}, Signers: []string{signer}})
+			Expect(err).ToNot(Succeed())
+		})
+
+		It("should not open without trusted signers", func() {
+			_, err := envelope.Open(sealed, &envelope.Keys{Identities: []string{identity}})
+			Expect(err).To(MatchError(ContainSubstring("no trusted signers")))
+		})
+
+		It("should not open an envelope forged for the recipient", func() {
+			forgingKey, forger, err := envelope.NewSigningKey()
+			Expect(err).To(Succeed())
+
+			forged, err := envelope.SealForRecipient([]byte("forged"), recipient, forgingKey)
+			Expect(err).To(Succeed())
+
+			_, err = envelope.Open(forged, &envelope.Keys{Identities: []string{identity}, Signers: []string{signer}})
+			Expect(err).To(MatchError(ContainSubstring(forger + ", which isn't a trusted signer")))
+		})
+
+		It("should not open an envelope whose signature was replaced", func() {
+			forged := tamper(sealed, func(e map[string]interface{}) {
+				_, forger, err := envelope.NewSigningKey()
+				Expect(err).To(Succeed())
+
+				e["signer"] = forger
+			})
+
+			_, err := envelope.Open(forged, &envelope.Keys{Identities: []string{identity}, Signers: []string{signer}})
+			Expect(err).To(MatchError(ContainSubstring("signature is invalid")))
+		})
+
+		It("should open with an identity file from the environment", func() {
+			dir, err := os.MkdirTemp("", "envelope")
+			Expect(err).To(Succeed())
+
+			defer os.RemoveAll(dir)
+
+			identityFile := filepath.Join(dir, "key.txt")
+			Expect(os.WriteFile(identityFile, []byte("# created: today\n# public key: "+recipient+"\n"+identity+"\n"), 0o600)).
+				To(Succeed())
+
+			os.Setenv(envelope.IdentityEnvVar, identityFile)
+			defer os.Unsetenv(envelope.IdentityEnvVar)
+
+			os.Setenv(envelope.SignersEnvVar, signer)
+			defer os.Unsetenv(envelope.SignersEnvVar)
+
+			opened, err := envelope.Unwrap(sealed)
+			Expect(err).To(Succeed())
+			Expect(opened).To(Equal(plaintext))
+		})
+	})
+
+	When("the recipient is invalid", func() {
+		It("should fail to seal", func() {
+			_, recipient, err := envelope.NewIdentity()
+			Expect(err).To(Succeed())
+
+			_, err = envelope.SealForRecipient(plaintext, strings.Replace(recipient, "q", "p", 1)+"x", signingKey)
+			Expect(err).ToNot(Succeed())
+		})
+	})
+
+	When("the signing key is invalid", func() {
+		It("should fail to seal", func() {
+			_, err := envelope.SealWithPassphrase(plaintext, "correct horse", strings.TrimPrefix(signingKey, envelope.SigningKeyPrefix))
+			Expect(err).ToNot(Succeed())
+		})
+	})
+
+	When("the signing key is written to a file", func() {
+		It("should read it back", func() {
+			dir, err := os.MkdirTemp("", "envelope")
+			Expect(err).To(Succeed())
+
+			defer os.RemoveAll(dir)
+
+			keyFile := filepath.Join(dir, "signing-key.txt")
+			Expect(envelope.WriteSigningKey(keyFile, signingKey)).To(Succeed())
+
+			read, err := envelope.ReadSigningKey(keyFile)
+			Expect(err).To(Succeed())
+			Expect(read).To(Equal(signingKey))
+		})
+	})
+
+	When("the data isn't encrypted", func() {
+		It("should unwrap it unchanged", func() {
+			unwrapped, err := envelope.Unwrap(plaintext)
+			Expect(err).To(Succeed())
+			Expect(unwrapped).To(Equal(plaintext))
+		})
+	})
+})
+
+// tamper applies the given change to the serialized envelope.
+func tamper(sealed []byte, change func(e map[string]interface{})) []byte {
+	e := map[string]interface{}{}
+	Expect(json.Unmarshal(sealed, &e)).To(Succeed())
+
+	change(e)
+
+	tampered, err := json.Marshal(e)
+	Expect(err).To(Succeed())
+
+	return tampered
+}
+
+// resign replaces the envelope's signature with a valid one from another signer, leaving the ciphertext unchanged.
+func resign(sealed []byte, signingKey, signer string) []byte {
+	e, err := envelope.Parse(sealed)
+	Expect(err).To(Succeed())
+
+	e.Signer = signer
+	e.Signature = nil
+
+	unsigned, err := json.Marshal(e)
+	Expect(err).To(Succeed())
+
+	seed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(signingKey, envelope.SigningKeyPrefix))
+	Expect(err).To(Succeed())
+
+	e.Signature = ed25519.Sign(ed25519.NewKeyFromSeed(seed), unsigned)
+
+	resigned, err := json.Marshal(e)
+	Expect(err).To(Succeed())
+
+	return resigned
+}
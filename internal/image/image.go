@@ -50,7 +50,7 @@ func GetOverrides(imageOverrideArr []string) (map[string]string, error) {
 
 		for _, s := range imageOverrideArr {
 			key := strings.Split(s, "=")[0]
-			if invalidImageName(key) {
+			if !IsValidName(key) {
 				return nil, fmt.Errorf("invalid image name %s provided. Please choose from %q", key, names.ValidImageNames)
 			}
 
@@ -64,12 +64,13 @@ func GetOverrides(imageOverrideArr []string) (map[string]string, error) {
 	return map[string]string{}, nil
 }
 
-func invalidImageName(key string) bool {
+// IsValidName returns whether the given key is a component image name which can be overridden.
+func IsValidName(key string) bool {
 	for _, name := range names.ValidImageNames {
 		if key == name {
-			return false
+			return true
 		}
 	}
 
-	return true
+	return false
 }
@@ -96,6 +96,11 @@ func ListGateways(clientset kubernetes.Interface) ([]string, error) {
 	return getNodeNames(labeledNodes), nil
 }
 
+// RemoveGatewayLabel removes the gateway label from the specified node.
+func RemoveGatewayLabel(clientset kubernetes.Interface, nodeName string) error {
+	return patchLabels(clientset, nodeName, fmt.Sprintf(`{%q:null}`, constants.SubmarinerGatewayLabel))
+}
+
 // this function was sourced from:
 // https://github.com/kubernetes/kubernetes/blob/a3ccea9d8743f2ff82e41b6c2af6dc2c41dc7b10/test/utils/density_utils.go#L36
 func addLabels(clientset kubernetes.Interface, nodeName string, labelsToAdd map[string]string) error {
@@ -104,7 +109,10 @@ func addLabels(clientset kubernetes.Interface, nodeName string, labelsToAdd map[
 		tokens = append(tokens, fmt.Sprintf("%q:%q", k, v))
 	}
 
-	labelString := "{" + strings.Join(tokens, ",") + "}"
+	return patchLabels(clientset, nodeName, "{"+strings.Join(tokens, ",")+"}")
+}
+
+func patchLabels(clientset kubernetes.Interface, nodeName, labelString string) error {
 	patch := fmt.Sprintf(`{"metadata":{"labels":%v}}`, labelString)
 
 	// retry is necessary because nodes get updated every 10 seconds, and a patch can happen
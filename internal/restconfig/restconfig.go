@@ -19,6 +19,7 @@ limitations under the License.
 package restconfig
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -30,9 +31,11 @@ import (
 	"github.com/submariner-io/shipyard/test/e2e/framework"
 	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
 	"github.com/submariner-io/submariner-operator/internal/exit"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd/utils"
 	"github.com/submariner-io/submariner-operator/pkg/version"
 	subv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -64,6 +67,11 @@ func NewProducerFrom(kubeConfig, kubeContext string) Producer {
 	}
 }
 
+// ForContext returns a Producer for the given context, using the same kubeconfig as this Producer.
+func (rcp *Producer) ForContext(kubeContext string) Producer {
+	return NewProducerFrom(rcp.kubeConfig, kubeContext)
+}
+
 func (rcp *Producer) AddKubeConfigFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVar(&rcp.kubeConfig, "kubeconfig", "", "absolute path(s) to the kubeconfig file(s)")
 }
@@ -170,29 +178,40 @@ func (rcp *Producer) ForClusters() ([]RestConfig, error) {
 	return restConfigs, nil
 }
 
-func ForBroker(submariner *v1alpha1.Submariner, serviceDisc *v1alpha1.ServiceDiscovery) (*rest.Config, string, error) {
+func ForBroker(kubeClient kubernetes.Interface, submariner *v1alpha1.Submariner,
+	serviceDisc *v1alpha1.ServiceDiscovery) (*rest.Config, string, error) {
 	var restConfig *rest.Config
 	var namespace string
 	var err error
 
-	// This is used in subctl; the broker secret isn't available mounted, so we use the old strings for now
+	// This is used in subctl; the broker secret isn't available mounted, so we read its contents
 	if submariner != nil {
+		token, ca, credErr := brokerCredentials(kubeClient, submariner.Namespace, submariner.Spec.BrokerK8sSecret,
+			submariner.Spec.BrokerK8sApiServerToken, submariner.Spec.BrokerK8sCA)
+		if credErr != nil {
+			return nil, "", credErr
+		}
+
 		// Try to authorize against the submariner Cluster resource as we know the CRD should exist and the credentials
 		// should allow read access.
 		restConfig, _, err = resource.GetAuthorizedRestConfigFromData(submariner.Spec.BrokerK8sApiServer,
-			submariner.Spec.BrokerK8sApiServerToken,
-			submariner.Spec.BrokerK8sCA, &rest.TLSClientConfig{}, schema.GroupVersionResource{
+			token, ca, &rest.TLSClientConfig{}, schema.GroupVersionResource{
 				Group:    subv1.SchemeGroupVersion.Group,
 				Version:  subv1.SchemeGroupVersion.Version,
 				Resource: "clusters",
 			}, submariner.Spec.BrokerK8sRemoteNamespace)
 		namespace = submariner.Spec.BrokerK8sRemoteNamespace
 	} else if serviceDisc != nil {
+		token, ca, credErr := brokerCredentials(kubeClient, serviceDisc.Namespace, serviceDisc.Spec.BrokerK8sSecret,
+			serviceDisc.Spec.BrokerK8sApiServerToken, serviceDisc.Spec.BrokerK8sCA)
+		if credErr != nil {
+			return nil, "", credErr
+		}
+
 		// Try to authorize against the ServiceImport resource as we know the CRD should exist and the credentials
 		// should allow read access.
 		restConfig, _, err = resource.GetAuthorizedRestConfigFromData(serviceDisc.Spec.BrokerK8sApiServer,
-			serviceDisc.Spec.BrokerK8sApiServerToken,
-			serviceDisc.Spec.BrokerK8sCA, &rest.TLSClientConfig{}, schema.GroupVersionResource{
+			token, ca, &rest.TLSClientConfig{}, schema.GroupVersionResource{
 				Group:    "multicluster.x-k8s.io",
 				Version:  "v1alpha1",
 				Resource: "serviceimports",
@@ -203,6 +222,23 @@ func ForBroker(submariner *v1alpha1.Submariner, serviceDisc *v1alpha1.ServiceDis
 	return restConfig, namespace, errors.Wrap(err, "error getting auth rest config")
 }
 
+// brokerCredentials returns the broker token and CA stored in the given Secret. The inline values are only used for
+// resources which don't reference a Secret, as deployed by old versions of subctl and not yet migrated by the operator.
+func brokerCredentials(kubeClient kubernetes.Interface, namespace, secretName, token, ca string) (string, string, error) {
+	if secretName == "" {
+		return token, ca, nil
+	}
+
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil {
+		return "", "", errors.Wrapf(err, "error retrieving the broker Secret %q", secretName)
+	}
+
+	token, ca = broker.CredentialsFromSecret(secret)
+
+	return token, ca, nil
+}
+
 func clientConfigAndClusterName(rules *clientcmd.ClientConfigLoadingRules, overrides *clientcmd.ConfigOverrides) (RestConfig, error) {
 	config := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
 
@@ -36,8 +36,10 @@ import (
 	"github.com/submariner-io/admiral/pkg/log/kzerolog"
 	"github.com/submariner-io/submariner-operator/api"
 	submarinerv1alpha1 "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	submarinerv1beta1 "github.com/submariner-io/submariner-operator/api/submariner/v1beta1"
 	"github.com/submariner-io/submariner-operator/controllers"
 	"github.com/submariner-io/submariner-operator/controllers/submariner"
+	"github.com/submariner-io/submariner-operator/controllers/webhook"
 	"github.com/submariner-io/submariner-operator/pkg/crd"
 	"github.com/submariner-io/submariner-operator/pkg/lighthouse"
 	"github.com/submariner-io/submariner-operator/pkg/metrics"
@@ -65,9 +67,10 @@ var (
 )
 
 var (
-	scheme = apiruntime.NewScheme()
-	log    = logf.Log.WithName("cmd")
-	help   = false
+	scheme         = apiruntime.NewScheme()
+	log            = logf.Log.WithName("cmd")
+	help           = false
+	enableWebhooks = false
 )
 
 func printVersion() {
@@ -81,12 +84,15 @@ func printVersion() {
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(submarinerv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(submarinerv1beta1.AddToScheme(scheme))
 	utilruntime.Must(apiextensions.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
 func init() {
 	flag.BoolVar(&help, "help", help, "Print usage options")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", enableWebhooks,
+		"Serve the admission webhooks; requires a serving certificate in the webhook server's certificate directory")
 }
 
 func main() {
@@ -138,13 +144,15 @@ func main() {
 
 	log.Info("Registering Components.")
 
-	// Set up the CRDs we need
+	// Set up the CRDs we need, without downgrading any installed by a newer subctl
 	crdUpdater, err := crd.UpdaterFromRestConfig(cfg)
 	if err != nil {
 		log.Error(err, "")
 		os.Exit(1)
 	}
 
+	crdUpdater = crd.WithSkewPolicy(crdUpdater, crd.SkipOlder)
+
 	log.Info("Creating the Lighthouse CRDs")
 
 	updated, err := lighthouse.Ensure(crdUpdater, lighthouse.DataCluster)
@@ -199,6 +207,13 @@ func main() {
 	}
 	// +kubebuilder:scaffold:builder
 
+	if enableWebhooks {
+		if err := webhook.AddToManager(mgr); err != nil {
+			log.Error(err, "unable to set up the admission webhooks")
+			os.Exit(1)
+		}
+	}
+
 	// Start the Cmd
 	log.Info("Starting the Cmd.")
 
@@ -235,6 +250,14 @@ func createServiceMonitors(ctx context.Context, cfg *rest.Config, servicePorts [
 	} else {
 		log.Info("Created service monitors", "service monitors", serviceMonitors)
 	}
+
+	// Example alerts based on the operator's metrics
+	rule, err := metrics.CreatePrometheusRule(cfg, namespace)
+	if err != nil {
+		log.Info("Could not create PrometheusRule object", "error", err.Error())
+	} else {
+		log.Info("Created the Prometheus rules", "rule", rule.Name)
+	}
 }
 
 // serveCRMetrics gets the Operator/CustomResource GVKs and generates metrics based on those types.
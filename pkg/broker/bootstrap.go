@@ -0,0 +1,414 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/admiral/pkg/stringset"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	operatorClientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// BootstrapClusterIDLabel identifies join token service accounts, and the cluster they allow to join.
+	BootstrapClusterIDLabel = "submariner.io/bootstrap-cluster-id"
+	// BootstrapExpiryAnnotation records when a join token expires.
+	BootstrapExpiryAnnotation = "submariner.io/bootstrap-expires"
+	// BootstrapPendingAnnotation marks cluster service accounts created for a join token which hasn't been used yet.
+	BootstrapPendingAnnotation = "submariner.io/pending-join"
+
+	// MinBootstrapTTL is the shortest token lifetime accepted by the API server.
+	MinBootstrapTTL = 10 * time.Minute
+
+	bootstrapSAPrefix = "join-"
+)
+
+// BootstrapToken describes a join token: a short-lived credential which only allows a single cluster to retrieve its
+// broker token, and which is revoked once used.
+type BootstrapToken struct {
+	ClusterID string `json:"clusterID"`
+	// Name is the name of the service account, role and role binding backing the join token.
+	Name string `json:"name"`
+	// ClusterTokenSecret is the name of the Secret holding the cluster's broker token.
+	ClusterTokenSecret string    `json:"clusterTokenSecret"`
+	Expires            time.Time `json:"expires"`
+}
+
+// Validate checks that the join token can be used to join the given cluster.
+func (b *BootstrapToken) Validate(clusterID string) error {
+	if clusterID != b.ClusterID {
+		return fmt.Errorf("the join token was issued for cluster %q, it can't be used to join cluster %q", b.ClusterID, clusterID)
+	}
+
+	if time.Now().After(b.Expires) {
+		return fmt.Errorf("the join token expired at %s, a new one must be created with \"subctl broker token create\"",
+			b.Expires.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// NewBootstrapInfo creates a join token for the given cluster, valid for the given duration, and returns broker
+// information using it in place of the broker administrator token held in adminInfo. The cluster's service account is
+// created on the broker if necessary, marked as pending until the join token is used, as is the pending
+// GlobalnetAllocation the join token can complete. Expired join tokens are deleted beforehand.
+func NewBootstrapInfo(adminInfo *Info, kubeClient kubernetes.Interface, operatorClient operatorClientset.Interface, clusterID string,
+	ttl time.Duration) (*Info, error) {
+	if ttl < MinBootstrapTTL {
+		return nil, fmt.Errorf("join tokens must be valid for at least %s", MinBootstrapTTL)
+	}
+
+	inNamespace := string(adminInfo.ClientToken.Data[SecretNamespaceKey])
+
+	if _, err := PruneExpiredBootstrapTokens(kubeClient, operatorClient, inNamespace); err != nil {
+		return nil, err
+	}
+
+	clusterToken, err := createPendingClusterSA(kubeClient, clusterID, inNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := createPendingGlobalnetAllocation(operatorClient, clusterID, inNamespace); err != nil {
+		return nil, err
+	}
+
+	bootstrap := &BootstrapToken{
+		ClusterID:          clusterID,
+		Name:               bootstrapSAPrefix + clusterID + "-" + utilrand.String(tokenRandomSuffixLength),
+		ClusterTokenSecret: clusterToken.Name,
+		Expires:            time.Now().Add(ttl).UTC().Truncate(time.Second),
+	}
+
+	token, err := createBootstrapCredentials(kubeClient, bootstrap, inNamespace, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	info := *adminInfo
+	info.Bootstrap = bootstrap
+	info.ClientToken = &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: bootstrap.Name, Namespace: inNamespace},
+		Data: map[string][]byte{
+			SecretTokenKey:     []byte(token),
+			SecretCAKey:        adminInfo.ClientToken.Data[SecretCAKey],
+			SecretNamespaceKey: []byte(inNamespace),
+		},
+	}
+
+	return &info, nil
+}
+
+// ExchangeBootstrapToken retrieves the cluster's broker token using the join token and marks the cluster's service
+// account as in use, then revokes the join token by deleting its service account; the role and role binding are
+// garbage-collected along with it.
+func ExchangeBootstrapToken(kubeClient kubernetes.Interface, bootstrap *BootstrapToken, inNamespace string) (*v1.Secret, error) {
+	clusterToken, err := kubeClient.CoreV1().Secrets(inNamespace).Get(context.TODO(), bootstrap.ClusterTokenSecret,
+		metav1.GetOptions{})
+	if apierrors.IsUnauthorized(err) {
+		return nil, errors.New("the join token is no longer valid, it has either expired or already been used")
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error retrieving the cluster's broker token")
+	}
+
+	// Once the cluster holds its broker token, its service account must survive the join token's expiry
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, BootstrapPendingAnnotation)
+
+	_, err = kubeClient.CoreV1().ServiceAccounts(inNamespace).Patch(context.TODO(), ClusterSAName(bootstrap.ClusterID),
+		types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error marking the cluster's service account as in use")
+	}
+
+	err = kubeClient.CoreV1().ServiceAccounts(inNamespace).Delete(context.TODO(), bootstrap.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, errors.Wrap(err, "error revoking the join token")
+	}
+
+	return clusterToken, nil
+}
+
+// PruneExpiredBootstrapTokens deletes the join tokens which have expired, returning their names. The pending service
+// accounts, with their token, and the pending GlobalnetAllocations of clusters which no longer have a valid join token
+// are deleted too.
+func PruneExpiredBootstrapTokens(kubeClient kubernetes.Interface, operatorClient operatorClientset.Interface, inNamespace string) (
+	[]string, error) {
+	serviceAccounts, err := kubeClient.CoreV1().ServiceAccounts(inNamespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: BootstrapClusterIDLabel,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the join tokens")
+	}
+
+	pruned := []string{}
+	prunedClusters := stringset.New()
+	validClusters := stringset.New()
+
+	for i := range serviceAccounts.Items {
+		sa := &serviceAccounts.Items[i]
+		clusterID := sa.Labels[BootstrapClusterIDLabel]
+
+		// Tokens with a missing or invalid expiry are considered expired
+		expires, err := time.Parse(time.RFC3339, sa.Annotations[BootstrapExpiryAnnotation])
+		if err == nil && time.Now().Before(expires) {
+			validClusters.Add(clusterID)
+			continue
+		}
+
+		err = kubeClient.CoreV1().ServiceAccounts(inNamespace).Delete(context.TODO(), sa.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return pruned, errors.Wrapf(err, "error deleting the expired join token %q", sa.Name)
+		}
+
+		pruned = append(pruned, sa.Name)
+		prunedClusters.Add(clusterID)
+	}
+
+	for _, clusterID := range prunedClusters.Elements() {
+		if validClusters.Contains(clusterID) {
+			continue
+		}
+
+		if err := deletePendingClusterSA(kubeClient, clusterID, inNamespace); err != nil {
+			return pruned, err
+		}
+
+		if err := deletePendingGlobalnetAllocation(operatorClient, clusterID, inNamespace); err != nil {
+			return pruned, err
+		}
+	}
+
+	return pruned, nil
+}
+
+// createPendingClusterSA ensures the cluster's service account exists and returns its token. A service account created
+// here is marked as pending, so that it is deleted along with the join token if the latter expires unused; existing
+// service accounts are kept as they are.
+func createPendingClusterSA(kubeClient kubernetes.Interface, clusterID, inNamespace string) (*v1.Secret, error) {
+	sa := NewBrokerSA(ClusterSAName(clusterID))
+	sa.Annotations = map[string]string{BootstrapPendingAnnotation: "true"}
+
+	_, err := kubeClient.CoreV1().ServiceAccounts(inNamespace).Create(context.TODO(), sa, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, errors.Wrap(err, "error creating cluster sa")
+	}
+
+	return CreateSAForCluster(kubeClient, clusterID, inNamespace)
+}
+
+// deletePendingClusterSA deletes the cluster's service account, its token and its role binding if no join token was
+// exchanged for them.
+func deletePendingClusterSA(kubeClient kubernetes.Interface, clusterID, inNamespace string) error {
+	saName := ClusterSAName(clusterID)
+
+	sa, err := kubeClient.CoreV1().ServiceAccounts(inNamespace).Get(context.TODO(), saName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving the service account for cluster %q", clusterID)
+	}
+
+	if _, pending := sa.Annotations[BootstrapPendingAnnotation]; !pending {
+		return nil
+	}
+
+	// The precondition ensures the service account wasn't handed out in the meantime
+	err = kubeClient.CoreV1().ServiceAccounts(inNamespace).Delete(context.TODO(), saName, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: &sa.ResourceVersion},
+	})
+	if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "error deleting the pending service account for cluster %q", clusterID)
+	}
+
+	for i := range sa.Secrets {
+		err = kubeClient.CoreV1().Secrets(inNamespace).Delete(context.TODO(), sa.Secrets[i].Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting the token of the pending service account for cluster %q", clusterID)
+		}
+	}
+
+	binding := NewBrokerRoleBinding(saName, submarinerBrokerClusterRole, inNamespace)
+
+	err = kubeClient.RbacV1().RoleBindings(inNamespace).Delete(context.TODO(), binding.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting the role binding of the pending service account for cluster %q", clusterID)
+	}
+
+	return nil
+}
+
+// createPendingGlobalnetAllocation creates the cluster's GlobalnetAllocation, marked as pending, so that the join token
+// only needs to be allowed to update it: RBAC can't restrict creations to a given name. Existing allocations are kept,
+// and Brokers which don't serve GlobalnetAllocations are ignored.
+func createPendingGlobalnetAllocation(operatorClient operatorClientset.Interface, clusterID, inNamespace string) error {
+	_, err := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(inNamespace).Create(context.TODO(), &v1alpha1.GlobalnetAllocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        clusterID,
+			Annotations: map[string]string{v1alpha1.GlobalnetAllocationPendingAnnotation: "true"},
+		},
+		Spec: v1alpha1.GlobalnetAllocationSpec{ClusterID: clusterID},
+	}, metav1.CreateOptions{})
+	if err == nil || apierrors.IsAlreadyExists(err) || apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	return errors.Wrapf(err, "error creating the GlobalnetAllocation for cluster %q", clusterID)
+}
+
+// deletePendingGlobalnetAllocation deletes the cluster's GlobalnetAllocation if no join token completed it.
+func deletePendingGlobalnetAllocation(operatorClient operatorClientset.Interface, clusterID, inNamespace string) error {
+	allocations := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(inNamespace)
+
+	allocation, err := allocations.Get(context.TODO(), clusterID, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving the GlobalnetAllocation for cluster %q", clusterID)
+	}
+
+	if _, pending := allocation.Annotations[v1alpha1.GlobalnetAllocationPendingAnnotation]; !pending {
+		return nil
+	}
+
+	err = allocations.Delete(context.TODO(), clusterID, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: &allocation.ResourceVersion},
+	})
+	if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		return errors.Wrapf(err, "error deleting the pending GlobalnetAllocation for cluster %q", clusterID)
+	}
+
+	return nil
+}
+
+// createBootstrapCredentials creates the service account, role and role binding backing the join token, and returns
+// a token for the service account which the API server considers invalid after the given duration.
+func createBootstrapCredentials(kubeClient kubernetes.Interface, bootstrap *BootstrapToken, inNamespace string,
+	ttl time.Duration) (string, error) {
+	sa, err := kubeClient.CoreV1().ServiceAccounts(inNamespace).Create(context.TODO(), &v1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        bootstrap.Name,
+			Labels:      map[string]string{BootstrapClusterIDLabel: bootstrap.ClusterID},
+			Annotations: map[string]string{BootstrapExpiryAnnotation: bootstrap.Expires.Format(time.RFC3339)},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "error creating the join token service account")
+	}
+
+	// The role and binding are owned by the service account, so that deleting it is enough to clean up
+	ownerReferences := []metav1.OwnerReference{{
+		APIVersion: "v1",
+		Kind:       "ServiceAccount",
+		Name:       sa.Name,
+		UID:        sa.UID,
+	}}
+
+	role := newBootstrapRole(bootstrap)
+	role.OwnerReferences = ownerReferences
+
+	if _, err := kubeClient.RbacV1().Roles(inNamespace).Create(context.TODO(), role, metav1.CreateOptions{}); err != nil {
+		return "", errors.Wrap(err, "error creating the join token role")
+	}
+
+	binding := NewBrokerRoleBinding(sa.Name, role.Name, inNamespace)
+	binding.OwnerReferences = ownerReferences
+
+	if _, err := kubeClient.RbacV1().RoleBindings(inNamespace).Create(context.TODO(), binding, metav1.CreateOptions{}); err != nil {
+		return "", errors.Wrap(err, "error creating the join token role binding")
+	}
+
+	expirationSeconds := int64(ttl.Seconds())
+
+	// Requested tokens are bound to the service account, so they are invalidated by its deletion as well as by expiry
+	tokenRequest, err := kubeClient.CoreV1().ServiceAccounts(inNamespace).CreateToken(context.TODO(), sa.Name,
+		&authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "error requesting the join token")
+	}
+
+	return tokenRequest.Status.Token, nil
+}
+
+// newBootstrapRole returns the role granted to a join token: it can only read the cluster's broker token and the
+// globalnet configuration, mark the cluster's service account as in use, request the cluster's global CIDR by completing
+// its pending GlobalnetAllocation, and revoke itself.
+func newBootstrapRole(bootstrap *BootstrapToken) *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bootstrap.Name,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				Verbs:         []string{"get"},
+				APIGroups:     []string{""},
+				Resources:     []string{"secrets"},
+				ResourceNames: []string{bootstrap.ClusterTokenSecret},
+			},
+			{
+				Verbs:         []string{"get"},
+				APIGroups:     []string{""},
+				Resources:     []string{"configmaps"},
+				ResourceNames: []string{GlobalCIDRConfigMapName},
+			},
+			{
+				Verbs:         []string{"patch"},
+				APIGroups:     []string{""},
+				Resources:     []string{"serviceaccounts"},
+				ResourceNames: []string{ClusterSAName(bootstrap.ClusterID)},
+			},
+			{
+				Verbs:         []string{"delete"},
+				APIGroups:     []string{""},
+				Resources:     []string{"serviceaccounts"},
+				ResourceNames: []string{bootstrap.Name},
+			},
+			{
+				Verbs:         []string{"get", "update"},
+				APIGroups:     []string{"submariner.io"},
+				Resources:     []string{"globalnetallocations"},
+				ResourceNames: []string{bootstrap.ClusterID},
+			},
+		},
+	}
+}
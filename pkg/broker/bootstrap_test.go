@@ -0,0 +1,330 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	fakeOperator "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned/fake"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeKubeClient "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const clusterTokenName = "cluster-east-token-abcde"
+
+var _ = Describe("Join tokens", func() {
+	var (
+		kubeClient     *fakeKubeClient.Clientset
+		operatorClient *fakeOperator.Clientset
+		adminInfo      *broker.Info
+	)
+
+	BeforeEach(func() {
+		saName := broker.ClusterSAName(clusterID)
+
+		kubeClient = fakeKubeClient.NewSimpleClientset(
+			&corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: saName, Namespace: brokerNamespace},
+				Secrets:    []corev1.ObjectReference{{Name: clusterTokenName}},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterTokenName, Namespace: brokerNamespace},
+				Type:       corev1.SecretTypeServiceAccountToken,
+				Data:       map[string][]byte{broker.SecretTokenKey: []byte("cluster-token")},
+			})
+
+		operatorClient = fakeOperator.NewSimpleClientset()
+
+		kubeClient.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "token" {
+				return false, nil, nil
+			}
+
+			return true, &authenticationv1.TokenRequest{
+				Status: authenticationv1.TokenRequestStatus{Token: "join-token"},
+			}, nil
+		})
+
+		adminInfo = &broker.Info{
+			BrokerURL: "https://broker:6443",
+			ClientToken: &corev1.Secret{
+				Data: map[string][]byte{
+					broker.SecretTokenKey:     []byte("admin-token"),
+					broker.SecretCAKey:        []byte("ca"),
+					broker.SecretNamespaceKey: []byte(brokerNamespace),
+				},
+			},
+		}
+	})
+
+	When("a join token is created", func() {
+		It("should replace the broker administrator token and only grant access to the cluster's token", func() {
+			joinInfo, err := broker.NewBootstrapInfo(adminInfo, kubeClient, operatorClient, clusterID, time.Hour)
+			Expect(err).To(Succeed())
+
+			Expect(joinInfo.BrokerURL).To(Equal(adminInfo.BrokerURL))
+			Expect(joinInfo.Bootstrap).ToNot(BeNil())
+			Expect(joinInfo.Bootstrap.ClusterID).To(Equal(clusterID))
+			Expect(joinInfo.Bootstrap.ClusterTokenSecret).To(Equal(clusterTokenName))
+			Expect(joinInfo.Bootstrap.Expires).To(BeTemporally("~", time.Now().Add(time.Hour), 5*time.Second))
+			Expect(joinInfo.ClientToken.Data).To(Equal(map[string][]byte{
+				broker.SecretTokenKey:     []byte("join-token"),
+				broker.SecretCAKey:        []byte("ca"),
+				broker.SecretNamespaceKey: []byte(brokerNamespace),
+			}))
+			Expect(adminInfo.Bootstrap).To(BeNil())
+
+			sa, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), joinInfo.Bootstrap.Name,
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(sa.Labels).To(HaveKeyWithValue(broker.BootstrapClusterIDLabel, clusterID))
+			Expect(sa.Annotations).To(HaveKey(broker.BootstrapExpiryAnnotation))
+
+			role, err := kubeClient.RbacV1().Roles(brokerNamespace).Get(context.TODO(), joinInfo.Bootstrap.Name, metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(role.OwnerReferences).To(HaveLen(1))
+
+			for _, rule := range role.Rules {
+				if len(rule.Resources) == 1 && rule.Resources[0] == "secrets" {
+					Expect(rule.ResourceNames).To(Equal([]string{clusterTokenName}))
+				}
+
+				// The token can't request a global CIDR for another cluster
+				if len(rule.Resources) == 1 && rule.Resources[0] == "globalnetallocations" {
+					Expect(rule.Verbs).ToNot(ContainElement("create"))
+					Expect(rule.ResourceNames).To(Equal([]string{clusterID}))
+				}
+			}
+
+			allocation, err := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace).Get(context.TODO(), clusterID,
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(allocation.Spec.ClusterID).To(Equal(clusterID))
+			Expect(allocation.Annotations).To(HaveKey(v1alpha1.GlobalnetAllocationPendingAnnotation))
+		})
+	})
+
+	When("a join token is created for a cluster with a GlobalnetAllocation", func() {
+		It("should keep the existing allocation", func() {
+			operatorClient = fakeOperator.NewSimpleClientset(&v1alpha1.GlobalnetAllocation{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterID, Namespace: brokerNamespace},
+				Spec:       v1alpha1.GlobalnetAllocationSpec{ClusterID: clusterID, ClusterSize: 1024},
+			})
+
+			_, err := broker.NewBootstrapInfo(adminInfo, kubeClient, operatorClient, clusterID, time.Hour)
+			Expect(err).To(Succeed())
+
+			allocation, err := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace).Get(context.TODO(), clusterID,
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(allocation.Spec.ClusterSize).To(Equal(uint(1024)))
+			Expect(allocation.Annotations).ToNot(HaveKey(v1alpha1.GlobalnetAllocationPendingAnnotation))
+		})
+	})
+
+	When("a join token is created for a cluster without a service account", func() {
+		It("should create the cluster's service account and mark it as pending", func() {
+			const westTokenName = "cluster-west-token-fghij"
+
+			_, err := kubeClient.CoreV1().Secrets(brokerNamespace).Create(context.TODO(), &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: westTokenName},
+				Type:       corev1.SecretTypeServiceAccountToken,
+			}, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+
+			// Emulate the token controller
+			kubeClient.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if sa, ok := action.(k8stesting.CreateAction).GetObject().(*corev1.ServiceAccount); ok {
+					sa.Secrets = []corev1.ObjectReference{{Name: westTokenName}}
+				}
+
+				return false, nil, nil
+			})
+
+			joinInfo, err := broker.NewBootstrapInfo(adminInfo, kubeClient, operatorClient, "west", time.Hour)
+			Expect(err).To(Succeed())
+			Expect(joinInfo.Bootstrap.ClusterTokenSecret).To(Equal(westTokenName))
+
+			sa, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), broker.ClusterSAName("west"),
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(sa.Annotations).To(HaveKey(broker.BootstrapPendingAnnotation))
+		})
+	})
+
+	When("a join token is requested with a too short lifetime", func() {
+		It("should return an error", func() {
+			_, err := broker.NewBootstrapInfo(adminInfo, kubeClient, operatorClient, clusterID, time.Minute)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("a join token is exchanged", func() {
+		It("should return the cluster's token, mark the cluster's service account as in use and revoke the join token", func() {
+			markClusterSAPending(kubeClient)
+
+			joinInfo, err := broker.NewBootstrapInfo(adminInfo, kubeClient, operatorClient, clusterID, time.Hour)
+			Expect(err).To(Succeed())
+
+			clusterToken, err := broker.ExchangeBootstrapToken(kubeClient, joinInfo.Bootstrap, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(clusterToken.Data[broker.SecretTokenKey]).To(Equal([]byte("cluster-token")))
+
+			sa, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), broker.ClusterSAName(clusterID),
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(sa.Annotations).ToNot(HaveKey(broker.BootstrapPendingAnnotation))
+
+			_, err = kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), joinInfo.Bootstrap.Name,
+				metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	When("join tokens have expired", func() {
+		It("should prune them", func() {
+			for name, expires := range map[string]time.Time{
+				"join-expired": time.Now().Add(-time.Minute),
+				"join-valid":   time.Now().Add(time.Hour),
+			} {
+				_, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Create(context.TODO(), &corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Labels:      map[string]string{broker.BootstrapClusterIDLabel: clusterID},
+						Annotations: map[string]string{broker.BootstrapExpiryAnnotation: expires.Format(time.RFC3339)},
+					},
+				}, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+			}
+
+			pruned, err := broker.PruneExpiredBootstrapTokens(kubeClient, operatorClient, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(pruned).To(Equal([]string{"join-expired"}))
+		})
+
+		It("should delete the pending service accounts of clusters without a valid join token", func() {
+			markClusterSAPending(kubeClient)
+			createJoinSA(kubeClient, clusterID, time.Now().Add(-time.Minute))
+
+			binding := broker.NewBrokerRoleBinding(broker.ClusterSAName(clusterID), "submariner-k8s-broker-cluster", brokerNamespace)
+			_, err := kubeClient.RbacV1().RoleBindings(brokerNamespace).Create(context.TODO(), binding, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+
+			_, err = broker.PruneExpiredBootstrapTokens(kubeClient, operatorClient, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			_, err = kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), broker.ClusterSAName(clusterID),
+				metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			_, err = kubeClient.CoreV1().Secrets(brokerNamespace).Get(context.TODO(), clusterTokenName, metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			_, err = kubeClient.RbacV1().RoleBindings(brokerNamespace).Get(context.TODO(), binding.Name, metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should keep the service accounts of clusters which have joined", func() {
+			createJoinSA(kubeClient, clusterID, time.Now().Add(-time.Minute))
+
+			_, err := broker.PruneExpiredBootstrapTokens(kubeClient, operatorClient, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			_, err = kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), broker.ClusterSAName(clusterID),
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			_, err = kubeClient.CoreV1().Secrets(brokerNamespace).Get(context.TODO(), clusterTokenName, metav1.GetOptions{})
+			Expect(err).To(Succeed())
+		})
+
+		It("should delete the pending GlobalnetAllocations of clusters without a valid join token", func() {
+			for id, tokenExpiry := range map[string]time.Time{
+				clusterID: time.Now().Add(-time.Minute),
+				"west":    time.Now().Add(time.Hour),
+			} {
+				_, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Create(context.TODO(), &corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "join-" + id,
+						Labels:      map[string]string{broker.BootstrapClusterIDLabel: id},
+						Annotations: map[string]string{broker.BootstrapExpiryAnnotation: tokenExpiry.Format(time.RFC3339)},
+					},
+				}, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+
+				_, err = operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace).Create(context.TODO(),
+					&v1alpha1.GlobalnetAllocation{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        id,
+							Annotations: map[string]string{v1alpha1.GlobalnetAllocationPendingAnnotation: "true"},
+						},
+						Spec: v1alpha1.GlobalnetAllocationSpec{ClusterID: id},
+					}, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+			}
+
+			_, err := broker.PruneExpiredBootstrapTokens(kubeClient, operatorClient, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			allocations := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace)
+			_, err = allocations.Get(context.TODO(), clusterID, metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			_, err = allocations.Get(context.TODO(), "west", metav1.GetOptions{})
+			Expect(err).To(Succeed())
+		})
+	})
+
+	When("a join token is validated", func() {
+		It("should reject other clusters and expired tokens", func() {
+			bootstrap := &broker.BootstrapToken{ClusterID: clusterID, Expires: time.Now().Add(time.Hour)}
+			Expect(bootstrap.Validate(clusterID)).To(Succeed())
+			Expect(bootstrap.Validate("west")).ToNot(Succeed())
+
+			bootstrap.Expires = time.Now().Add(-time.Minute)
+			Expect(bootstrap.Validate(clusterID)).ToNot(Succeed())
+		})
+	})
+})
+
+func markClusterSAPending(kubeClient *fakeKubeClient.Clientset) {
+	sa, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), broker.ClusterSAName(clusterID), metav1.GetOptions{})
+	Expect(err).To(Succeed())
+
+	sa.Annotations = map[string]string{broker.BootstrapPendingAnnotation: "true"}
+	_, err = kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Update(context.TODO(), sa, metav1.UpdateOptions{})
+	Expect(err).To(Succeed())
+}
+
+func createJoinSA(kubeClient *fakeKubeClient.Clientset, forClusterID string, expires time.Time) {
+	_, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Create(context.TODO(), &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "join-" + forClusterID,
+			Labels:      map[string]string{broker.BootstrapClusterIDLabel: forClusterID},
+			Annotations: map[string]string{broker.BootstrapExpiryAnnotation: expires.Format(time.RFC3339)},
+		},
+	}, metav1.CreateOptions{})
+	Expect(err).To(Succeed())
+}
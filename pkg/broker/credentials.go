@@ -0,0 +1,39 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"encoding/base64"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// The entries in the broker Secret copied to connecting clusters; they match those of the service account token Secret
+// it's copied from.
+const (
+	SecretTokenKey     = "token"
+	SecretCAKey        = "ca.crt"
+	SecretNamespaceKey = "namespace"
+)
+
+// CredentialsFromSecret returns the API server token and base64-encoded CA stored in a broker Secret, in the form expected
+// by resource.GetAuthorizedRestConfigFromData.
+func CredentialsFromSecret(secret *v1.Secret) (token, ca string) {
+	return string(secret.Data[SecretTokenKey]), base64.StdEncoding.EncodeToString(secret.Data[SecretCAKey])
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/submariner-io/admiral/pkg/stringset"
 	"github.com/submariner-io/submariner-operator/internal/component"
 	"github.com/submariner-io/submariner-operator/internal/constants"
+	"github.com/submariner-io/submariner-operator/internal/envelope"
 	"github.com/submariner-io/submariner-operator/internal/rbac"
 	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"k8s.io/client-go/kubernetes"
@@ -37,9 +38,9 @@ import (
 
 const InfoFileName = "broker-info.subm"
 
-func WriteInfoToFile(restConfig *rest.Config, brokerNamespace, ipsecFile string, components stringset.Interface,
+func WriteInfoToFile(filename string, restConfig *rest.Config, brokerNamespace, ipsecFile string, components stringset.Interface,
 	customDomains []string, status reporter.Interface) error {
-	status.Start("Saving broker info to file %q", InfoFileName)
+	status.Start("Saving broker info to file %q", filename)
 	defer status.End()
 
 	kubeClient, err := kubernetes.NewForConfig(restConfig)
@@ -53,15 +54,15 @@ func WriteInfoToFile(restConfig *rest.Config, brokerNamespace, ipsecFile string,
 		return err
 	}
 
-	data.BrokerURL = restConfig.Host + restConfig.APIPath
+	data.BrokerURL = BrokerURL(restConfig)
 
-	newFilename, err := backupIfExists(InfoFileName)
+	newFilename, err := backupIfExists(filename)
 	if err != nil {
 		return status.Error(err, "error backing up the broker file")
 	}
 
 	if newFilename != "" {
-		status.Success("Backed up previous file %q to %q", InfoFileName, newFilename)
+		status.Success("Backed up previous file %q to %q", filename, newFilename)
 	}
 
 	data.ServiceDiscovery = components.Contains(component.ServiceDiscovery)
@@ -71,7 +72,12 @@ func WriteInfoToFile(restConfig *rest.Config, brokerNamespace, ipsecFile string,
 		data.CustomDomains = &customDomains
 	}
 
-	return status.Error(data.writeToFile(InfoFileName), "error saving broker info")
+	return status.Error(data.WriteToFile(filename), "error saving broker info")
+}
+
+// BrokerURL returns the broker URL recorded in the broker information for a broker accessed with the given config.
+func BrokerURL(restConfig *rest.Config) string {
+	return restConfig.Host + restConfig.APIPath
 }
 
 func ReadInfoFromFile(filename string) (*Info, error) {
@@ -80,11 +86,23 @@ func ReadInfoFromFile(filename string) (*Info, error) {
 		return nil, errors.Wrapf(err, "error reading file %q", filename)
 	}
 
+	raw, err = envelope.Unwrap(raw)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error reading file %q", filename)
+	}
+
+	data, err := ParseInfo(raw)
+
+	return data, errors.WithMessagef(err, "error decoding data from file %q", filename)
+}
+
+// ParseInfo parses unencrypted broker info, as stored in legacy files and inside encrypted envelopes.
+func ParseInfo(raw []byte) (*Info, error) {
 	data := &Info{}
 
 	bytes, err := base64.URLEncoding.DecodeString(string(raw))
 	if err != nil {
-		return nil, errors.Wrapf(err, "error decoding data from file %q", filename)
+		return nil, errors.Wrap(err, "error decoding data")
 	}
 
 	return data, errors.Wrap(json.Unmarshal(bytes, data), "error unmarshalling data")
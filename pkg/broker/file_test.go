@@ -0,0 +1,93 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/internal/envelope"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+)
+
+var _ = Describe("ReadInfoFromFile", func() {
+	const brokerURL = "https://broker:6443"
+
+	var (
+		dir      string
+		fileName string
+		legacy   []byte
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		dir, err = os.MkdirTemp("", "broker-info")
+		Expect(err).To(Succeed())
+
+		fileName = filepath.Join(dir, broker.InfoFileName)
+		legacy = []byte(base64.URLEncoding.EncodeToString([]byte(`{"brokerURL":"` + brokerURL + `"}`)))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	When("the file isn't encrypted", func() {
+		It("should read it", func() {
+			Expect(os.WriteFile(fileName, legacy, 0o600)).To(Succeed())
+
+			info, err := broker.ReadInfoFromFile(fileName)
+			Expect(err).To(Succeed())
+			Expect(info.BrokerURL).To(Equal(brokerURL))
+		})
+	})
+
+	When("the file is encrypted with a passphrase", func() {
+		BeforeEach(func() {
+			signingKey, _, err := envelope.NewSigningKey()
+			Expect(err).To(Succeed())
+
+			sealed, err := envelope.SealWithPassphrase(legacy, "secret", signingKey)
+			Expect(err).To(Succeed())
+			Expect(os.WriteFile(fileName, sealed, 0o600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv(envelope.PassphraseEnvVar)).To(Succeed())
+		})
+
+		It("should read it with the passphrase from the environment", func() {
+			Expect(os.Setenv(envelope.PassphraseEnvVar, "secret")).To(Succeed())
+
+			info, err := broker.ReadInfoFromFile(fileName)
+			Expect(err).To(Succeed())
+			Expect(info.BrokerURL).To(Equal(brokerURL))
+		})
+
+		It("should fail without the passphrase", func() {
+			_, err := broker.ReadInfoFromFile(fileName)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(envelope.PassphraseEnvVar))
+		})
+	})
+})
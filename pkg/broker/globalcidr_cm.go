@@ -122,15 +122,52 @@ func UpdateGlobalnetConfigMap(k8sClientset kubernetes.Interface, namespace strin
 		clusterInfo = append(clusterInfo, newEntry)
 	}
 
+	return updateGlobalnetClusterInfo(k8sClientset, namespace, configMap, clusterInfo)
+}
+
+// RemoveFromGlobalnetConfigMap removes the given cluster's global CIDRs from the globalnet ConfigMap.
+func RemoveFromGlobalnetConfigMap(k8sClientset kubernetes.Interface, namespace string, configMap *v1.ConfigMap, clusterID string) error {
+	var clusterInfo []ClusterInfo
+
+	err := json.Unmarshal([]byte(configMap.Data[ClusterInfoKey]), &clusterInfo)
+	if err != nil {
+		return errors.Wrapf(err, "error unmarshalling ClusterInfo")
+	}
+
+	remaining := make([]ClusterInfo, 0, len(clusterInfo))
+
+	for _, value := range clusterInfo {
+		if value.ClusterID != clusterID {
+			remaining = append(remaining, value)
+		}
+	}
+
+	if len(remaining) == len(clusterInfo) {
+		return nil
+	}
+
+	return updateGlobalnetClusterInfo(k8sClientset, namespace, configMap, remaining)
+}
+
+// updateGlobalnetClusterInfo stores the given cluster information in the globalnet ConfigMap; on success, configMap
+// is refreshed so that it can be updated again.
+func updateGlobalnetClusterInfo(k8sClientset kubernetes.Interface, namespace string, configMap *v1.ConfigMap,
+	clusterInfo []ClusterInfo) error {
 	data, err := json.MarshalIndent(clusterInfo, "", "\t")
 	if err != nil {
 		return errors.Wrapf(err, "error marshalling ClusterInfo")
 	}
 
 	configMap.Data[ClusterInfoKey] = string(data)
-	_, err = k8sClientset.CoreV1().ConfigMaps(namespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
 
-	return errors.Wrapf(err, "error updating ConfigMap")
+	updated, err := k8sClientset.CoreV1().ConfigMaps(namespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error updating ConfigMap")
+	}
+
+	*configMap = *updated
+
+	return nil
 }
 
 // nolint:wrapcheck // No need to wrap here
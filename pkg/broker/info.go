@@ -42,9 +42,11 @@ type Info struct {
 	ServiceDiscovery bool           `omitempty,json:"serviceDiscovery"`
 	Components       []string       `json:",omitempty"`
 	CustomDomains    *[]string      `omitempty,json:"customDomains"`
+	// Bootstrap is set when ClientToken is a join token rather than the broker administrator token.
+	Bootstrap *BootstrapToken `json:"bootstrap,omitempty"`
 }
 
-func (d *Info) writeToFile(filename string) error {
+func (d *Info) WriteToFile(filename string) error {
 	dataStr, err := d.encode()
 	if err != nil {
 		return err
@@ -90,7 +92,8 @@ func (d *Info) getAndCheckBrokerAdministratorConfig(private bool) (*rest.Config,
 	// likely means we couldn’t connect
 	_, err = submClientset.SubmarinerV1().Clusters(string(d.ClientToken.Data["namespace"])).List(
 		context.TODO(), metav1.ListOptions{})
-	if apierrors.IsNotFound(err) {
+	// Join tokens aren't allowed to list Clusters, but being refused access still shows that the connection works
+	if apierrors.IsNotFound(err) || (d.Bootstrap != nil && apierrors.IsForbidden(err)) {
 		err = nil
 	}
 
@@ -26,7 +26,8 @@ import (
 )
 
 const (
-	ipsecPSKSecretName = "submariner-ipsec-psk"
+	IPSecPSKSecretName = "submariner-ipsec-psk"
+	IPSecPSKSecretKey  = "psk"
 	ipsecSecretLength  = 48
 )
 
@@ -45,11 +46,11 @@ func newIPSECPSKSecret() (*v1.Secret, error) {
 	}
 
 	pskSecretData := make(map[string][]byte)
-	pskSecretData["psk"] = psk
+	pskSecretData[IPSecPSKSecretKey] = psk
 
 	pskSecret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: ipsecPSKSecretName,
+			Name: IPSecPSKSecretName,
 		},
 		Data: pskSecretData,
 	}
@@ -0,0 +1,166 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	operatorClientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
+	submarinerClientset "github.com/submariner-io/submariner/pkg/client/clientset/versioned"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// RemoveCluster removes everything the broker holds for the given cluster: its Cluster and Endpoints, its globalnet
+// CIDR, and its service account along with the service account's role binding and tokens. Resources which are already
+// gone are ignored, so this can be retried.
+func RemoveCluster(kubeClient kubernetes.Interface, submClient submarinerClientset.Interface, operatorClient operatorClientset.Interface,
+	clusterID, inNamespace string) error {
+	if err := removeClusterRecords(submClient, clusterID, inNamespace); err != nil {
+		return err
+	}
+
+	if err := releaseGlobalCIDR(kubeClient, operatorClient, clusterID, inNamespace); err != nil {
+		return err
+	}
+
+	return removeClusterSA(kubeClient, clusterID, inNamespace)
+}
+
+func removeClusterRecords(submClient submarinerClientset.Interface, clusterID, inNamespace string) error {
+	endpoints, err := submClient.SubmarinerV1().Endpoints(inNamespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error listing the broker Endpoints")
+	}
+
+	for i := range endpoints.Items {
+		if endpoints.Items[i].Spec.ClusterID != clusterID {
+			continue
+		}
+
+		err = submClient.SubmarinerV1().Endpoints(inNamespace).Delete(context.TODO(), endpoints.Items[i].Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting Endpoint %q", endpoints.Items[i].Name)
+		}
+	}
+
+	clusters, err := submClient.SubmarinerV1().Clusters(inNamespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error listing the broker Clusters")
+	}
+
+	for i := range clusters.Items {
+		if clusters.Items[i].Spec.ClusterID != clusterID {
+			continue
+		}
+
+		err = submClient.SubmarinerV1().Clusters(inNamespace).Delete(context.TODO(), clusters.Items[i].Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting Cluster %q", clusters.Items[i].Name)
+		}
+	}
+
+	return nil
+}
+
+func releaseGlobalCIDR(kubeClient kubernetes.Interface, operatorClient operatorClientset.Interface, clusterID, inNamespace string) error {
+	released, err := deleteGlobalnetAllocation(operatorClient, clusterID, inNamespace)
+	if err != nil || released {
+		return err
+	}
+
+	// CIDRs which the broker controller doesn't manage are only recorded in the ConfigMap
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := GetGlobalnetConfigMap(kubeClient, inNamespace)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return errors.Wrap(err, "error retrieving the globalnet ConfigMap")
+		}
+
+		return RemoveFromGlobalnetConfigMap(kubeClient, inNamespace, configMap, clusterID)
+	})
+
+	return err // nolint:wrapcheck // Errors are already wrapped
+}
+
+// deleteGlobalnetAllocation deletes the cluster's GlobalnetAllocation, and returns true if the broker controller will
+// release the CIDR, i.e. if it processed the allocation. Older brokers don't support GlobalnetAllocations, or don't
+// allow subctl to manage them; if the allocation was never processed, subctl fell back to updating the ConfigMap.
+func deleteGlobalnetAllocation(operatorClient operatorClientset.Interface, clusterID, inNamespace string) (bool, error) {
+	allocations := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(inNamespace)
+
+	allocation, err := allocations.Get(context.TODO(), clusterID, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) || meta.IsNoMatchError(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, errors.Wrapf(err, "error retrieving the GlobalnetAllocation for cluster %q", clusterID)
+	}
+
+	err = allocations.Delete(context.TODO(), clusterID, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, errors.Wrapf(err, "error deleting the GlobalnetAllocation for cluster %q", clusterID)
+	}
+
+	for _, f := range allocation.Finalizers {
+		if f == v1alpha1.GlobalnetAllocationFinalizer {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func removeClusterSA(kubeClient kubernetes.Interface, clusterID, inNamespace string) error {
+	saName := ClusterSAName(clusterID)
+	roleBindingName := NewBrokerRoleBinding(saName, submarinerBrokerClusterRole, inNamespace).Name
+
+	err := kubeClient.RbacV1().RoleBindings(inNamespace).Delete(context.TODO(), roleBindingName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting role binding %q", roleBindingName)
+	}
+
+	tokens, err := listClusterTokens(kubeClient, saName, inNamespace)
+	if err != nil {
+		return err
+	}
+
+	for i := range tokens {
+		err = kubeClient.CoreV1().Secrets(inNamespace).Delete(context.TODO(), tokens[i].Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting token %q", tokens[i].Name)
+		}
+	}
+
+	err = kubeClient.CoreV1().ServiceAccounts(inNamespace).Delete(context.TODO(), saName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting service account %q", saName)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	fakeOperator "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned/fake"
+	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	fakeSubmariner "github.com/submariner-io/submariner/pkg/client/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeKubeClient "k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("RemoveCluster", func() {
+	var (
+		kubeClient     *fakeKubeClient.Clientset
+		submClient     *fakeSubmariner.Clientset
+		operatorClient *fakeOperator.Clientset
+	)
+
+	BeforeEach(func() {
+		saName := broker.ClusterSAName(clusterID)
+		roleBinding := broker.NewBrokerRoleBinding(saName, "submariner-k8s-broker-cluster", brokerNamespace)
+		roleBinding.Namespace = brokerNamespace
+
+		configMap, err := broker.NewGlobalnetConfigMap(true, "242.0.0.0/8", 65536, brokerNamespace)
+		Expect(err).To(Succeed())
+
+		kubeClient = fakeKubeClient.NewSimpleClientset(
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: saName, Namespace: brokerNamespace}},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        oldTokenName,
+					Namespace:   brokerNamespace,
+					Annotations: map[string]string{"kubernetes.io/service-account.name": saName},
+				},
+				Type: corev1.SecretTypeServiceAccountToken,
+			},
+			roleBinding, configMap)
+
+		for _, id := range []string{clusterID, "west"} {
+			Expect(broker.UpdateGlobalnetConfigMap(kubeClient, brokerNamespace, configMap, broker.ClusterInfo{
+				ClusterID:  id,
+				GlobalCidr: []string{"242.0.0.0/16"},
+			})).To(Succeed())
+		}
+
+		submClient = fakeSubmariner.NewSimpleClientset(
+			&submarinerv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterID, Namespace: brokerNamespace},
+				Spec:       submarinerv1.ClusterSpec{ClusterID: clusterID},
+			},
+			&submarinerv1.Endpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterID + "-submariner-cable", Namespace: brokerNamespace},
+				Spec:       submarinerv1.EndpointSpec{ClusterID: clusterID},
+			},
+			&submarinerv1.Endpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: "west-submariner-cable", Namespace: brokerNamespace},
+				Spec:       submarinerv1.EndpointSpec{ClusterID: "west"},
+			})
+
+		operatorClient = fakeOperator.NewSimpleClientset(&v1alpha1.GlobalnetAllocation{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterID, Namespace: brokerNamespace},
+			Spec:       v1alpha1.GlobalnetAllocationSpec{ClusterID: clusterID},
+		})
+	})
+
+	It("should remove the cluster's resources from the broker", func() {
+		Expect(broker.RemoveCluster(kubeClient, submClient, operatorClient, clusterID, brokerNamespace)).To(Succeed())
+
+		_, err := submClient.SubmarinerV1().Clusters(brokerNamespace).Get(context.TODO(), clusterID, metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		endpoints, err := submClient.SubmarinerV1().Endpoints(brokerNamespace).List(context.TODO(), metav1.ListOptions{})
+		Expect(err).To(Succeed())
+		Expect(endpoints.Items).To(HaveLen(1))
+		Expect(endpoints.Items[0].Spec.ClusterID).To(Equal("west"))
+
+		_, err = operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace).Get(context.TODO(), clusterID,
+			metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		configMap, err := broker.GetGlobalnetConfigMap(kubeClient, brokerNamespace)
+		Expect(err).To(Succeed())
+		Expect(configMap.Data[broker.ClusterInfoKey]).ToNot(ContainSubstring(`"` + clusterID + `"`))
+		Expect(configMap.Data[broker.ClusterInfoKey]).To(ContainSubstring(`"west"`))
+
+		_, err = kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), broker.ClusterSAName(clusterID),
+			metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		_, err = kubeClient.CoreV1().Secrets(brokerNamespace).Get(context.TODO(), oldTokenName, metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		roleBindings, err := kubeClient.RbacV1().RoleBindings(brokerNamespace).List(context.TODO(), metav1.ListOptions{})
+		Expect(err).To(Succeed())
+		Expect(roleBindings.Items).To(BeEmpty())
+	})
+
+	When("the broker controller processed the GlobalnetAllocation", func() {
+		BeforeEach(func() {
+			operatorClient = fakeOperator.NewSimpleClientset(&v1alpha1.GlobalnetAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       clusterID,
+					Namespace:  brokerNamespace,
+					Finalizers: []string{v1alpha1.GlobalnetAllocationFinalizer},
+				},
+				Spec: v1alpha1.GlobalnetAllocationSpec{ClusterID: clusterID},
+			})
+		})
+
+		It("should leave the globalnet ConfigMap to the broker controller", func() {
+			Expect(broker.RemoveCluster(kubeClient, submClient, operatorClient, clusterID, brokerNamespace)).To(Succeed())
+
+			_, err := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace).Get(context.TODO(), clusterID,
+				metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			configMap, err := broker.GetGlobalnetConfigMap(kubeClient, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(configMap.Data[broker.ClusterInfoKey]).To(ContainSubstring(`"` + clusterID + `"`))
+		})
+	})
+
+	When("the cluster was already removed", func() {
+		It("should succeed", func() {
+			Expect(broker.RemoveCluster(kubeClient, submClient, operatorClient, clusterID, brokerNamespace)).To(Succeed())
+			Expect(broker.RemoveCluster(kubeClient, submClient, operatorClient, clusterID, brokerNamespace)).To(Succeed())
+		})
+	})
+})
@@ -57,17 +57,22 @@ func NewBrokerAdminRole() *rbacv1.Role {
 			{
 				Verbs:     []string{"create", "get", "list", "watch", "patch", "update", "delete"},
 				APIGroups: []string{"submariner.io"},
-				Resources: []string{"clusters", "endpoints"},
+				Resources: []string{"clusters", "endpoints", "globalnetallocations"},
 			},
 			{
 				Verbs:     []string{"create", "get", "list", "update", "delete"},
 				APIGroups: []string{""},
 				Resources: []string{"serviceaccounts", "secrets", "configmaps"},
 			},
+			{
+				Verbs:     []string{"create"},
+				APIGroups: []string{""},
+				Resources: []string{"serviceaccounts/token"},
+			},
 			{
 				Verbs:     []string{"create", "get", "list", "delete"},
 				APIGroups: []string{"rbac.authorization.k8s.io"},
-				Resources: []string{"rolebindings"},
+				Resources: []string{"rolebindings", "roles"},
 			},
 			{
 				Verbs:     []string{"create", "get", "list", "watch", "patch", "update", "delete"},
@@ -106,10 +111,15 @@ func NewBrokerClusterRole() *rbacv1.Role {
 				Resources: []string{"endpointslices", "endpointslices/restricted"},
 			},
 			{
-				Verbs:     []string{"get", "list"},
+				Verbs:     []string{"get", "list", "watch"},
 				APIGroups: []string{""},
 				Resources: []string{"secrets"},
 			},
+			{
+				Verbs:     []string{"get"},
+				APIGroups: []string{"submariner.io"},
+				Resources: []string{"globalnetallocations"},
+			},
 		},
 	}
 }
@@ -0,0 +1,316 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	goerrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	submarinerClientset "github.com/submariner-io/submariner/pkg/client/clientset/versioned"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// SupersededAnnotation marks cluster tokens which have been replaced by a rotation; they are no longer synced to the
+	// clusters, and are deleted once every cluster's Endpoints have been refreshed with its new token.
+	SupersededAnnotation = "submariner.io/superseded"
+	// CredentialsAnnotation is set by the Submariner operator on its cluster's Endpoints on the broker, using the cluster's
+	// broker token, once every component using the token has restarted with it; its value is given by TokenHash.
+	CredentialsAnnotation = "submariner.io/broker-credentials"
+
+	serviceAccountNameAnnotation = "kubernetes.io/service-account.name"
+	tokenRandomSuffixLength      = 5
+	gatewayPodSelector           = "app=" + names.GatewayComponent
+	// maxTokenPrefixLength mirrors the limit applied to generated names, see k8s.io/apiserver/pkg/storage/names.
+	maxTokenPrefixLength = 63 - tokenRandomSuffixLength
+)
+
+// RotateClusterToken mints a new token for the given cluster's service account. The cluster's previous tokens are
+// marked as superseded but remain valid until RevokeSupersededClusterTokens is called.
+func RotateClusterToken(kubeClient kubernetes.Interface, clusterID, inNamespace string) (*v1.Secret, error) {
+	saName := ClusterSAName(clusterID)
+
+	sa, err := kubeClient.CoreV1().ServiceAccounts(inNamespace).Get(context.TODO(), saName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error retrieving the service account %q", saName)
+	}
+
+	currentTokens, err := listClusterTokens(kubeClient, saName, inNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark the current tokens first, so that the secret syncer never overwrites the new token with an old one
+	for i := range currentTokens {
+		token := &currentTokens[i]
+		if token.Annotations == nil {
+			token.Annotations = map[string]string{}
+		}
+
+		token.Annotations[SupersededAnnotation] = "true"
+
+		if _, err := kubeClient.CoreV1().Secrets(inNamespace).Update(context.TODO(), token, metav1.UpdateOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "error marking token %q as superseded", token.Name)
+		}
+	}
+
+	newToken, err := kubeClient.CoreV1().Secrets(inNamespace).Create(context.TODO(), &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        newTokenName(saName),
+			Annotations: map[string]string{serviceAccountNameAnnotation: saName},
+		},
+		Type: v1.SecretTypeServiceAccountToken,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating a new token for service account %q", saName)
+	}
+
+	// The token is looked up through the service account's secrets, see rbac.GetClientTokenSecret
+	sa.Secrets = append([]v1.ObjectReference{{Name: newToken.Name}}, sa.Secrets...)
+
+	if _, err := kubeClient.CoreV1().ServiceAccounts(inNamespace).Update(context.TODO(), sa, metav1.UpdateOptions{}); err != nil {
+		return nil, errors.Wrapf(err, "error adding the new token to service account %q", saName)
+	}
+
+	return waitForTokenData(kubeClient, newToken.Name, inNamespace)
+}
+
+// RevokeSupersededClusterTokens deletes the given cluster's superseded tokens, which invalidates them.
+func RevokeSupersededClusterTokens(kubeClient kubernetes.Interface, clusterID, inNamespace string) error {
+	saName := ClusterSAName(clusterID)
+
+	tokens, err := listClusterTokens(kubeClient, saName, inNamespace)
+	if err != nil {
+		return err
+	}
+
+	revoked := map[string]bool{}
+
+	for i := range tokens {
+		if tokens[i].Annotations[SupersededAnnotation] != "true" {
+			continue
+		}
+
+		err := kubeClient.CoreV1().Secrets(inNamespace).Delete(context.TODO(), tokens[i].Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting token %q", tokens[i].Name)
+		}
+
+		revoked[tokens[i].Name] = true
+	}
+
+	if len(revoked) == 0 {
+		return nil
+	}
+
+	sa, err := kubeClient.CoreV1().ServiceAccounts(inNamespace).Get(context.TODO(), saName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving the service account %q", saName)
+	}
+
+	secrets := []v1.ObjectReference{}
+
+	for _, secret := range sa.Secrets {
+		if !revoked[secret.Name] {
+			secrets = append(secrets, secret)
+		}
+	}
+
+	sa.Secrets = secrets
+
+	_, err = kubeClient.CoreV1().ServiceAccounts(inNamespace).Update(context.TODO(), sa, metav1.UpdateOptions{})
+
+	return errors.Wrapf(err, "error removing the revoked tokens from service account %q", saName)
+}
+
+// RotateIPSecPSK generates a new IPsec PSK and stores it on the broker, from where it is synced to the clusters.
+func RotateIPSecPSK(kubeClient kubernetes.Interface, inNamespace string) (*v1.Secret, error) {
+	pskSecret, err := newIPSECPSKSecret()
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating the IPsec PSK")
+	}
+
+	// The secret is updated in place rather than re-created, so that the syncer never sees it disappear
+	existing, err := kubeClient.CoreV1().Secrets(inNamespace).Get(context.TODO(), pskSecret.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		created, err := kubeClient.CoreV1().Secrets(inNamespace).Create(context.TODO(), pskSecret, metav1.CreateOptions{})
+		return created, errors.Wrap(err, "error creating the IPsec PSK secret")
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error retrieving the IPsec PSK secret")
+	}
+
+	existing.Data = pskSecret.Data
+	updated, err := kubeClient.CoreV1().Secrets(inNamespace).Update(context.TODO(), existing, metav1.UpdateOptions{})
+
+	return updated, errors.Wrap(err, "error updating the IPsec PSK secret")
+}
+
+// GatewayPods returns the UIDs of the gateway pods currently running in the given namespace of a joined cluster; once
+// credentials have been rotated, they can be passed to WaitForGatewaysRestart.
+func GatewayPods(kubeClient kubernetes.Interface, namespace string) (map[types.UID]bool, error) {
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: gatewayPodSelector,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the gateway pods")
+	}
+
+	uids := map[types.UID]bool{}
+	for i := range pods.Items {
+		uids[pods.Items[i].UID] = true
+	}
+
+	return uids, nil
+}
+
+// WaitForGatewaysRestart waits until none of the given gateway pods remain in the given namespace of a joined cluster, and
+// their replacements are running, which indicates that the gateways have restarted with the rotated credentials.
+func WaitForGatewaysRestart(kubeClient kubernetes.Interface, namespace string, previous map[types.UID]bool,
+	timeout time.Duration) error {
+	err := wait.PollImmediate(5*time.Second, timeout, func() (bool, error) {
+		pods, err := kubeClient.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: gatewayPodSelector,
+		})
+		if err != nil {
+			return false, errors.Wrap(err, "error listing the gateway pods")
+		}
+
+		for i := range pods.Items {
+			if previous[pods.Items[i].UID] || pods.Items[i].Status.Phase != v1.PodRunning {
+				return false, nil
+			}
+		}
+
+		return len(pods.Items) > 0, nil
+	})
+
+	if goerrors.Is(err, wait.ErrWaitTimeout) {
+		return errors.New("timed out waiting for the gateway pods to restart")
+	}
+
+	return err // nolint:wrapcheck // No need to wrap here
+}
+
+// TokenHash returns the value of CredentialsAnnotation corresponding to the given broker token.
+func TokenHash(token []byte) string {
+	hash := sha256.Sum256(token)
+	return hex.EncodeToString(hash[:])
+}
+
+// WaitForEndpointsRefresh waits until the given cluster's Endpoints on the broker have been refreshed with the given
+// token, i.e. until they all carry its hash in CredentialsAnnotation. This shows that the token works, and that the
+// cluster's components have restarted with it, so that the cluster's previous tokens can be revoked.
+func WaitForEndpointsRefresh(submClient submarinerClientset.Interface, clusterID, inNamespace string, token []byte,
+	timeout time.Duration) error {
+	tokenHash := TokenHash(token)
+
+	err := wait.PollImmediate(5*time.Second, timeout, func() (bool, error) {
+		endpoints, err := submClient.SubmarinerV1().Endpoints(inNamespace).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return false, errors.Wrap(err, "error listing the broker Endpoints")
+		}
+
+		found := false
+
+		for i := range endpoints.Items {
+			if endpoints.Items[i].Spec.ClusterID != clusterID {
+				continue
+			}
+
+			if endpoints.Items[i].Annotations[CredentialsAnnotation] != tokenHash {
+				return false, nil
+			}
+
+			found = true
+		}
+
+		return found, nil
+	})
+
+	if goerrors.Is(err, wait.ErrWaitTimeout) {
+		return fmt.Errorf("timed out waiting for the Endpoints of cluster %q to be refreshed with the new token", clusterID)
+	}
+
+	return err // nolint:wrapcheck // No need to wrap here
+}
+
+func listClusterTokens(kubeClient kubernetes.Interface, saName, inNamespace string) ([]v1.Secret, error) {
+	secrets, err := kubeClient.CoreV1().Secrets(inNamespace).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: "type=" + string(v1.SecretTypeServiceAccountToken),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the broker tokens")
+	}
+
+	tokens := []v1.Secret{}
+
+	for i := range secrets.Items {
+		if secrets.Items[i].Type == v1.SecretTypeServiceAccountToken &&
+			secrets.Items[i].Annotations[serviceAccountNameAnnotation] == saName {
+			tokens = append(tokens, secrets.Items[i])
+		}
+	}
+
+	return tokens, nil
+}
+
+func newTokenName(saName string) string {
+	tokenPrefix := saName + "-token-"
+	if len(tokenPrefix) > maxTokenPrefixLength {
+		tokenPrefix = tokenPrefix[:maxTokenPrefixLength]
+	}
+
+	return tokenPrefix + utilrand.String(tokenRandomSuffixLength)
+}
+
+func waitForTokenData(kubeClient kubernetes.Interface, name, inNamespace string) (*v1.Secret, error) {
+	var secret *v1.Secret
+
+	// The token controller populates the secret asynchronously
+	err := wait.ExponentialBackoff(wait.Backoff{Steps: 10, Duration: time.Second, Factor: 1.5}, func() (bool, error) {
+		var err error
+
+		secret, err = kubeClient.CoreV1().Secrets(inNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "error retrieving token %q", name)
+		}
+
+		return len(secret.Data[SecretTokenKey]) > 0, nil
+	})
+
+	if goerrors.Is(err, wait.ErrWaitTimeout) {
+		return nil, fmt.Errorf("timed out waiting for token %q to be populated", name)
+	}
+
+	return secret, err // nolint:wrapcheck // No need to wrap here
+}
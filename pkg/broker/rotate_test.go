@@ -0,0 +1,196 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	fakeSubmarinerClient "github.com/submariner-io/submariner/pkg/client/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeKubeClient "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	brokerNamespace = "submariner-k8s-broker"
+	clusterID       = "east"
+	oldTokenName    = "cluster-east-token-abcde"
+)
+
+var _ = Describe("Credential rotation", func() {
+	var kubeClient *fakeKubeClient.Clientset
+
+	BeforeEach(func() {
+		saName := broker.ClusterSAName(clusterID)
+
+		kubeClient = fakeKubeClient.NewSimpleClientset(
+			&corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: saName, Namespace: brokerNamespace},
+				Secrets:    []corev1.ObjectReference{{Name: oldTokenName}},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        oldTokenName,
+					Namespace:   brokerNamespace,
+					Annotations: map[string]string{"kubernetes.io/service-account.name": saName},
+				},
+				Type: corev1.SecretTypeServiceAccountToken,
+				Data: map[string][]byte{broker.SecretTokenKey: []byte("old-token")},
+			})
+
+		// Simulate the token controller
+		kubeClient.PrependReactor("create", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			secret := action.(k8stesting.CreateAction).GetObject().(*corev1.Secret)
+			if secret.Type == corev1.SecretTypeServiceAccountToken {
+				secret.Data = map[string][]byte{broker.SecretTokenKey: []byte("new-token")}
+			}
+
+			return false, nil, nil
+		})
+	})
+
+	When("a cluster token is rotated", func() {
+		It("should mint a new token and supersede the old one", func() {
+			newToken, err := broker.RotateClusterToken(kubeClient, clusterID, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(newToken.Data).To(HaveKeyWithValue(broker.SecretTokenKey, []byte("new-token")))
+
+			sa, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), broker.ClusterSAName(clusterID),
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(sa.Secrets).To(Equal([]corev1.ObjectReference{{Name: newToken.Name}, {Name: oldTokenName}}))
+
+			oldToken, err := kubeClient.CoreV1().Secrets(brokerNamespace).Get(context.TODO(), oldTokenName, metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(oldToken.Annotations).To(HaveKeyWithValue(broker.SupersededAnnotation, "true"))
+		})
+
+		It("should only delete the old token once revoked", func() {
+			newToken, err := broker.RotateClusterToken(kubeClient, clusterID, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			Expect(broker.RevokeSupersededClusterTokens(kubeClient, clusterID, brokerNamespace)).To(Succeed())
+
+			_, err = kubeClient.CoreV1().Secrets(brokerNamespace).Get(context.TODO(), oldTokenName, metav1.GetOptions{})
+			Expect(err).To(HaveOccurred())
+
+			_, err = kubeClient.CoreV1().Secrets(brokerNamespace).Get(context.TODO(), newToken.Name, metav1.GetOptions{})
+			Expect(err).To(Succeed())
+
+			sa, err := kubeClient.CoreV1().ServiceAccounts(brokerNamespace).Get(context.TODO(), broker.ClusterSAName(clusterID),
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			Expect(sa.Secrets).To(Equal([]corev1.ObjectReference{{Name: newToken.Name}}))
+		})
+	})
+
+	When("the IPsec PSK is rotated", func() {
+		It("should replace the PSK stored on the broker", func() {
+			first, err := broker.RotateIPSecPSK(kubeClient, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(first.Data[broker.IPSecPSKSecretKey]).ToNot(BeEmpty())
+
+			second, err := broker.RotateIPSecPSK(kubeClient, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(second.Name).To(Equal(broker.IPSecPSKSecretName))
+			Expect(second.Data[broker.IPSecPSKSecretKey]).ToNot(Equal(first.Data[broker.IPSecPSKSecretKey]))
+		})
+	})
+
+	When("waiting for a cluster's gateways to restart", func() {
+		const submarinerNamespace = "submariner-operator"
+
+		newGatewayPod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: submarinerNamespace,
+					UID:       types.UID(name),
+					Labels:    map[string]string{"app": "submariner-gateway"},
+				},
+				Status: corev1.PodStatus{Phase: phase},
+			}
+		}
+
+		var previous map[types.UID]bool
+
+		BeforeEach(func() {
+			var err error
+
+			previous, err = broker.GatewayPods(fakeKubeClient.NewSimpleClientset(newGatewayPod("old", corev1.PodRunning)),
+				submarinerNamespace)
+			Expect(err).To(Succeed())
+			Expect(previous).To(HaveKey(types.UID("old")))
+		})
+
+		It("should succeed once the previous pods have been replaced by running pods", func() {
+			client := fakeKubeClient.NewSimpleClientset(newGatewayPod("new", corev1.PodRunning))
+			Expect(broker.WaitForGatewaysRestart(client, submarinerNamespace, previous, time.Second)).To(Succeed())
+		})
+
+		It("should time out if a previous pod remains", func() {
+			client := fakeKubeClient.NewSimpleClientset(newGatewayPod("old", corev1.PodRunning), newGatewayPod("new", corev1.PodRunning))
+			Expect(broker.WaitForGatewaysRestart(client, submarinerNamespace, previous, time.Second)).ToNot(Succeed())
+		})
+
+		It("should time out if a replacement pod isn't running", func() {
+			client := fakeKubeClient.NewSimpleClientset(newGatewayPod("new", corev1.PodPending))
+			Expect(broker.WaitForGatewaysRestart(client, submarinerNamespace, previous, time.Second)).ToNot(Succeed())
+		})
+	})
+
+	When("waiting for a cluster's Endpoints to be refreshed", func() {
+		newEndpoint := func(name, clusterID string, token []byte) *submarinerv1.Endpoint {
+			endpoint := &submarinerv1.Endpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: brokerNamespace},
+				Spec:       submarinerv1.EndpointSpec{ClusterID: clusterID},
+			}
+
+			if token != nil {
+				endpoint.Annotations = map[string]string{broker.CredentialsAnnotation: broker.TokenHash(token)}
+			}
+
+			return endpoint
+		}
+
+		It("should succeed once all the cluster's Endpoints carry the new token's hash", func() {
+			client := fakeSubmarinerClient.NewSimpleClientset(newEndpoint("east", clusterID, []byte("new-token")),
+				newEndpoint("west", "west", nil))
+			Expect(broker.WaitForEndpointsRefresh(client, clusterID, brokerNamespace, []byte("new-token"), time.Second)).To(Succeed())
+		})
+
+		It("should time out if an Endpoint carries a previous token's hash", func() {
+			client := fakeSubmarinerClient.NewSimpleClientset(newEndpoint("east", clusterID, []byte("old-token")))
+			Expect(broker.WaitForEndpointsRefresh(client, clusterID, brokerNamespace, []byte("new-token"), time.Second)).ToNot(Succeed())
+		})
+
+		It("should time out if the cluster has no Endpoints", func() {
+			client := fakeSubmarinerClient.NewSimpleClientset(newEndpoint("west", "west", []byte("new-token")))
+			Expect(broker.WaitForEndpointsRefresh(client, clusterID, brokerNamespace, []byte("new-token"), time.Second)).ToNot(Succeed())
+		})
+	})
+})
@@ -32,6 +32,10 @@ func (c *FakeSubmarinerV1alpha1) Brokers(namespace string) v1alpha1.BrokerInterf
 	return &FakeBrokers{c, namespace}
 }
 
+func (c *FakeSubmarinerV1alpha1) GlobalnetAllocations(namespace string) v1alpha1.GlobalnetAllocationInterface {
+	return &FakeGlobalnetAllocations{c, namespace}
+}
+
 func (c *FakeSubmarinerV1alpha1) ServiceDiscoveries(namespace string) v1alpha1.ServiceDiscoveryInterface {
 	return &FakeServiceDiscoveries{c, namespace}
 }
@@ -20,6 +20,8 @@ package v1alpha1
 
 type BrokerExpansion interface{}
 
+type GlobalnetAllocationExpansion interface{}
+
 type ServiceDiscoveryExpansion interface{}
 
 type SubmarinerExpansion interface{}
@@ -27,6 +27,7 @@ import (
 type SubmarinerV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	BrokersGetter
+	GlobalnetAllocationsGetter
 	ServiceDiscoveriesGetter
 	SubmarinersGetter
 }
@@ -40,6 +41,10 @@ func (c *SubmarinerV1alpha1Client) Brokers(namespace string) BrokerInterface {
 	return newBrokers(c, namespace)
 }
 
+func (c *SubmarinerV1alpha1Client) GlobalnetAllocations(namespace string) GlobalnetAllocationInterface {
+	return newGlobalnetAllocations(c, namespace)
+}
+
 func (c *SubmarinerV1alpha1Client) ServiceDiscoveries(namespace string) ServiceDiscoveryInterface {
 	return newServiceDiscoveries(c, namespace)
 }
@@ -0,0 +1,461 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	dryRunNameSuffix = "dryrun"
+	dryRunToken      = "dry-run-placeholder"
+)
+
+// recorder intercepts the requests sent by the clients of a RecordingProducer. Objects which would be created or
+// updated are recorded, and kept in an overlay which takes precedence over the API server when they are read back.
+type recorder struct {
+	mode    DryRunMode
+	mutex   sync.Mutex
+	order   []string
+	records map[string]*unstructured.Unstructured
+	// The overlay maps object paths to the objects which would exist after the recorded changes; deleted objects map
+	// to nil.
+	overlay map[string]*unstructured.Unstructured
+}
+
+type recordingTransport struct {
+	*recorder
+	next http.RoundTripper
+}
+
+// resourcePath is a parsed API request path.
+type resourcePath struct {
+	group     string
+	version   string
+	namespace string
+	resource  string
+	name      string
+	sub       string
+}
+
+func newRecorder(mode DryRunMode) *recorder {
+	return &recorder{
+		mode:    mode,
+		records: map[string]*unstructured.Unstructured{},
+		overlay: map[string]*unstructured.Unstructured{},
+	}
+}
+
+func (r *recorder) wrap(next http.RoundTripper) http.RoundTripper {
+	return &recordingTransport{recorder: r, next: next}
+}
+
+func (r *recorder) recordedObjects() []*unstructured.Unstructured {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	objects := make([]*unstructured.Unstructured, 0, len(r.order))
+	for _, path := range r.order {
+		objects = append(objects, r.records[path].DeepCopy())
+	}
+
+	return objects
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path, isResource := parseResourcePath(req.URL.Path)
+	if !isResource {
+		if t.mode == RenderOnly {
+			return statusResponse(req, apierrors.NewNotFound(schema.GroupResource{}, req.URL.Path))
+		}
+
+		return t.next.RoundTrip(req)
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	switch req.Method {
+	case http.MethodGet:
+		return t.get(req, path)
+	case http.MethodPost:
+		return t.create(req, path)
+	case http.MethodPut:
+		return t.update(req, path)
+	case http.MethodDelete:
+		return t.delete(req, path)
+	case http.MethodPatch:
+		return t.patch(req, path)
+	}
+
+	return statusResponse(req, apierrors.NewMethodNotSupported(path.groupResource(), req.Method))
+}
+
+func (t *recordingTransport) get(req *http.Request, path *resourcePath) (*http.Response, error) {
+	if path.name != "" && path.sub == "" {
+		if obj, found := t.overlay[req.URL.Path]; found {
+			if obj == nil {
+				return statusResponse(req, apierrors.NewNotFound(path.groupResource(), path.name))
+			}
+
+			return objectResponse(req, http.StatusOK, obj)
+		}
+	}
+
+	if t.mode != RenderOnly {
+		return t.next.RoundTrip(req)
+	}
+
+	if path.name == "" {
+		return jsonResponse(req, http.StatusOK, map[string]interface{}{
+			"metadata": map[string]interface{}{},
+			"items":    []interface{}{},
+		})
+	}
+
+	return statusResponse(req, apierrors.NewNotFound(path.groupResource(), path.name))
+}
+
+func (t *recordingTransport) create(req *http.Request, path *resourcePath) (*http.Response, error) {
+	obj, err := readObject(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(path.namespace)
+	}
+
+	if obj.GetName() == "" && obj.GetGenerateName() != "" {
+		obj.SetName(obj.GetGenerateName() + dryRunNameSuffix)
+	}
+
+	objPath := req.URL.Path + "/" + obj.GetName()
+
+	existing, inOverlay := t.overlay[objPath]
+
+	switch {
+	case inOverlay && existing != nil:
+		return statusResponse(req, apierrors.NewAlreadyExists(path.groupResource(), obj.GetName()))
+	case t.mode == DryRunServer && !inOverlay:
+		return t.sendDryRun(req, objPath, obj)
+	case t.mode == DryRunClient && !inOverlay:
+		resp, err := t.next.RoundTrip(newGetRequest(req, objPath))
+		if err != nil {
+			return nil, err
+		}
+
+		_ = resp.Body.Close()
+
+		if resp.StatusCode == http.StatusOK {
+			return statusResponse(req, apierrors.NewAlreadyExists(path.groupResource(), obj.GetName()))
+		}
+	}
+
+	t.record(objPath, obj)
+
+	return objectResponse(req, http.StatusCreated, t.overlay[objPath])
+}
+
+func (t *recordingTransport) update(req *http.Request, path *resourcePath) (*http.Response, error) {
+	obj, err := readObject(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if path.sub != "" {
+		// Status updates aren't part of the manifests
+		return objectResponse(req, http.StatusOK, obj)
+	}
+
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(path.namespace)
+	}
+
+	if t.mode == DryRunServer {
+		return t.sendDryRun(req, req.URL.Path, obj)
+	}
+
+	t.record(req.URL.Path, obj)
+
+	return objectResponse(req, http.StatusOK, t.overlay[req.URL.Path])
+}
+
+func (t *recordingTransport) delete(req *http.Request, path *resourcePath) (*http.Response, error) {
+	if t.mode == DryRunServer {
+		resp, err := t.next.RoundTrip(withDryRun(req))
+		if err == nil && resp.StatusCode < http.StatusMultipleChoices {
+			t.overlay[req.URL.Path] = nil
+		}
+
+		return resp, err
+	}
+
+	t.overlay[req.URL.Path] = nil
+
+	return jsonResponse(req, http.StatusOK, &metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusSuccess,
+	})
+}
+
+func (t *recordingTransport) patch(req *http.Request, path *resourcePath) (*http.Response, error) {
+	if t.mode != DryRunServer {
+		return statusResponse(req, apierrors.NewMethodNotSupported(path.groupResource(), "patch"))
+	}
+
+	resp, err := t.next.RoundTrip(withDryRun(req))
+	if err != nil || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp, err
+	}
+
+	obj, err := readResponseObject(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	t.record(req.URL.Path, obj)
+
+	return objectResponse(req, resp.StatusCode, t.overlay[req.URL.Path])
+}
+
+// sendDryRun sends the request to the API server in dry-run mode, recording the object it returns.
+func (t *recordingTransport) sendDryRun(req *http.Request, objPath string, obj *unstructured.Unstructured) (*http.Response, error) {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err // nolint:wrapcheck // No need to wrap here
+	}
+
+	dryRunReq := withDryRun(req)
+	dryRunReq.Body = io.NopCloser(bytes.NewReader(body))
+	dryRunReq.ContentLength = int64(len(body))
+
+	resp, err := t.next.RoundTrip(dryRunReq)
+	if err != nil || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp, err
+	}
+
+	returned, err := readResponseObject(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	t.record(objPath, returned)
+
+	return objectResponse(req, resp.StatusCode, t.overlay[objPath])
+}
+
+// record records the object and adds it to the overlay, along with the changes the cluster would make once it exists.
+func (t *recordingTransport) record(objPath string, obj *unstructured.Unstructured) {
+	if _, found := t.records[objPath]; !found {
+		t.order = append(t.order, objPath)
+	}
+
+	t.records[objPath] = manifestFrom(obj)
+
+	existing := t.overlay[objPath]
+	if existing != nil && obj.GetResourceVersion() == "" {
+		obj.SetResourceVersion(existing.GetResourceVersion())
+	}
+
+	t.overlay[objPath] = obj
+
+	t.simulateControllers(objPath, obj)
+}
+
+// simulateControllers emulates the controllers which the callers wait for, since they don't act on objects which
+// are only recorded.
+func (t *recordingTransport) simulateControllers(objPath string, obj *unstructured.Unstructured) {
+	switch obj.GetKind() {
+	case "ServiceAccount":
+		// The token controller creates a token secret for each service account; callers use it as a credential,
+		// so a placeholder is provided instead
+		secrets, _, _ := unstructured.NestedSlice(obj.Object, "secrets")
+		if len(secrets) > 0 {
+			return
+		}
+
+		secretName := obj.GetName() + "-token-" + dryRunNameSuffix
+
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{map[string]interface{}{"name": secretName}}, "secrets")
+
+		secret := &unstructured.Unstructured{}
+		secret.SetAPIVersion("v1")
+		secret.SetKind("Secret")
+		secret.SetNamespace(obj.GetNamespace())
+		secret.SetName(secretName)
+		secret.Object["type"] = string(corev1.SecretTypeServiceAccountToken)
+		secret.Object["data"] = map[string]interface{}{
+			corev1.ServiceAccountTokenKey:     base64.StdEncoding.EncodeToString([]byte(dryRunToken)),
+			corev1.ServiceAccountRootCAKey:    "",
+			corev1.ServiceAccountNamespaceKey: base64.StdEncoding.EncodeToString([]byte(obj.GetNamespace())),
+		}
+
+		t.overlay[objPath[:strings.LastIndex(objPath, "/serviceaccounts/")]+"/secrets/"+secretName] = secret
+	case "Deployment":
+		// Report the deployment as available
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{map[string]interface{}{
+			"type":   string(appsv1.DeploymentAvailable),
+			"status": string(corev1.ConditionTrue),
+		}}, "status", "conditions")
+	}
+}
+
+// manifestFrom returns a copy of the object without the fields set by the API server.
+func manifestFrom(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	manifest := obj.DeepCopy()
+
+	delete(manifest.Object, "status")
+	unstructured.RemoveNestedField(manifest.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(manifest.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(manifest.Object, "metadata", "uid")
+	unstructured.RemoveNestedField(manifest.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(manifest.Object, "metadata", "selfLink")
+	unstructured.RemoveNestedField(manifest.Object, "metadata", "managedFields")
+
+	return manifest
+}
+
+// parseResourcePath parses paths of the form /api/{version}/... or /apis/{group}/{version}/..., followed by
+// [namespaces/{namespace}/]{resource}[/{name}[/{subresource}]].
+func parseResourcePath(urlPath string) (*resourcePath, bool) {
+	segments := strings.Split(strings.Trim(urlPath, "/"), "/")
+	path := &resourcePath{}
+
+	switch {
+	case len(segments) >= 3 && segments[0] == "api":
+		path.version = segments[1]
+		segments = segments[2:]
+	case len(segments) >= 4 && segments[0] == "apis":
+		path.group = segments[1]
+		path.version = segments[2]
+		segments = segments[3:]
+	default:
+		return nil, false
+	}
+
+	if len(segments) >= 3 && segments[0] == "namespaces" {
+		path.namespace = segments[1]
+		segments = segments[2:]
+	}
+
+	path.resource = segments[0]
+
+	if len(segments) > 1 {
+		path.name = segments[1]
+	}
+
+	if len(segments) > 2 {
+		path.sub = strings.Join(segments[2:], "/")
+	}
+
+	return path, true
+}
+
+func (p *resourcePath) groupResource() schema.GroupResource {
+	return schema.GroupResource{Group: p.group, Resource: p.resource}
+}
+
+func withDryRun(req *http.Request) *http.Request {
+	dryRunReq := req.Clone(req.Context())
+	query := dryRunReq.URL.Query()
+	query.Set("dryRun", metav1.DryRunAll)
+	dryRunReq.URL.RawQuery = query.Encode()
+
+	return dryRunReq
+}
+
+func newGetRequest(req *http.Request, objPath string) *http.Request {
+	getReq := req.Clone(req.Context())
+	getReq.Method = http.MethodGet
+	getReq.URL.Path = objPath
+	getReq.URL.RawQuery = ""
+	getReq.Body = nil
+	getReq.ContentLength = 0
+
+	return getReq
+}
+
+func readObject(req *http.Request) (*unstructured.Unstructured, error) {
+	if req.Body == nil {
+		return nil, apierrors.NewBadRequest("missing request body")
+	}
+
+	defer req.Body.Close()
+
+	return decodeObject(req.Body)
+}
+
+func readResponseObject(resp *http.Response) (*unstructured.Unstructured, error) {
+	defer resp.Body.Close()
+
+	return decodeObject(resp.Body)
+}
+
+func decodeObject(reader io.Reader) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+
+	err := json.NewDecoder(reader).Decode(&obj.Object)
+	if err != nil {
+		return nil, apierrors.NewBadRequest("unable to decode the object: " + err.Error())
+	}
+
+	return obj, nil
+}
+
+func objectResponse(req *http.Request, code int, obj *unstructured.Unstructured) (*http.Response, error) {
+	return jsonResponse(req, code, obj.Object)
+}
+
+func statusResponse(req *http.Request, err *apierrors.StatusError) (*http.Response, error) {
+	status := err.ErrStatus
+	status.Kind = "Status"
+	status.APIVersion = "v1"
+
+	return jsonResponse(req, int(status.Code), &status)
+}
+
+func jsonResponse(req *http.Request, code int, body interface{}) (*http.Response, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err // nolint:wrapcheck // No need to wrap here
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(code),
+		StatusCode:    code,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,108 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+)
+
+type DryRunMode string
+
+const (
+	// DryRunClient records the objects which would be created or updated without sending them to the API server.
+	DryRunClient DryRunMode = "client"
+	// DryRunServer sends the changes to the API server in dry-run mode, and records the objects it returns.
+	DryRunServer DryRunMode = "server"
+	// RenderOnly records the objects which would be created in an empty cluster, without contacting any API server.
+	RenderOnly DryRunMode = "render"
+)
+
+const renderOnlyHost = "https://render-only.invalid"
+
+// RecordingProducer is a Producer whose clients record the objects they create or update instead of applying them.
+// Objects recorded during a run are served back by the clients, so that subsequent reads see them.
+type RecordingProducer struct {
+	DefaultProducer
+	recorder *recorder
+}
+
+// ParseDryRunMode parses a dry-run mode as given on the command line.
+func ParseDryRunMode(mode string) (DryRunMode, error) {
+	if m := DryRunMode(mode); m == DryRunClient || m == DryRunServer {
+		return m, nil
+	}
+
+	return "", fmt.Errorf("invalid dry-run mode %q, it must be %q or %q", mode, DryRunClient, DryRunServer)
+}
+
+// NewRecordingProducer returns a RecordingProducer for the given mode. The config is ignored in RenderOnly mode.
+func NewRecordingProducer(config *rest.Config, mode DryRunMode) (*RecordingProducer, error) {
+	if mode == RenderOnly {
+		config = &rest.Config{Host: renderOnlyHost}
+	} else {
+		config = rest.CopyConfig(config)
+	}
+
+	rec := newRecorder(mode)
+
+	config.Wrap(rec.wrap)
+
+	p, err := NewProducerFromRestConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecordingProducer{
+		DefaultProducer: *p.(*DefaultProducer),
+		recorder:        rec,
+	}, nil
+}
+
+// ForConfig returns a RecordingProducer for another cluster, using the same mode but recording separately.
+func (p *RecordingProducer) ForConfig(config *rest.Config) (*RecordingProducer, error) {
+	return NewRecordingProducer(config, p.recorder.mode)
+}
+
+// Mode returns the dry-run mode.
+func (p *RecordingProducer) Mode() DryRunMode {
+	return p.recorder.mode
+}
+
+// Objects returns the objects which would have been created or updated, in the order they were first recorded.
+func (p *RecordingProducer) Objects() []*unstructured.Unstructured {
+	return p.recorder.recordedObjects()
+}
+
+// IsRecording returns true if the given Producer records changes instead of applying them.
+func IsRecording(p Producer) bool {
+	_, ok := p.(*RecordingProducer)
+	return ok
+}
+
+// ModeOf returns the dry-run mode of the given Producer, or an empty mode if it applies changes.
+func ModeOf(p Producer) DryRunMode {
+	if r, ok := p.(*RecordingProducer); ok {
+		return r.Mode()
+	}
+
+	return ""
+}
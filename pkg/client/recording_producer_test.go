@@ -0,0 +1,236 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/internal/rbac"
+	"github.com/submariner-io/submariner-operator/pkg/client"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+const namespace = "test-ns"
+
+var ctx = context.TODO()
+
+var _ = Describe("RecordingProducer", func() {
+	Context("in render-only mode", testRenderOnly)
+	Context("in client dry-run mode", testClientDryRun)
+})
+
+func testRenderOnly() {
+	var producer *client.RecordingProducer
+
+	BeforeEach(func() {
+		var err error
+
+		producer, err = client.NewRecordingProducer(nil, client.RenderOnly)
+		Expect(err).To(Succeed())
+	})
+
+	It("should record created objects and serve them back", func() {
+		_, err := producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config"},
+			Data:       map[string]string{"key": "value"},
+		}, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+
+		cm, err := producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Get(ctx, "config", metav1.GetOptions{})
+		Expect(err).To(Succeed())
+		Expect(cm.Data).To(Equal(map[string]string{"key": "value"}))
+
+		objects := producer.Objects()
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0].GetKind()).To(Equal("ConfigMap"))
+		Expect(objects[0].GetAPIVersion()).To(Equal("v1"))
+		Expect(objects[0].GetNamespace()).To(Equal(namespace))
+		Expect(objects[0].GetName()).To(Equal("config"))
+	})
+
+	It("should record the latest version of updated objects once", func() {
+		submariner := &v1alpha1.Submariner{
+			ObjectMeta: metav1.ObjectMeta{Name: "submariner", Namespace: namespace},
+			Spec:       v1alpha1.SubmarinerSpec{ClusterID: "east"},
+		}
+
+		created, err := producer.ForOperator().SubmarinerV1alpha1().Submariners(namespace).Create(ctx, submariner, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+
+		created.Spec.ClusterID = "west"
+		_, err = producer.ForOperator().SubmarinerV1alpha1().Submariners(namespace).Update(ctx, created, metav1.UpdateOptions{})
+		Expect(err).To(Succeed())
+
+		objects := producer.Objects()
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0].GetKind()).To(Equal("Submariner"))
+		Expect(objects[0].Object["spec"]).To(HaveKeyWithValue("clusterID", "west"))
+	})
+
+	It("should report missing objects as not found and lists as empty", func() {
+		_, err := producer.ForKubernetes().CoreV1().Secrets(namespace).Get(ctx, "missing", metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		list, err := producer.ForKubernetes().CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		Expect(err).To(Succeed())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("should fail to create an object twice", func() {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config"}}
+
+		_, err := producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+
+		_, err = producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+		Expect(apierrors.IsAlreadyExists(err)).To(BeTrue())
+
+		Expect(producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Delete(ctx, "config", metav1.DeleteOptions{})).To(Succeed())
+
+		_, err = producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Get(ctx, "config", metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		_, err = producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+	})
+
+	It("should name objects created with a generated name", func() {
+		secret, err := producer.ForKubernetes().CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "broker-secret-"},
+		}, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+		Expect(secret.Name).To(HavePrefix("broker-secret-"))
+	})
+
+	It("should provide a placeholder token for created service accounts", func() {
+		_, err := producer.ForKubernetes().CoreV1().ServiceAccounts(namespace).Create(ctx, &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-sa"},
+		}, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+
+		secret, err := rbac.GetClientTokenSecret(producer.ForKubernetes(), namespace, "cluster-sa")
+		Expect(err).To(Succeed())
+		Expect(secret.Data).To(HaveKey(corev1.ServiceAccountTokenKey))
+
+		objects := producer.Objects()
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0].Object).ToNot(HaveKey("secrets"))
+	})
+
+	It("should report created deployments as available", func() {
+		_, err := producer.ForKubernetes().AppsV1().Deployments(namespace).Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator"},
+		}, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+
+		deployment, err := producer.ForKubernetes().AppsV1().Deployments(namespace).Get(ctx, "operator", metav1.GetOptions{})
+		Expect(err).To(Succeed())
+		Expect(deployment.Status.Conditions).To(HaveLen(1))
+		Expect(deployment.Status.Conditions[0].Type).To(Equal(appsv1.DeploymentAvailable))
+
+		Expect(producer.Objects()[0].Object).ToNot(HaveKey("status"))
+	})
+
+	It("should not support patching", func() {
+		_, err := producer.ForKubernetes().CoreV1().Nodes().Patch(ctx, "node", "application/merge-patch+json", []byte("{}"),
+			metav1.PatchOptions{})
+		Expect(apierrors.IsMethodNotSupported(err)).To(BeTrue())
+	})
+}
+
+func testClientDryRun() {
+	var (
+		producer *client.RecordingProducer
+		server   *httptest.Server
+		requests []string
+	)
+
+	BeforeEach(func() {
+		requests = nil
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+
+			w.Header().Set("Content-Type", "application/json")
+
+			if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/configmaps/existing") {
+				_ = json.NewEncoder(w).Encode(&corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: namespace, ResourceVersion: "1"},
+				})
+
+				return
+			}
+
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(apierrors.NewNotFound(corev1.Resource("configmaps"), "").ErrStatus)
+		}))
+
+		var err error
+
+		producer, err = client.NewRecordingProducer(&rest.Config{Host: server.URL}, client.DryRunClient)
+		Expect(err).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should read from the API server but not send changes", func() {
+		existing, err := producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Get(ctx, "existing", metav1.GetOptions{})
+		Expect(err).To(Succeed())
+
+		existing.Data = map[string]string{"key": "value"}
+		_, err = producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Update(ctx, existing, metav1.UpdateOptions{})
+		Expect(err).To(Succeed())
+
+		_, err = producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "new"},
+		}, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+
+		for _, request := range requests {
+			Expect(request).To(HavePrefix(http.MethodGet))
+		}
+
+		objects := producer.Objects()
+		Expect(objects).To(HaveLen(2))
+		Expect(objects[0].GetName()).To(Equal("existing"))
+		Expect(objects[0].GetResourceVersion()).To(BeEmpty())
+		Expect(objects[1].GetName()).To(Equal("new"))
+	})
+
+	It("should fail to create an object which exists in the cluster", func() {
+		_, err := producer.ForKubernetes().CoreV1().ConfigMaps(namespace).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "existing"},
+		}, metav1.CreateOptions{})
+		Expect(apierrors.IsAlreadyExists(err)).To(BeTrue())
+	})
+}
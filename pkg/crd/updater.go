@@ -21,19 +21,58 @@ package crd
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"github.com/submariner-io/admiral/pkg/resource"
+	"github.com/submariner-io/admiral/pkg/util"
 	"github.com/submariner-io/submariner-operator/pkg/embeddedyamls"
-	resourceutil "github.com/submariner-io/submariner-operator/pkg/resource"
+	"github.com/submariner-io/submariner-operator/pkg/version"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// VersionAnnotation records the version of subctl or the operator which last wrote a CRD.
+const VersionAnnotation = "submariner.io/version"
+
+// SkewPolicy determines how an Updater handles CRDs written by a newer version than its own.
+type SkewPolicy int
+
+const (
+	// RefuseOlder refuses to replace CRDs written by a newer version, returning a VersionSkewError.
+	RefuseOlder SkewPolicy = iota
+	// SkipOlder leaves CRDs written by a newer version as they are.
+	SkipOlder
+	// ForceOlder replaces CRDs even if they were written by a newer version.
+	ForceOlder
+)
+
+// SkewPolicyFor returns ForceOlder if force is true, RefuseOlder otherwise.
+func SkewPolicyFor(force bool) SkewPolicy {
+	if force {
+		return ForceOlder
+	}
+
+	return RefuseOlder
+}
+
+// VersionSkewError is returned when replacing a CRD would downgrade it.
+type VersionSkewError struct {
+	Name      string
+	Installed string
+	Embedded  string
+}
+
+func (e *VersionSkewError) Error() string {
+	return fmt.Sprintf("the CRD %q was installed by version %s, replacing it with the CRD from version %s would downgrade it",
+		e.Name, e.Installed, e.Embedded)
+}
+
 type baseUpdater interface {
 	Create(context.Context, *apiextensions.CustomResourceDefinition, metav1.CreateOptions) (*apiextensions.CustomResourceDefinition, error)
 	Update(context.Context, *apiextensions.CustomResourceDefinition, metav1.UpdateOptions) (*apiextensions.CustomResourceDefinition, error)
@@ -48,6 +87,8 @@ type Updater interface {
 
 type updater struct {
 	baseUpdater
+	version string
+	policy  SkewPolicy
 }
 
 type controllerClientCreator struct {
@@ -64,15 +105,31 @@ func UpdaterFromRestConfig(config *rest.Config) (Updater, error) {
 }
 
 func UpdaterFromClientSet(cs clientset.Interface) Updater {
-	return &updater{baseUpdater: cs.ApiextensionsV1().CustomResourceDefinitions()}
+	return &updater{baseUpdater: cs.ApiextensionsV1().CustomResourceDefinitions(), version: version.Version}
 }
 
 func UpdaterFromControllerClient(controllerClient client.Client) Updater {
 	return &updater{baseUpdater: &controllerClientCreator{
 		client: controllerClient,
-	}}
+	}, version: version.Version}
+}
+
+// WithSkewPolicy returns a copy of the given Updater which handles CRDs written by newer versions according to the
+// given policy; Updaters refuse to downgrade CRDs by default.
+func WithSkewPolicy(u Updater, policy SkewPolicy) Updater {
+	base, ok := u.(*updater)
+	if !ok {
+		return u
+	}
+
+	withPolicy := *base
+	withPolicy.policy = policy
+
+	return &withPolicy
 }
 
+// CreateOrUpdateFromEmbedded creates or replaces the given embedded CRD, annotated with the Updater's version. CRDs
+// written by a newer version are handled according to the Updater's SkewPolicy.
 func (u *updater) CreateOrUpdateFromEmbedded(ctx context.Context, crdYaml string) (bool, error) {
 	crd := &apiextensions.CustomResourceDefinition{}
 
@@ -80,7 +137,13 @@ func (u *updater) CreateOrUpdateFromEmbedded(ctx context.Context, crdYaml string
 		return false, errors.Wrap(err, "error extracting embedded CRD")
 	}
 
-	return resourceutil.CreateOrUpdate(ctx, &resource.InterfaceFuncs{
+	if crd.Annotations == nil {
+		crd.Annotations = map[string]string{}
+	}
+
+	crd.Annotations[VersionAnnotation] = u.version
+
+	result, err := util.CreateOrUpdate(ctx, &resource.InterfaceFuncs{
 		GetFunc: func(ctx context.Context, name string, options metav1.GetOptions) (runtime.Object, error) {
 			return u.Get(ctx, name, options)
 		},
@@ -90,7 +153,34 @@ func (u *updater) CreateOrUpdateFromEmbedded(ctx context.Context, crdYaml string
 		UpdateFunc: func(ctx context.Context, obj runtime.Object, options metav1.UpdateOptions) (runtime.Object, error) {
 			return u.Update(ctx, obj.(*apiextensions.CustomResourceDefinition), options)
 		},
-	}, crd)
+	}, crd, func(existing runtime.Object) (runtime.Object, error) {
+		installed := existing.(*apiextensions.CustomResourceDefinition).Annotations[VersionAnnotation]
+		if comparison, ok := version.Compare(installed, u.version); !ok || comparison <= 0 {
+			return crd, nil
+		}
+
+		switch u.policy {
+		case SkipOlder:
+			klog.Warningf("Not replacing the CRD %q installed by version %s with the CRD from the older version %s",
+				crd.Name, installed, u.version)
+			return existing, nil
+		case ForceOlder:
+			klog.Warningf("Replacing the CRD %q installed by version %s with the CRD from the older version %s",
+				crd.Name, installed, u.version)
+			return crd, nil
+		case RefuseOlder:
+		}
+
+		return nil, &VersionSkewError{Name: crd.Name, Installed: installed, Embedded: u.version}
+	})
+
+	return result == util.OperationResultCreated, err
+}
+
+// IsVersionSkew returns true if the error indicates that a CRD update was refused because it would downgrade the CRD.
+func IsVersionSkew(err error) bool {
+	var skewErr *VersionSkewError
+	return errors.As(err, &skewErr)
 }
 
 func (c *controllerClientCreator) Create(ctx context.Context, crd *apiextensions.CustomResourceDefinition,
@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/submariner-io/submariner-operator/pkg/crd"
+	"github.com/submariner-io/submariner-operator/pkg/version"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	extendedfakeclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -49,10 +50,15 @@ var _ = Describe("Updater", func() {
 	)
 
 	BeforeEach(func() {
+		version.Version = "v0.12.0"
 		client = extendedfakeclientset.NewSimpleClientset()
 		updater = crd.UpdaterFromClientSet(client)
 	})
 
+	AfterEach(func() {
+		version.Version = "devel"
+	})
+
 	assertCRDExists := func(name string) {
 		crd, err := updater.Get(context.TODO(), name, metav1.GetOptions{})
 		Expect(err).To(Succeed())
@@ -60,13 +66,14 @@ var _ = Describe("Updater", func() {
 	}
 
 	Context("on CreateOrUpdate", func() {
-		crd := &apiextensions.CustomResourceDefinition{
+		submarinerCRD := &apiextensions.CustomResourceDefinition{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       "CustomResourceDefinition",
 				APIVersion: apiextensions.SchemeGroupVersion.String(),
 			},
 			ObjectMeta: metav1.ObjectMeta{
-				Name: "submariners.submariner.io",
+				Name:        "submariners.submariner.io",
+				Annotations: map[string]string{crd.VersionAnnotation: "v0.12.0"},
 			},
 			Spec: apiextensions.CustomResourceDefinitionSpec{
 				Group: "submariner.io",
@@ -81,15 +88,19 @@ var _ = Describe("Updater", func() {
 				created, err := updater.CreateOrUpdateFromEmbedded(context.TODO(), crdYAML)
 				Expect(created).To(BeTrue())
 				Expect(err).To(Succeed())
-				assertCRDExists(crd.Name)
+				assertCRDExists(submarinerCRD.Name)
+
+				existing, err := updater.Get(context.TODO(), submarinerCRD.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(existing.Annotations).To(HaveKeyWithValue(crd.VersionAnnotation, "v0.12.0"))
 			})
 		})
 
 		When("the CRD already exists", func() {
 			It("should not update it", func() {
-				_, err := updater.Create(context.TODO(), crd, metav1.CreateOptions{})
+				_, err := updater.Create(context.TODO(), submarinerCRD, metav1.CreateOptions{})
 				Expect(err).To(Succeed())
-				assertCRDExists(crd.Name)
+				assertCRDExists(submarinerCRD.Name)
 
 				created, err := updater.CreateOrUpdateFromEmbedded(context.TODO(), crdYAML)
 				Expect(created).To(BeFalse())
@@ -101,5 +112,41 @@ var _ = Describe("Updater", func() {
 				}
 			})
 		})
+
+		When("the CRD was installed by a newer version", func() {
+			BeforeEach(func() {
+				newer := submarinerCRD.DeepCopy()
+				newer.Annotations = map[string]string{crd.VersionAnnotation: "v0.13.0"}
+				newer.Spec.Names.Plural = "submariners"
+
+				_, err := updater.Create(context.TODO(), newer, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+			})
+
+			assertInstalledVersion := func(expected string) {
+				existing, err := updater.Get(context.TODO(), submarinerCRD.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(existing.Annotations).To(HaveKeyWithValue(crd.VersionAnnotation, expected))
+			}
+
+			It("should refuse to downgrade it by default", func() {
+				_, err := updater.CreateOrUpdateFromEmbedded(context.TODO(), crdYAML)
+				Expect(err).To(HaveOccurred())
+				Expect(crd.IsVersionSkew(err)).To(BeTrue())
+				assertInstalledVersion("v0.13.0")
+			})
+
+			It("should leave it as is with the SkipOlder policy", func() {
+				_, err := crd.WithSkewPolicy(updater, crd.SkipOlder).CreateOrUpdateFromEmbedded(context.TODO(), crdYAML)
+				Expect(err).To(Succeed())
+				assertInstalledVersion("v0.13.0")
+			})
+
+			It("should downgrade it with the ForceOlder policy", func() {
+				_, err := crd.WithSkewPolicy(updater, crd.ForceOlder).CreateOrUpdateFromEmbedded(context.TODO(), crdYAML)
+				Expect(err).To(Succeed())
+				assertInstalledVersion("v0.12.0")
+			})
+		})
 	})
 })
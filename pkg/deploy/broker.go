@@ -33,11 +33,12 @@ import (
 )
 
 type BrokerOptions struct {
-	OperatorDebug   bool
-	Repository      string
-	ImageVersion    string
-	BrokerNamespace string
-	BrokerSpec      submarinerv1a1.BrokerSpec
+	OperatorDebug   bool                      `json:"operatorDebug,omitempty"`
+	Repository      string                    `json:"repository,omitempty"`
+	ImageVersion    string                    `json:"version,omitempty"`
+	BrokerNamespace string                    `json:"namespace,omitempty"`
+	BrokerSpec      submarinerv1a1.BrokerSpec `json:"spec,omitempty"`
+	ForceCRDUpdate  bool                      `json:"forceCRDUpdate,omitempty"`
 }
 
 var ValidComponents = []string{component.ServiceDiscovery, component.Connectivity}
@@ -80,15 +81,18 @@ func deploy(options *BrokerOptions, status reporter.Interface, clientProducer cl
 	status.Start("Setting up broker RBAC")
 	defer status.End()
 
-	err := broker.Ensure(crd.UpdaterFromClientSet(clientProducer.ForCRD()), clientProducer.ForKubernetes(),
+	crdUpdater := crd.WithSkewPolicy(crd.UpdaterFromClientSet(clientProducer.ForCRD()), crd.SkewPolicyFor(options.ForceCRDUpdate))
+
+	err := broker.Ensure(crdUpdater, clientProducer.ForKubernetes(),
 		options.BrokerSpec.Components, false, options.BrokerNamespace)
 	if err != nil {
-		return status.Error(err, "error setting up broker RBAC")
+		return status.Error(withSkewHint(err), "error setting up broker RBAC")
 	}
 
 	status.Start("Deploying the Submariner operator")
 
-	err = Operator(status, options.ImageVersion, options.Repository, nil, options.OperatorDebug, clientProducer)
+	err = Operator(status, options.ImageVersion, options.Repository, nil, options.OperatorDebug,
+		crd.SkewPolicyFor(options.ForceCRDUpdate), clientProducer)
 	if err != nil {
 		return status.Error(err, "error deploying Submariner operator")
 	}
@@ -19,25 +19,37 @@ limitations under the License.
 package deploy
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	"github.com/submariner-io/submariner-operator/internal/constants"
 	"github.com/submariner-io/submariner-operator/internal/image"
 	"github.com/submariner-io/submariner-operator/pkg/client"
+	"github.com/submariner-io/submariner-operator/pkg/crd"
 	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/operator/submarinerop"
 )
 
 func Operator(status reporter.Interface, version, repository string, imageOverrideArr []string, debug bool,
-	clientProducer client.Producer) error {
+	crdPolicy crd.SkewPolicy, clientProducer client.Producer) error {
 	operatorImage, err := image.ForOperator(version, repository, imageOverrideArr)
 	if err != nil {
 		return errors.Wrap(err, "error overriding Operator Image")
 	}
 
-	err = submarinerop.Ensure(status, clientProducer, constants.OperatorNamespace, operatorImage, debug)
+	err = submarinerop.Ensure(status, clientProducer, constants.OperatorNamespace, operatorImage, debug, crdPolicy)
 	if err != nil {
-		return errors.Wrap(err, "error deploying Submariner operator")
+		return errors.Wrap(withSkewHint(err), "error deploying Submariner operator")
 	}
 
 	return nil
 }
+
+// withSkewHint explains how to proceed when CRDs weren't updated because they were installed by a newer version.
+func withSkewHint(err error) error {
+	if crd.IsVersionSkew(err) {
+		return fmt.Errorf("%w; use a subctl matching the deployed version, or --force to replace the CRDs anyway", err)
+	}
+
+	return err
+}
@@ -19,7 +19,6 @@ limitations under the License.
 package deploy
 
 import (
-	"encoding/base64"
 	"strings"
 
 	submariner "github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
@@ -76,8 +75,6 @@ func populateSubmarinerSpec(options *SubmarinerOptions, brokerInfo *broker.Info,
 	netconfig globalnet.Config, imageOverrides map[string]string) *submariner.SubmarinerSpec {
 	brokerURL := removeSchemaPrefix(brokerInfo.BrokerURL)
 
-	// For backwards compatibility, the connection information is populated through the secret and individual components
-	// TODO skitt This will be removed in the release following 0.12
 	submarinerSpec := &submariner.SubmarinerSpec{
 		Repository:               getImageRepo(options.Repository),
 		Version:                  getImageVersion(options.ImageVersion),
@@ -86,11 +83,8 @@ func populateSubmarinerSpec(options *SubmarinerOptions, brokerInfo *broker.Info,
 		CeIPSecDebug:             options.IPSecDebug,
 		CeIPSecForceUDPEncaps:    options.ForceUDPEncaps,
 		CeIPSecPreferredServer:   options.PreferredServer,
-		CeIPSecPSK:               base64.StdEncoding.EncodeToString(brokerInfo.IPSecPSK.Data["psk"]),
 		CeIPSecPSKSecret:         pskSecret.ObjectMeta.Name,
-		BrokerK8sCA:              base64.StdEncoding.EncodeToString(brokerSecret.Data["ca.crt"]),
 		BrokerK8sRemoteNamespace: string(brokerSecret.Data["namespace"]),
-		BrokerK8sApiServerToken:  string(brokerSecret.Data["token"]),
 		BrokerK8sApiServer:       brokerURL,
 		BrokerK8sSecret:          brokerSecret.ObjectMeta.Name,
 		Broker:                   "k8s",
@@ -0,0 +1,145 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalnet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	operatorclientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+var (
+	// AllocationTimeout is how long RequestGlobalCIDRAllocation waits for the Broker to process the allocation.
+	AllocationTimeout      = 2 * time.Minute
+	allocationPollInterval = time.Second
+)
+
+// RequestGlobalCIDRAllocation requests a global CIDR for the cluster from the Broker, by creating or updating the
+// cluster's GlobalnetAllocation, and waits for the Broker to process it; the resulting CIDR is stored in netconfig.
+// The Submariner controller keeps following the allocation once the cluster has joined. Only Brokers which don't serve
+// GlobalnetAllocations at all have the globalnet ConfigMap updated directly, as older versions of subctl did.
+func RequestGlobalCIDRAllocation(operatorClient operatorclientset.Interface, brokerAdminClientset kubernetes.Interface,
+	brokerNamespace string, netconfig *Config, status reporter.Interface) error {
+	status.Start("Requesting a global CIDR allocation from the Broker")
+	defer status.End()
+
+	allocations := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace)
+
+	spec := v1alpha1.GlobalnetAllocationSpec{
+		ClusterID:   netconfig.ClusterID,
+		GlobalCIDR:  netconfig.GlobalCIDR,
+		ClusterSize: netconfig.ClusterSize,
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := allocations.Get(context.TODO(), netconfig.ClusterID, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = allocations.Create(context.TODO(), &v1alpha1.GlobalnetAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      netconfig.ClusterID,
+					Namespace: brokerNamespace,
+				},
+				Spec: spec,
+			}, metav1.CreateOptions{})
+
+			return err // nolint:wrapcheck // No need to wrap here
+		}
+
+		// Join tokens come with a pending allocation, which the request completes
+		_, pending := existing.Annotations[v1alpha1.GlobalnetAllocationPendingAnnotation]
+
+		if err != nil || (existing.Spec == spec && !pending) {
+			return err // nolint:wrapcheck // No need to wrap here
+		}
+
+		existing.Spec = spec
+		delete(existing.Annotations, v1alpha1.GlobalnetAllocationPendingAnnotation)
+		_, err = allocations.Update(context.TODO(), existing, metav1.UpdateOptions{})
+
+		return err // nolint:wrapcheck // No need to wrap here
+	})
+
+	// Older Brokers don't serve the CRD; once they do, the allocation is only ever made by the Broker controller
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		status.Warning("The Broker doesn't support GlobalnetAllocations - updating the Globalnet ConfigMap directly")
+		status.End()
+
+		return allocateAndUpdateGlobalCIDRConfigMap(brokerAdminClientset, brokerNamespace, netconfig, status)
+	}
+
+	if err != nil {
+		return status.Error(err, "error requesting the global CIDR allocation")
+	}
+
+	return awaitGlobalCIDRAllocation(operatorClient, brokerNamespace, netconfig, status)
+}
+
+func awaitGlobalCIDRAllocation(operatorClient operatorclientset.Interface, brokerNamespace string, netconfig *Config,
+	status reporter.Interface) error {
+	var allocation *v1alpha1.GlobalnetAllocation
+
+	err := wait.PollImmediate(allocationPollInterval, AllocationTimeout, func() (bool, error) {
+		var err error
+
+		allocation, err = operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace).Get(context.TODO(),
+			netconfig.ClusterID, metav1.GetOptions{})
+		if err != nil {
+			return false, err // nolint:wrapcheck // No need to wrap here
+		}
+
+		condition := meta.FindStatusCondition(allocation.Status.Conditions, v1alpha1.GlobalnetAllocated)
+
+		return condition != nil && condition.ObservedGeneration >= allocation.Generation, nil
+	})
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return status.Error(fmt.Errorf("the Broker didn't process the GlobalnetAllocation within %s, check that the Submariner"+
+			" operator is running on the Broker cluster", AllocationTimeout), "error waiting for the Broker to allocate the global CIDR")
+	}
+
+	if err != nil {
+		return status.Error(err, "error waiting for the Broker to allocate the global CIDR")
+	}
+
+	condition := meta.FindStatusCondition(allocation.Status.Conditions, v1alpha1.GlobalnetAllocated)
+
+	switch {
+	case condition.Status == metav1.ConditionTrue:
+		netconfig.GlobalCIDR = allocation.Status.GlobalCIDR
+		status.Success("Allocated global CIDR %s", netconfig.GlobalCIDR)
+	case condition.Reason == v1alpha1.GlobalnetDisabledReason:
+		status.Warning("Globalnet is not enabled on the Broker - ignoring the Globalnet configuration")
+
+		netconfig.GlobalCIDR = ""
+	default:
+		return status.Error(errors.New(condition.Message), "the Broker was unable to allocate the global CIDR")
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalnet_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	fakeOperatorClient "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned/fake"
+	"github.com/submariner-io/submariner-operator/pkg/discovery/globalnet"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakeKubeClient "k8s.io/client-go/kubernetes/fake"
+	testing "k8s.io/client-go/testing"
+)
+
+const brokerNamespace = "submariner-k8s-broker"
+
+var _ = Describe("RequestGlobalCIDRAllocation", func() {
+	var (
+		operatorClient *fakeOperatorClient.Clientset
+		kubeClient     *fakeKubeClient.Clientset
+		netconfig      *globalnet.Config
+		allocated      metav1.Condition
+		brokerActive   bool
+		err            error
+	)
+
+	BeforeEach(func() {
+		operatorClient = fakeOperatorClient.NewSimpleClientset()
+		kubeClient = fakeKubeClient.NewSimpleClientset()
+		netconfig = &globalnet.Config{ClusterID: "east", ClusterSize: 1024}
+		brokerActive = true
+		allocated = metav1.Condition{
+			Type:   v1alpha1.GlobalnetAllocated,
+			Status: metav1.ConditionTrue,
+			Reason: "CIDRAllocated",
+		}
+
+		// Simulate the Broker controller
+		operatorClient.PrependReactor("create", "globalnetallocations",
+			func(action testing.Action) (bool, runtime.Object, error) {
+				allocation := action.(testing.CreateAction).GetObject().(*v1alpha1.GlobalnetAllocation)
+				Expect(allocation.Spec.ClusterID).To(Equal(netconfig.ClusterID))
+				Expect(allocation.Spec.ClusterSize).To(Equal(netconfig.ClusterSize))
+
+				if !brokerActive {
+					return false, nil, nil
+				}
+
+				meta.SetStatusCondition(&allocation.Status.Conditions, allocated)
+
+				if allocated.Status == metav1.ConditionTrue {
+					allocation.Status.GlobalCIDR = "242.0.0.0/22"
+				}
+
+				return false, nil, nil
+			})
+	})
+
+	JustBeforeEach(func() {
+		err = globalnet.RequestGlobalCIDRAllocation(operatorClient, kubeClient, brokerNamespace, netconfig, reporter.Silent())
+	})
+
+	When("the Broker allocates the CIDR", func() {
+		It("should return the allocated CIDR", func() {
+			Expect(err).To(Succeed())
+			Expect(netconfig.GlobalCIDR).To(Equal("242.0.0.0/22"))
+
+			_, err := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace).Get(context.TODO(), "east",
+				metav1.GetOptions{})
+			Expect(err).To(Succeed())
+		})
+	})
+
+	When("globalnet isn't enabled on the Broker", func() {
+		BeforeEach(func() {
+			netconfig.GlobalCIDR = "242.0.0.0/16"
+			netconfig.ClusterSize = 0
+			allocated.Status = metav1.ConditionFalse
+			allocated.Reason = v1alpha1.GlobalnetDisabledReason
+		})
+
+		It("should ignore the global CIDR", func() {
+			Expect(err).To(Succeed())
+			Expect(netconfig.GlobalCIDR).To(BeEmpty())
+		})
+	})
+
+	When("the Broker fails to allocate the CIDR", func() {
+		BeforeEach(func() {
+			allocated.Status = metav1.ConditionFalse
+			allocated.Reason = "AllocationFailed"
+			allocated.Message = "allocation not available"
+		})
+
+		It("should return an error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("allocation not available"))
+		})
+	})
+
+	When("the Broker doesn't support GlobalnetAllocations", func() {
+		BeforeEach(func() {
+			operatorClient.PrependReactor("create", "globalnetallocations",
+				func(action testing.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrors.NewNotFound(schema.GroupResource{}, "")
+				})
+
+			configMap, err := broker.NewGlobalnetConfigMap(true, "242.0.0.0/8", 65536, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			_, err = kubeClient.CoreV1().ConfigMaps(brokerNamespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+		})
+
+		It("should update the globalnet ConfigMap directly", func() {
+			Expect(err).To(Succeed())
+			Expect(netconfig.GlobalCIDR).To(Equal("242.0.0.0/22"))
+
+			globalnetInfo, _, err := globalnet.GetGlobalNetworks(kubeClient, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(globalnetInfo.CidrInfo).To(HaveKey("east"))
+		})
+	})
+
+	When("subctl isn't allowed to request GlobalnetAllocations", func() {
+		BeforeEach(func() {
+			operatorClient.PrependReactor("create", "globalnetallocations",
+				func(action testing.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrors.NewForbidden(schema.GroupResource{}, "", nil)
+				})
+
+			configMap, err := broker.NewGlobalnetConfigMap(true, "242.0.0.0/8", 65536, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			_, err = kubeClient.CoreV1().ConfigMaps(brokerNamespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+		})
+
+		It("should return an error without updating the globalnet ConfigMap", func() {
+			Expect(err).To(HaveOccurred())
+
+			globalnetInfo, _, err := globalnet.GetGlobalNetworks(kubeClient, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(globalnetInfo.CidrInfo).ToNot(HaveKey("east"))
+		})
+	})
+
+	When("the Broker doesn't process the GlobalnetAllocation", func() {
+		var oldTimeout time.Duration
+
+		BeforeEach(func() {
+			brokerActive = false
+			oldTimeout = globalnet.AllocationTimeout
+			globalnet.AllocationTimeout = 100 * time.Millisecond
+
+			configMap, err := broker.NewGlobalnetConfigMap(true, "242.0.0.0/8", 65536, brokerNamespace)
+			Expect(err).To(Succeed())
+
+			_, err = kubeClient.CoreV1().ConfigMaps(brokerNamespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+		})
+
+		AfterEach(func() {
+			globalnet.AllocationTimeout = oldTimeout
+		})
+
+		It("should return an error without updating the globalnet ConfigMap", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(netconfig.GlobalCIDR).To(BeEmpty())
+
+			globalnetInfo, _, err := globalnet.GetGlobalNetworks(kubeClient, brokerNamespace)
+			Expect(err).To(Succeed())
+			Expect(globalnetInfo.CidrInfo).ToNot(HaveKey("east"))
+		})
+	})
+})
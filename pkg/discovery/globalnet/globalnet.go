@@ -19,9 +19,9 @@ limitations under the License.
 package globalnet
 
 import (
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"math/bits"
 	"net"
 
@@ -55,8 +55,8 @@ type GlobalCIDR struct {
 
 type CIDR struct {
 	network *net.IPNet
+	lastIP  *big.Int
 	size    int
-	lastIP  uint
 }
 
 type Config struct {
@@ -65,9 +65,7 @@ type Config struct {
 	ClusterSize uint
 }
 
-var globalCidr = GlobalCIDR{allocatedCount: 0}
-
-func isOverlappingCIDR(cidrList []string, cidr string) (bool, error) {
+func IsOverlappingCIDR(cidrList []string, cidr string) (bool, error) {
 	_, newNet, err := net.ParseCIDR(cidr)
 	if err != nil {
 		return false, err // nolint:wrapcheck // No need to wrap here
@@ -96,34 +94,36 @@ func NewCIDR(cidr string) (CIDR, error) {
 	ones, total := network.Mask.Size()
 	size := total - ones
 	lastIP := LastIP(network)
-	clusterCidr := CIDR{network: network, size: size, lastIP: lastIP}
+	clusterCidr := CIDR{network: network, lastIP: lastIP, size: size}
 
 	return clusterCidr, nil
 }
 
-func LastIP(network *net.IPNet) uint {
+// LastIP returns the last address in the given network, as an integer; this works for IPv4 and IPv6 networks.
+func LastIP(network *net.IPNet) *big.Int {
 	ones, total := network.Mask.Size()
 	clusterSize := uint(total - ones)
-	firstIPInt := ipToUint(network.IP)
-	lastIPUint := (firstIPInt + 1<<clusterSize) - 1
+	lastIP := new(big.Int).Lsh(big.NewInt(1), clusterSize)
 
-	return lastIPUint
+	return lastIP.Add(lastIP, ipToInt(network.IP)).Sub(lastIP, big.NewInt(1))
 }
 
-func allocateByCidr(cidr string) (uint, error) {
+// allocateByCidr allocates the given CIDR. If it overlaps an existing allocation, the last IP of the blocking range is returned
+// with the error, so the caller can try the following range; a nil IP means that no further allocation is possible.
+func (globalCidr *GlobalCIDR) allocateByCidr(cidr string) (*big.Int, error) {
 	requestedIP, requestedNetwork, err := net.ParseCIDR(cidr)
 	if err != nil || !globalCidr.net.Contains(requestedIP) {
-		return 0, fmt.Errorf("%s not a valid subnet of %v", cidr, globalCidr.net)
+		return nil, fmt.Errorf("%s not a valid subnet of %v", cidr, globalCidr.net)
 	}
 
 	var clusterCidr CIDR
 
 	if clusterCidr, err = NewCIDR(cidr); err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	if !globalCidr.net.Contains(uintToIP(clusterCidr.lastIP)) {
-		return 0, fmt.Errorf("%s not a valid subnet of %v", cidr, globalCidr.net)
+	if !globalCidr.net.Contains(intToIP(clusterCidr.lastIP, isIPv4(globalCidr.net.IP))) {
+		return nil, fmt.Errorf("%s not a valid subnet of %v", cidr, globalCidr.net)
 	}
 
 	for i := 0; i < globalCidr.allocatedCount; i++ {
@@ -142,10 +142,10 @@ func allocateByCidr(cidr string) (uint, error) {
 	globalCidr.allocatedClusters = append(globalCidr.allocatedClusters, &clusterCidr)
 	globalCidr.allocatedCount++
 
-	return 0, nil
+	return nil, nil
 }
 
-func allocateByClusterSize(numSize uint) (string, error) {
+func (globalCidr *GlobalCIDR) allocateByClusterSize(numSize uint) (string, error) {
 	bitSize := bits.LeadingZeros(0) - bits.LeadingZeros(numSize-1)
 	_, totalbits := globalCidr.net.Mask.Size()
 	clusterPrefix := totalbits - bitSize
@@ -153,20 +153,20 @@ func allocateByClusterSize(numSize uint) (string, error) {
 
 	cidr := fmt.Sprintf("%s/%d", globalCidr.net.IP, clusterPrefix)
 
-	last, err := allocateByCidr(cidr)
-	if err != nil && last == 0 {
+	last, err := globalCidr.allocateByCidr(cidr)
+	if err != nil && last == nil {
 		return "", err
 	}
 
 	for err != nil {
 		nextNet := net.IPNet{
-			IP:   uintToIP(last + 1),
+			IP:   intToIP(new(big.Int).Add(last, big.NewInt(1)), isIPv4(globalCidr.net.IP)),
 			Mask: mask,
 		}
 		cidr = nextNet.String()
 
-		last, err = allocateByCidr(cidr)
-		if err != nil && last == 0 {
+		last, err = globalCidr.allocateByCidr(cidr)
+		if err != nil && last == nil {
 			return "", fmt.Errorf("allocation not available")
 		}
 	}
@@ -174,8 +174,10 @@ func allocateByClusterSize(numSize uint) (string, error) {
 	return cidr, nil
 }
 
+// AllocateGlobalCIDR allocates a CIDR of globalnetInfo.ClusterSize addresses from the global CIDR range, avoiding the CIDRs
+// already allocated in globalnetInfo. It doesn't record the allocation; concurrent callers must be serialized.
 func AllocateGlobalCIDR(globalnetInfo *Info) (string, error) {
-	globalCidr = GlobalCIDR{allocatedCount: 0, cidr: globalnetInfo.CidrRange}
+	globalCidr := &GlobalCIDR{allocatedCount: 0, cidr: globalnetInfo.CidrRange}
 
 	_, network, err := net.ParseCIDR(globalCidr.cidr)
 	if err != nil {
@@ -196,21 +198,35 @@ func AllocateGlobalCIDR(globalnetInfo *Info) (string, error) {
 		}
 	}
 
-	return allocateByClusterSize(globalnetInfo.ClusterSize)
+	return globalCidr.allocateByClusterSize(globalnetInfo.ClusterSize)
+}
+
+func isIPv4(ip net.IP) bool {
+	return ip.To4() != nil
 }
 
-func ipToUint(ip net.IP) uint {
-	intIP := ip
-	if len(ip) == 16 {
-		intIP = ip[12:16]
+func ipToInt(ip net.IP) *big.Int {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return new(big.Int).SetBytes(ipv4)
 	}
 
-	return uint(binary.BigEndian.Uint32(intIP))
+	return new(big.Int).SetBytes(ip.To16())
 }
 
-func uintToIP(ip uint) net.IP {
-	netIP := make(net.IP, 4)
-	binary.BigEndian.PutUint32(netIP, uint32(ip))
+func intToIP(ip *big.Int, ipv4 bool) net.IP {
+	size := net.IPv6len
+	if ipv4 {
+		size = net.IPv4len
+	}
+
+	// Values beyond the address space are truncated to their low-order bytes, i.e. wrapped around
+	ipBytes := ip.Bytes()
+	if len(ipBytes) > size {
+		ipBytes = ipBytes[len(ipBytes)-size:]
+	}
+
+	netIP := make(net.IP, size)
+	copy(netIP[size-len(ipBytes):], ipBytes)
 
 	return netIP
 }
@@ -222,12 +238,13 @@ func GetValidClusterSize(cidrRange string, clusterSize uint) (uint, error) {
 	}
 
 	ones, totalbits := network.Mask.Size()
-	availableSize := 1 << uint(totalbits-ones)
+	maxClusterSize := new(big.Int).Lsh(big.NewInt(1), uint(totalbits-ones))
+	maxClusterSize.Rsh(maxClusterSize, 1)
 	userClusterSize := clusterSize
 	clusterSize = nextPowerOf2(uint32(clusterSize))
 
-	if clusterSize > uint(availableSize/2) {
-		return 0, fmt.Errorf("cluster size %d, should be <= %d", userClusterSize, availableSize/2)
+	if new(big.Int).SetUint64(uint64(clusterSize)).Cmp(maxClusterSize) > 0 {
+		return 0, fmt.Errorf("cluster size %d, should be <= %s", userClusterSize, maxClusterSize)
 	}
 
 	if clusterSize == 0 {
@@ -258,7 +275,7 @@ func CheckOverlappingCidrs(globalnetInfo *Info, netconfig Config) error {
 		cidrlist = v.GlobalCIDRs
 		cidr = netconfig.GlobalCIDR
 
-		overlap, err := isOverlappingCIDR(cidrlist, cidr)
+		overlap, err := IsOverlappingCIDR(cidrlist, cidr)
 		if err != nil {
 			return errors.Wrap(err, "unable to validate overlapping CIDR")
 		}
@@ -416,6 +433,36 @@ func AssignGlobalnetIPs(globalnetInfo *Info, netconfig Config, status reporter.I
 	return globalnetCIDR, nil
 }
 
+// AllocateClusterGlobalCIDR determines the global CIDR for the cluster described by netconfig, as AssignGlobalnetIPs does,
+// and records it in globalnetInfo so that subsequent allocations take it into account.
+func AllocateClusterGlobalCIDR(globalnetInfo *Info, netconfig Config) (string, error) {
+	// The cluster size may be overridden for this cluster only
+	clusterInfo := *globalnetInfo
+
+	globalnetCIDR, err := ValidateGlobalnetConfiguration(&clusterInfo, netconfig, reporter.Silent())
+	if err != nil {
+		return "", err
+	}
+
+	netconfig.GlobalCIDR = globalnetCIDR
+
+	globalnetCIDR, err = AssignGlobalnetIPs(&clusterInfo, netconfig, reporter.Silent())
+	if err != nil {
+		return "", err
+	}
+
+	if globalnetInfo.CidrInfo == nil {
+		globalnetInfo.CidrInfo = map[string]*GlobalNetwork{}
+	}
+
+	globalnetInfo.CidrInfo[netconfig.ClusterID] = &GlobalNetwork{
+		ClusterID:   netconfig.ClusterID,
+		GlobalCIDRs: []string{globalnetCIDR},
+	}
+
+	return globalnetCIDR, nil
+}
+
 func IsValidCIDR(cidr string) error {
 	ip, _, err := net.ParseCIDR(cidr)
 	if err != nil {
@@ -460,7 +507,9 @@ func ValidateExistingGlobalNetworks(kubeClient kubernetes.Interface, namespace s
 	return nil
 }
 
-func AllocateAndUpdateGlobalCIDRConfigMap(brokerAdminClientset kubernetes.Interface, brokerNamespace string,
+// allocateAndUpdateGlobalCIDRConfigMap allocates the global CIDR by updating the globalnet ConfigMap directly; this is
+// only used with Brokers which don't support GlobalnetAllocations.
+func allocateAndUpdateGlobalCIDRConfigMap(brokerAdminClientset kubernetes.Interface, brokerNamespace string,
 	netconfig *Config, status reporter.Interface) error {
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		status.Start("Retrieving Globalnet information from the Broker")
@@ -253,6 +253,86 @@ var _ = Describe("AllocateGlobalCIDR: Fail", func() {
 	})
 })
 
+var _ = Describe("AllocateGlobalCIDR: IPv6", func() {
+	globalnetInfo := globalnet.Info{CidrRange: "fd00:1234::/64", ClusterSize: 65536}
+	globalnetInfo.CidrInfo = make(map[string]*globalnet.GlobalNetwork)
+
+	When("No GlobalCIDRs are already allocated", func() {
+		result, err := globalnet.AllocateGlobalCIDR(&globalnetInfo)
+		It("Should not return error", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should allocate the first CIDR", func() {
+			Expect(result).To(Equal("fd00:1234::/112"))
+		})
+	})
+	When("Two CIDRs are allocated at beginning", func() {
+		globalNetwork1 := globalnet.GlobalNetwork{
+			ClusterID:   "cluster1",
+			GlobalCIDRs: []string{"fd00:1234::/112"},
+		}
+		globalNetwork2 := globalnet.GlobalNetwork{
+			ClusterID:   "cluster2",
+			GlobalCIDRs: []string{"fd00:1234::1:0/112"},
+		}
+		globalnetInfo.CidrInfo[globalNetwork1.ClusterID] = &globalNetwork1
+		globalnetInfo.CidrInfo[globalNetwork2.ClusterID] = &globalNetwork2
+		result, err := globalnet.AllocateGlobalCIDR(&globalnetInfo)
+		It("Should not return error", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should allocate next available block", func() {
+			Expect(result).To(Equal("fd00:1234::2:0/112"))
+		})
+	})
+	When("The cluster size exceeds 32 bits", func() {
+		largeInfo := globalnet.Info{CidrRange: "fd00::/48", ClusterSize: 1 << 40}
+		largeInfo.CidrInfo = map[string]*globalnet.GlobalNetwork{
+			"cluster1": {ClusterID: "cluster1", GlobalCIDRs: []string{"fd00::/88"}},
+		}
+		result, err := globalnet.AllocateGlobalCIDR(&largeInfo)
+		It("Should not return error", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should allocate next available block", func() {
+			Expect(result).To(Equal("fd00::100:0:0/88"))
+		})
+	})
+	When("All CIDRs are already allocated", func() {
+		fullInfo := globalnet.Info{CidrRange: "fd00:1234::/111", ClusterSize: 65536}
+		fullInfo.CidrInfo = map[string]*globalnet.GlobalNetwork{
+			"cluster1": {ClusterID: "cluster1", GlobalCIDRs: []string{"fd00:1234::/112"}},
+			"cluster2": {ClusterID: "cluster2", GlobalCIDRs: []string{"fd00:1234::1:0/112"}},
+		}
+		result, err := globalnet.AllocateGlobalCIDR(&fullInfo)
+		It("Should return error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should not allocate any CIDR", func() {
+			Expect(result).To(Equal(""))
+		})
+	})
+})
+
+var _ = Describe("GetValidClusterSize", func() {
+	When("The range is IPv6", func() {
+		size, err := globalnet.GetValidClusterSize("fd00:1234::/64", 1000)
+		It("Should not return error", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should round the size up to a power of 2", func() {
+			Expect(size).To(Equal(uint(1024)))
+		})
+	})
+
+	When("The cluster size exceeds half the range", func() {
+		_, err := globalnet.GetValidClusterSize("fd00:1234::/120", 256)
+		It("Should return error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
 var _ = Describe("IsValidCidr", func() {
 	When("Unspecified CIDR", func() {
 		err := globalnet.IsValidCIDR("")
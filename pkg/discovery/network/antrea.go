@@ -0,0 +1,129 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	NetworkPluginAntrea = "antrea"
+	antreaNamespace     = "kube-system"
+	antreaConfigName    = "antrea-config"
+)
+
+func init() {
+	RegisterDiscoverer(NetworkPluginAntrea, PriorityAntrea, discoverAntreaNetwork)
+}
+
+// nolint:nilnil // Intentional as the purpose is to discover.
+func discoverAntreaNetwork(_ dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error) {
+	antreaConfig, err := findAntreaConfig(clientSet)
+	if err != nil || antreaConfig == nil {
+		return nil, err
+	}
+
+	clusterNetwork := &ClusterNetwork{NetworkPlugin: NetworkPluginAntrea}
+
+	var agentConfig struct {
+		ServiceCIDR   string `json:"serviceCIDR"`
+		ServiceCIDRv6 string `json:"serviceCIDRv6"`
+	}
+
+	// The service CIDRs are optional in the agent configuration; parsing errors are treated as missing values
+	if err := yaml.Unmarshal([]byte(antreaConfig.Data["antrea-agent.conf"]), &agentConfig); err == nil {
+		clusterNetwork.ServiceCIDRs = nonEmpty(agentConfig.ServiceCIDR, agentConfig.ServiceCIDRv6)
+	}
+
+	var controllerConfig struct {
+		NodeIPAM struct {
+			EnableNodeIPAM bool     `json:"enableNodeIPAM"`
+			ClusterCIDRs   []string `json:"clusterCIDRs"`
+			ServiceCIDR    string   `json:"serviceCIDR"`
+			ServiceCIDRv6  string   `json:"serviceCIDRv6"`
+		} `json:"nodeIPAM"`
+	}
+
+	// Antrea only knows the pod CIDRs when it allocates them itself; otherwise the nodes' pod CIDRs are used
+	if err := yaml.Unmarshal([]byte(antreaConfig.Data["antrea-controller.conf"]), &controllerConfig); err == nil &&
+		controllerConfig.NodeIPAM.EnableNodeIPAM {
+		clusterNetwork.PodCIDRs = controllerConfig.NodeIPAM.ClusterCIDRs
+
+		if len(clusterNetwork.ServiceCIDRs) == 0 {
+			clusterNetwork.ServiceCIDRs = nonEmpty(controllerConfig.NodeIPAM.ServiceCIDR, controllerConfig.NodeIPAM.ServiceCIDRv6)
+		}
+	}
+
+	if len(clusterNetwork.ServiceCIDRs) == 0 {
+		clusterIPRange, err := findClusterIPRange(clientSet)
+		if err == nil && clusterIPRange != "" {
+			clusterNetwork.ServiceCIDRs = []string{clusterIPRange}
+		}
+	}
+
+	return clusterNetwork, nil
+}
+
+// nolint:nilnil // Intentional as the purpose is to discover.
+func findAntreaConfig(clientSet kubernetes.Interface) (*v1.ConfigMap, error) {
+	configMaps := clientSet.CoreV1().ConfigMaps(antreaNamespace)
+
+	antreaConfig, err := configMaps.Get(context.TODO(), antreaConfigName, metav1.GetOptions{})
+	if err == nil {
+		return antreaConfig, nil
+	}
+
+	if !isNotDetected(err) {
+		return nil, errors.Wrapf(err, "error retrieving the %s ConfigMap", antreaConfigName)
+	}
+
+	// Older deployments suffix the name with a hash of the contents
+	cmList, err := configMaps.List(context.TODO(), metav1.ListOptions{LabelSelector: "app=antrea"})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing the Antrea ConfigMaps")
+	}
+
+	for i := range cmList.Items {
+		if strings.HasPrefix(cmList.Items[i].Name, antreaConfigName+"-") {
+			return &cmList.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func nonEmpty(values ...string) []string {
+	var result []string
+
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
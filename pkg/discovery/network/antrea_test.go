@@ -0,0 +1,109 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/pkg/discovery/network"
+	v1 "k8s.io/api/core/v1"
+	v1meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("Antrea Network", func() {
+	var (
+		initObjs     []runtime.Object
+		clusterNet   *network.ClusterNetwork
+		err          error
+		antreaCfgMap *v1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		antreaCfgMap = &v1.ConfigMap{
+			ObjectMeta: v1meta.ObjectMeta{
+				Name:      "antrea-config-8h7k2m9b5f",
+				Namespace: "kube-system",
+				Labels:    map[string]string{"app": "antrea"},
+			},
+			Data: map[string]string{
+				"antrea-agent.conf": "featureGates:\n  AntreaProxy: true\n#serviceCIDR: 10.96.0.0/12\n",
+			},
+		}
+		initObjs = []runtime.Object{antreaCfgMap, fakeNode("node1", testPodCIDR)}
+	})
+
+	JustBeforeEach(func() {
+		clusterNet, err = network.Discover(nil, newTestClient(initObjs...), nil, "")
+	})
+
+	When("the antrea-config ConfigMap has no network details", func() {
+		It("should return a ClusterNetwork with the generic pod and service CIDRs", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginAntrea))
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{testPodCIDR}))
+			Expect(clusterNet.ServiceCIDRs).To(Equal([]string{testServiceCIDRFromService}))
+		})
+	})
+
+	When("the agent configuration has the service CIDR", func() {
+		BeforeEach(func() {
+			antreaCfgMap.Data["antrea-agent.conf"] = "serviceCIDR: " + testServiceCIDR + "\n"
+		})
+
+		It("should return a ClusterNetwork with the configured service CIDR", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginAntrea))
+			Expect(clusterNet.ServiceCIDRs).To(Equal([]string{testServiceCIDR}))
+		})
+	})
+
+	When("the controller allocates the node pod CIDRs", func() {
+		BeforeEach(func() {
+			antreaCfgMap.Name = "antrea-config"
+			antreaCfgMap.Data["antrea-controller.conf"] = `nodeIPAM:
+  enableNodeIPAM: true
+  clusterCIDRs: [10.10.0.0/16]
+  serviceCIDR: 10.20.0.0/16
+`
+		})
+
+		It("should return a ClusterNetwork with the configured pod and service CIDRs", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginAntrea))
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{"10.10.0.0/16"}))
+			Expect(clusterNet.ServiceCIDRs).To(Equal([]string{"10.20.0.0/16"}))
+		})
+	})
+
+	When("the antrea-config ConfigMap is in an unrelated namespace", func() {
+		BeforeEach(func() {
+			antreaCfgMap.Namespace = "default"
+		})
+
+		It("should not detect Antrea", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).ToNot(Equal(network.NetworkPluginAntrea))
+		})
+	})
+})
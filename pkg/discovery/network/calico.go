@@ -23,11 +23,16 @@ import (
 	"github.com/pkg/errors"
 	"github.com/submariner-io/submariner/pkg/routeagent_driver/constants"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
+func init() {
+	RegisterDiscoverer(constants.NetworkPluginCalico, PriorityCalico, discoverCalicoNetwork)
+}
+
 // nolint:nilnil // Intentional as the purpose is to discover.
-func discoverCalicoNetwork(clientSet kubernetes.Interface) (*ClusterNetwork, error) {
+func discoverCalicoNetwork(_ dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error) {
 	cmList, err := clientSet.CoreV1().ConfigMaps(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "error listing ConfigMaps")
@@ -25,13 +25,17 @@ import (
 	"github.com/pkg/errors"
 	"github.com/submariner-io/submariner/pkg/routeagent_driver/constants"
 	v1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
+func init() {
+	RegisterDiscoverer(constants.NetworkPluginCanalFlannel, PriorityCanalFlannel, discoverCanalFlannelNetwork)
+}
+
 // nolint:nilnil // Intentional as the purpose is to discover.
-func discoverCanalFlannelNetwork(clientSet kubernetes.Interface) (*ClusterNetwork, error) {
+func discoverCanalFlannelNetwork(_ dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error) {
 	// TODO: this must be smarter, looking for the canal daemonset, with labels k8s-app=canal
 	//  and then the reference on the container volumes:
 	//   - configMap:
@@ -40,7 +44,7 @@ func discoverCanalFlannelNetwork(clientSet kubernetes.Interface) (*ClusterNetwor
 	//        name: flannel-cfg
 	cm, err := clientSet.CoreV1().ConfigMaps("kube-system").Get(context.TODO(), "canal-config", metav1.GetOptions{})
 	if err != nil {
-		if apierrors.IsNotFound(err) {
+		if isNotDetected(err) {
 			return nil, nil
 		}
 
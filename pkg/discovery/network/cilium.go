@@ -0,0 +1,119 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	NetworkPluginCilium = "cilium"
+	ciliumConfigName    = "cilium-config"
+)
+
+// The namespaces in which Cilium is deployed, by default and on OpenShift.
+var ciliumNamespaces = []string{"kube-system", "cilium"}
+
+var ciliumNodeGVR = schema.GroupVersionResource{
+	Group:    "cilium.io",
+	Version:  "v2",
+	Resource: "ciliumnodes",
+}
+
+func init() {
+	RegisterDiscoverer(NetworkPluginCilium, PriorityCilium, discoverCiliumNetwork)
+}
+
+// nolint:nilnil // Intentional as the purpose is to discover.
+func discoverCiliumNetwork(dynClient dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error) {
+	ciliumConfig, err := findCiliumConfig(clientSet)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterNetwork := &ClusterNetwork{NetworkPlugin: NetworkPluginCilium}
+	found := ciliumConfig != nil
+
+	if found {
+		// Only set when Cilium manages the pod IPs itself (cluster-pool IPAM); otherwise the pod CIDRs are discovered
+		// generically
+		for _, key := range []string{"cluster-pool-ipv4-cidr", "cluster-pool-ipv6-cidr"} {
+			if cidr := ciliumConfig.Data[key]; cidr != "" {
+				clusterNetwork.PodCIDRs = append(clusterNetwork.PodCIDRs, cidr)
+			}
+		}
+	} else {
+		// CiliumNodes only hold each node's share of the pod CIDRs, so they're only used to detect Cilium
+		found, err = ciliumNodesExist(dynClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	clusterIPRange, err := findClusterIPRange(clientSet)
+	if err == nil && clusterIPRange != "" {
+		clusterNetwork.ServiceCIDRs = []string{clusterIPRange}
+	}
+
+	return clusterNetwork, nil
+}
+
+// nolint:nilnil // Intentional as the purpose is to discover.
+func findCiliumConfig(clientSet kubernetes.Interface) (*v1.ConfigMap, error) {
+	for _, namespace := range ciliumNamespaces {
+		ciliumConfig, err := clientSet.CoreV1().ConfigMaps(namespace).Get(context.TODO(), ciliumConfigName, metav1.GetOptions{})
+		if err == nil {
+			return ciliumConfig, nil
+		}
+
+		if !isNotDetected(err) {
+			return nil, errors.Wrapf(err, "error retrieving the %s ConfigMap in namespace %q", ciliumConfigName, namespace)
+		}
+	}
+
+	return nil, nil
+}
+
+func ciliumNodesExist(dynClient dynamic.Interface) (bool, error) {
+	if dynClient == nil {
+		return false, nil
+	}
+
+	nodes, err := dynClient.Resource(ciliumNodeGVR).List(context.TODO(), metav1.ListOptions{Limit: 1})
+	if err != nil {
+		if isNotDetected(err) {
+			return false, nil
+		}
+
+		return false, errors.WithMessage(err, "error listing CiliumNodes")
+	}
+
+	return len(nodes.Items) > 0, nil
+}
@@ -0,0 +1,160 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/pkg/discovery/network"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Cilium Network", func() {
+	const ciliumPodCIDR = "10.0.0.0/8"
+
+	var (
+		initObjs     []runtime.Object
+		dynObjs      []runtime.Object
+		clusterNet   *network.ClusterNetwork
+		err          error
+		ciliumCfgMap *v1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		dynObjs = nil
+		ciliumCfgMap = &v1.ConfigMap{
+			ObjectMeta: v1meta.ObjectMeta{
+				Name:      "cilium-config",
+				Namespace: "kube-system",
+			},
+			Data: map[string]string{"cluster-pool-ipv4-cidr": ciliumPodCIDR},
+		}
+		initObjs = []runtime.Object{ciliumCfgMap}
+	})
+
+	JustBeforeEach(func() {
+		clusterNet, err = network.Discover(fake.NewSimpleDynamicClient(runtime.NewScheme(), dynObjs...), newTestClient(initObjs...), nil, "")
+	})
+
+	When("the cilium-config ConfigMap has a cluster pool CIDR", func() {
+		It("should return a ClusterNetwork with the pod and service CIDRs", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginCilium))
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{ciliumPodCIDR}))
+			Expect(clusterNet.ServiceCIDRs).To(Equal([]string{testServiceCIDRFromService}))
+		})
+	})
+
+	When("the cilium-config ConfigMap has no cluster pool CIDR", func() {
+		BeforeEach(func() {
+			ciliumCfgMap.Data = map[string]string{}
+			initObjs = append(initObjs, fakeNode("node1", testPodCIDR))
+			dynObjs = []runtime.Object{fakeCiliumNode("node1", "10.1.0.0/24"), fakeCiliumNode("node2", "10.1.1.0/24")}
+		})
+
+		It("should return a ClusterNetwork with the generic pod CIDR rather than a single node's", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginCilium))
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{testPodCIDR}))
+		})
+	})
+
+	When("the cilium-config ConfigMap is in the cilium namespace", func() {
+		BeforeEach(func() {
+			ciliumCfgMap.Namespace = "cilium"
+		})
+
+		It("should return a ClusterNetwork with the pod CIDR", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginCilium))
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{ciliumPodCIDR}))
+		})
+	})
+
+	When("a cilium-config ConfigMap is in an unrelated namespace", func() {
+		BeforeEach(func() {
+			ciliumCfgMap.Namespace = "default"
+		})
+
+		It("should not detect Cilium", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).ToNot(Equal(network.NetworkPluginCilium))
+		})
+	})
+
+	When("an earlier discoverer fails", func() {
+		JustBeforeEach(func() {
+			dynClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), dynObjs...)
+			dynClient.PrependReactor("get", "subnets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewInternalError(errors.New("fake error"))
+			})
+
+			clusterNet, err = network.Discover(dynClient, newTestClient(initObjs...), nil, "")
+		})
+
+		It("should still detect Cilium", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginCilium))
+		})
+	})
+
+	When("there is no cilium-config ConfigMap but there are CiliumNodes", func() {
+		BeforeEach(func() {
+			initObjs = []runtime.Object{fakeNode("node1", testPodCIDR)}
+			dynObjs = []runtime.Object{fakeCiliumNode("node1", "10.1.0.0/24")}
+		})
+
+		It("should return a Cilium ClusterNetwork with the generic pod CIDR", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginCilium))
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{testPodCIDR}))
+		})
+	})
+})
+
+func fakeCiliumNode(name, podCIDR string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cilium.io/v2",
+			"kind":       "CiliumNode",
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+			"spec": map[string]interface{}{
+				"ipam": map[string]interface{}{
+					"podCIDRs": []interface{}{podCIDR},
+				},
+			},
+		},
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/submariner-io/submariner/pkg/routeagent_driver/constants"
@@ -41,6 +42,8 @@ func discoverGenericNetwork(clientSet kubernetes.Interface) (*ClusterNetwork, er
 
 	if clusterNetwork != nil {
 		clusterNetwork.NetworkPlugin = constants.NetworkPluginGeneric
+		clusterNetwork.splitFamilies()
+
 		return clusterNetwork, nil
 	}
 
@@ -189,6 +192,11 @@ func findPodIPRangeFromNodeSpec(clientSet kubernetes.Interface) (string, error)
 
 func parseToPodCidr(nodes []v1.Node) (string, error) {
 	for i := range nodes {
+		// Dual-stack nodes list the CIDRs of both families
+		if len(nodes[i].Spec.PodCIDRs) > 1 {
+			return strings.Join(nodes[i].Spec.PodCIDRs, ","), nil
+		}
+
 		if nodes[i].Spec.PodCIDR != "" {
 			return nodes[i].Spec.PodCIDR, nil
 		}
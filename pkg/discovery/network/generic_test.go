@@ -264,6 +264,36 @@ var _ = Describe("Generic Network", func() {
 		})
 	})
 
+	When("The cluster is dual-stack", func() {
+		const (
+			testIPv6PodCIDR     = "fd00:10:244::/56"
+			testIPv6ServiceCIDR = "fd00:10:96::/112"
+		)
+
+		var clusterNet *network.ClusterNetwork
+
+		BeforeEach(func() {
+			node := fakeNode("node1", testPodCIDR)
+			node.Spec.PodCIDRs = []string{testPodCIDR, testIPv6PodCIDR}
+
+			clusterNet = testDiscoverGenericWith(
+				node,
+				fakePod("kube-apiserver", []string{"kube-apiserver",
+					"--service-cluster-ip-range=" + testServiceCIDR + "," + testIPv6ServiceCIDR}, []v1.EnvVar{}),
+			)
+		})
+
+		It("Should return the IPv4 CIDRs", func() {
+			Expect(clusterNet.ServiceCIDRs).To(Equal([]string{testServiceCIDR}))
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{testPodCIDR}))
+		})
+
+		It("Should return the IPv6 CIDRs separately", func() {
+			Expect(clusterNet.IPv6ServiceCIDRs).To(Equal([]string{testIPv6ServiceCIDR}))
+			Expect(clusterNet.IPv6PodCIDRs).To(Equal([]string{testIPv6PodCIDR}))
+		})
+	})
+
 	When("No kube-api pod exists and invalid service creation returns no error", func() {
 		It("Should return error and nil cluster network", func() {
 			clientSet := fake.NewSimpleClientset()
@@ -0,0 +1,83 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+const NetworkPluginKubeOVN = "kube-ovn"
+
+var kubeOVNSubnetGVR = schema.GroupVersionResource{
+	Group:    "kubeovn.io",
+	Version:  "v1",
+	Resource: "subnets",
+}
+
+func init() {
+	RegisterDiscoverer(NetworkPluginKubeOVN, PriorityKubeOVN, discoverKubeOVNNetwork)
+}
+
+// nolint:nilnil // Intentional as the purpose is to discover.
+func discoverKubeOVNNetwork(dynClient dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error) {
+	if dynClient == nil {
+		return nil, nil
+	}
+
+	subnet, err := dynClient.Resource(kubeOVNSubnetGVR).Get(context.TODO(), "ovn-default", metav1.GetOptions{})
+	if err != nil {
+		if isNotDetected(err) {
+			return nil, nil
+		}
+
+		return nil, errors.WithMessage(err, "error obtaining the \"ovn-default\" Kube-OVN Subnet")
+	}
+
+	clusterNetwork := &ClusterNetwork{NetworkPlugin: NetworkPluginKubeOVN}
+
+	cidrBlock, _, _ := unstructured.NestedString(subnet.Object, "spec", "cidrBlock")
+	if cidrBlock != "" {
+		clusterNetwork.PodCIDRs = []string{cidrBlock}
+	}
+
+	clusterIPRange, err := FindPodCommandParameter(clientSet, "app=kube-ovn-controller", "--service-cluster-ip-range")
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterIPRange == "" {
+		clusterIPRange, err = findClusterIPRange(clientSet)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if clusterIPRange != "" {
+		clusterNetwork.ServiceCIDRs = []string{clusterIPRange}
+	}
+
+	return clusterNetwork, nil
+}
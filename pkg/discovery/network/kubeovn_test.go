@@ -0,0 +1,138 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/pkg/discovery/network"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Kube-OVN Network", func() {
+	var (
+		initObjs   []runtime.Object
+		cidrBlock  string
+		clusterNet *network.ClusterNetwork
+		subnetErr  error
+		err        error
+	)
+
+	BeforeEach(func() {
+		initObjs = nil
+		cidrBlock = testPodCIDR
+		subnetErr = nil
+	})
+
+	JustBeforeEach(func() {
+		dynClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), fakeKubeOVNSubnet("ovn-default", cidrBlock))
+		if subnetErr != nil {
+			dynClient.PrependReactor("get", "subnets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, subnetErr
+			})
+		}
+
+		clusterNet, err = network.Discover(dynClient, newTestClient(initObjs...), nil, "")
+	})
+
+	When("there is no kube-ovn-controller pod", func() {
+		It("should return a ClusterNetwork with the subnet CIDR and the generic service CIDR", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginKubeOVN))
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{testPodCIDR}))
+			Expect(clusterNet.ServiceCIDRs).To(Equal([]string{testServiceCIDRFromService}))
+		})
+	})
+
+	When("there is a kube-ovn-controller pod", func() {
+		BeforeEach(func() {
+			pod := fakePodWithName("kube-ovn-controller-xyz", "kube-ovn-controller",
+				[]string{"/kube-ovn/start-controller.sh", "--service-cluster-ip-range=" + testServiceCIDR}, []v1.EnvVar{})
+			pod.Labels["app"] = "kube-ovn-controller"
+			initObjs = []runtime.Object{pod}
+		})
+
+		It("should return a ClusterNetwork with the controller's service CIDR", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal(network.NetworkPluginKubeOVN))
+			Expect(clusterNet.ServiceCIDRs).To(Equal([]string{testServiceCIDR}))
+		})
+	})
+
+	When("the subnets aren't accessible", func() {
+		BeforeEach(func() {
+			subnetErr = apierrors.NewForbidden(schema.GroupResource{Group: "kubeovn.io", Resource: "subnets"}, "ovn-default", nil)
+		})
+
+		It("should not detect Kube-OVN and fall back to the generic discovery", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.NetworkPlugin).To(Equal("generic"))
+		})
+	})
+
+	When("retrieving the subnet fails", func() {
+		BeforeEach(func() {
+			subnetErr = apierrors.NewInternalError(errors.New("fake error"))
+		})
+
+		It("should return the error since no other plugin is detected", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(clusterNet).To(BeNil())
+		})
+	})
+
+	When("the subnet is dual-stack", func() {
+		BeforeEach(func() {
+			cidrBlock = testPodCIDR + ",fd00:10:16::/64"
+		})
+
+		It("should return the pod CIDRs of both families", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterNet).NotTo(BeNil())
+			Expect(clusterNet.PodCIDRs).To(Equal([]string{testPodCIDR}))
+			Expect(clusterNet.IPv6PodCIDRs).To(Equal([]string{"fd00:10:16::/64"}))
+		})
+	})
+})
+
+func fakeKubeOVNSubnet(name, cidrBlock string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "kubeovn.io/v1",
+			"kind":       "Subnet",
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+			"spec": map[string]interface{}{
+				"cidrBlock": cidrBlock,
+			},
+		},
+	}
+}
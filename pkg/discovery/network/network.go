@@ -21,33 +21,92 @@ package network
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	operatorclientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/operator/submarinercr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
+// A Discoverer probes the cluster for a specific network plugin. It returns nil if the plugin isn't detected; errors
+// don't prevent the following discoverers from being tried.
+type Discoverer func(dynClient dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error)
+
+type registeredDiscoverer struct {
+	name     string
+	discover Discoverer
+	priority int
+}
+
+// Priorities of the built-in discoverers; discoverers with lower priorities are tried first.
+const (
+	PriorityOpenShift4    = 100
+	PriorityWeaveNet      = 200
+	PriorityCanalFlannel  = 300
+	PriorityOVNKubernetes = 400
+	PriorityKubeOVN       = 500
+	PriorityCilium        = 600
+	PriorityAntrea        = 700
+	PriorityCalico        = 800
+)
+
+var discoverers []registeredDiscoverer
+
+// RegisterDiscoverer adds a network plugin discoverer, tried in ascending order of priority, and by name for equal priorities.
+// The first discoverer which detects its plugin determines the result, even if earlier discoverers failed.
+func RegisterDiscoverer(name string, priority int, discover Discoverer) {
+	discoverers = append(discoverers, registeredDiscoverer{name: name, discover: discover, priority: priority})
+
+	sort.SliceStable(discoverers, func(i, j int) bool {
+		if discoverers[i].priority != discoverers[j].priority {
+			return discoverers[i].priority < discoverers[j].priority
+		}
+
+		return discoverers[i].name < discoverers[j].name
+	})
+}
+
+// ClusterNetwork describes the discovered network; PodCIDRs and ServiceCIDRs hold the IPv4 CIDRs, the IPv6 CIDRs of
+// dual-stack and IPv6 clusters are held separately.
 type ClusterNetwork struct {
-	PodCIDRs       []string
-	ServiceCIDRs   []string
-	NetworkPlugin  string
-	GlobalCIDR     string
-	PluginSettings map[string]string
+	PodCIDRs         []string
+	ServiceCIDRs     []string
+	IPv6PodCIDRs     []string
+	IPv6ServiceCIDRs []string
+	NetworkPlugin    string
+	GlobalCIDR       string
+	PluginSettings   map[string]string
 }
 
 func (cn *ClusterNetwork) Show() {
+	cn.ShowTo(os.Stdout)
+}
+
+// ShowTo writes the network details to the given writer.
+func (cn *ClusterNetwork) ShowTo(w io.Writer) {
 	if cn == nil {
-		fmt.Println("    No network details discovered")
+		fmt.Fprintln(w, "    No network details discovered")
 	} else {
-		fmt.Printf("        Network plugin:  %s\n", cn.NetworkPlugin)
-		fmt.Printf("        Service CIDRs:   %v\n", cn.ServiceCIDRs)
-		fmt.Printf("        Cluster CIDRs:   %v\n", cn.PodCIDRs)
+		fmt.Fprintf(w, "        Network plugin:  %s\n", cn.NetworkPlugin)
+		fmt.Fprintf(w, "        Service CIDRs:   %v\n", cn.ServiceCIDRs)
+		fmt.Fprintf(w, "        Cluster CIDRs:   %v\n", cn.PodCIDRs)
+		if len(cn.IPv6ServiceCIDRs) > 0 || len(cn.IPv6PodCIDRs) > 0 {
+			fmt.Fprintf(w, "        IPv6 Service CIDRs: %v\n", cn.IPv6ServiceCIDRs)
+			fmt.Fprintf(w, "        IPv6 Cluster CIDRs: %v\n", cn.IPv6PodCIDRs)
+		}
 		if cn.GlobalCIDR != "" {
-			fmt.Printf("        Global CIDR:     %v\n", cn.GlobalCIDR)
+			fmt.Fprintf(w, "        Global CIDR:     %v\n", cn.GlobalCIDR)
 		}
 	}
 }
@@ -56,7 +115,44 @@ func (cn *ClusterNetwork) Log(logger logr.Logger) {
 	logger.Info("Discovered K8s network details",
 		"plugin", cn.NetworkPlugin,
 		"clusterCIDRs", cn.PodCIDRs,
-		"serviceCIDRs", cn.ServiceCIDRs)
+		"serviceCIDRs", cn.ServiceCIDRs,
+		"ipv6ClusterCIDRs", cn.IPv6PodCIDRs,
+		"ipv6ServiceCIDRs", cn.IPv6ServiceCIDRs)
+}
+
+// splitFamilies moves the IPv6 CIDRs to their own fields; dual-stack settings list both families, separated by commas.
+func (cn *ClusterNetwork) splitFamilies() {
+	cn.PodCIDRs, cn.IPv6PodCIDRs = splitCIDRFamilies(cn.PodCIDRs, cn.IPv6PodCIDRs)
+	cn.ServiceCIDRs, cn.IPv6ServiceCIDRs = splitCIDRFamilies(cn.ServiceCIDRs, cn.IPv6ServiceCIDRs)
+}
+
+func splitCIDRFamilies(cidrs, ipv6CIDRs []string) ([]string, []string) {
+	var ipv4CIDRs []string
+
+	for _, entry := range cidrs {
+		for _, cidr := range strings.Split(entry, ",") {
+			cidr = strings.TrimSpace(cidr)
+
+			// Anything which isn't an IPv6 CIDR stays where it was
+			if ip, _, err := net.ParseCIDR(cidr); err == nil && ip.To4() == nil {
+				ipv6CIDRs = appendUnique(ipv6CIDRs, cidr)
+			} else if cidr != "" {
+				ipv4CIDRs = appendUnique(ipv4CIDRs, cidr)
+			}
+		}
+	}
+
+	return ipv4CIDRs, ipv6CIDRs
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+
+	return append(values, value)
 }
 
 func (cn *ClusterNetwork) IsComplete() bool {
@@ -67,6 +163,7 @@ func Discover(dynClient dynamic.Interface, clientSet kubernetes.Interface, opera
 	operatorNamespace string) (*ClusterNetwork, error) {
 	discovery, err := networkPluginsDiscovery(dynClient, clientSet)
 	if err != nil {
+		// The generic discovery could be wrong if the plugin which failed is in use
 		return nil, err
 	}
 
@@ -95,6 +192,14 @@ func Discover(dynClient dynamic.Interface, clientSet kubernetes.Interface, opera
 				if len(discovery.PodCIDRs) == 0 {
 					discovery.PodCIDRs = genericNet.PodCIDRs
 				}
+
+				if len(discovery.IPv6ServiceCIDRs) == 0 {
+					discovery.IPv6ServiceCIDRs = genericNet.IPv6ServiceCIDRs
+				}
+
+				if len(discovery.IPv6PodCIDRs) == 0 {
+					discovery.IPv6PodCIDRs = genericNet.IPv6PodCIDRs
+				}
 			}
 		}
 
@@ -105,34 +210,31 @@ func Discover(dynClient dynamic.Interface, clientSet kubernetes.Interface, opera
 	return discoverGenericNetwork(clientSet)
 }
 
-// nolint:nilnil // Intentional as the purpose is to discover.
+// networkPluginsDiscovery returns the network discovered by the first discoverer which detects its plugin; if none does,
+// it returns the errors of the discoverers which failed.
 func networkPluginsDiscovery(dynClient dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error) {
-	osClusterNet, err := discoverOpenShift4Network(dynClient)
-	if err != nil || osClusterNet != nil {
-		return osClusterNet, err
-	}
-
-	weaveClusterNet, err := discoverWeaveNetwork(clientSet)
-	if err != nil || weaveClusterNet != nil {
-		return weaveClusterNet, err
-	}
+	errs := []error{}
 
-	canalClusterNet, err := discoverCanalFlannelNetwork(clientSet)
-	if err != nil || canalClusterNet != nil {
-		return canalClusterNet, err
-	}
+	for i := range discoverers {
+		clusterNet, err := discoverers[i].discover(dynClient, clientSet)
+		if err != nil {
+			errs = append(errs, errors.WithMessagef(err, "error discovering the %s network", discoverers[i].name))
+			continue
+		}
 
-	ovnClusterNet, err := discoverOvnKubernetesNetwork(clientSet)
-	if err != nil || ovnClusterNet != nil {
-		return ovnClusterNet, err
+		if clusterNet != nil {
+			clusterNet.splitFamilies()
+			return clusterNet, nil
+		}
 	}
 
-	calicoClusterNet, err := discoverCalicoNetwork(clientSet)
-	if err != nil || calicoClusterNet != nil {
-		return calicoClusterNet, err
-	}
+	return nil, utilerrors.NewAggregate(errs)
+}
 
-	return nil, nil
+// isNotDetected returns true if the error only shows that the probed resource is unavailable, because it doesn't exist,
+// its type isn't known, or it isn't accessible; the corresponding plugin is then not detected.
+func isNotDetected(err error) bool {
+	return apierrors.IsNotFound(err) || apierrors.IsForbidden(err) || meta.IsNoMatchError(err)
 }
 
 func getGlobalCIDRs(operatorClient operatorclientset.Interface, operatorNamespace string) (string, error) {
@@ -24,11 +24,11 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/submariner-io/submariner/pkg/routeagent_driver/constants"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 )
 
 var openshift4clusterNetworkGVR = schema.GroupVersionResource{
@@ -37,8 +37,12 @@ var openshift4clusterNetworkGVR = schema.GroupVersionResource{
 	Resource: "networks",
 }
 
+func init() {
+	RegisterDiscoverer("OpenShift4", PriorityOpenShift4, discoverOpenShift4Network)
+}
+
 // nolint:nilnil // Intentional as the purpose is to discover.
-func discoverOpenShift4Network(dynClient dynamic.Interface) (*ClusterNetwork, error) {
+func discoverOpenShift4Network(dynClient dynamic.Interface, _ kubernetes.Interface) (*ClusterNetwork, error) {
 	if dynClient == nil {
 		return nil, nil
 	}
@@ -47,7 +51,7 @@ func discoverOpenShift4Network(dynClient dynamic.Interface) (*ClusterNetwork, er
 
 	cr, err := crClient.Get(context.TODO(), "cluster", metav1.GetOptions{})
 	if err != nil {
-		if apierrors.IsNotFound(err) {
+		if isNotDetected(err) {
 			return nil, nil
 		}
 
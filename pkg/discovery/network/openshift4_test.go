@@ -64,7 +64,7 @@ func testOS4DiscoveryWith(json []byte) (*network.ClusterNetwork, error) {
 	scheme := runtime.NewScheme()
 	dynClient := fake.NewSimpleDynamicClient(scheme, obj)
 
-	return network.Discover(dynClient, nil, nil, "")
+	return network.Discover(dynClient, newTestClient(), nil, "")
 }
 
 func getNetworkJSON() []byte {
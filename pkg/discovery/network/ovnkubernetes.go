@@ -25,6 +25,7 @@ import (
 
 	"github.com/submariner-io/submariner/pkg/routeagent_driver/constants"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -36,7 +37,11 @@ const (
 	OvnSBDBDefaultPort = 6642
 )
 
-func discoverOvnKubernetesNetwork(clientSet kubernetes.Interface) (*ClusterNetwork, error) {
+func init() {
+	RegisterDiscoverer(constants.NetworkPluginOVNKubernetes, PriorityOVNKubernetes, discoverOvnKubernetesNetwork)
+}
+
+func discoverOvnKubernetesNetwork(_ dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error) {
 	ovnDBPod, err := FindPod(clientSet, "name=ovnkube-db")
 
 	if err != nil || ovnDBPod == nil {
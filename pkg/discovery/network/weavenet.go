@@ -20,11 +20,16 @@ package network
 
 import (
 	"github.com/submariner-io/submariner/pkg/routeagent_driver/constants"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
+func init() {
+	RegisterDiscoverer(constants.NetworkPluginWeaveNet, PriorityWeaveNet, discoverWeaveNetwork)
+}
+
 // nolint:nilnil // Intentional as the purpose is to discover.
-func discoverWeaveNetwork(clientSet kubernetes.Interface) (*ClusterNetwork, error) {
+func discoverWeaveNetwork(_ dynamic.Interface, clientSet kubernetes.Interface) (*ClusterNetwork, error) {
 	weaveNetPod, err := FindPod(clientSet, "name=weave-net")
 
 	if err != nil || weaveNetPod == nil {
@@ -28,6 +28,7 @@ import (
 	"github.com/submariner-io/submariner-operator/internal/image"
 	"github.com/submariner-io/submariner-operator/pkg/broker"
 	"github.com/submariner-io/submariner-operator/pkg/client"
+	"github.com/submariner-io/submariner-operator/pkg/crd"
 	"github.com/submariner-io/submariner-operator/pkg/deploy"
 	"github.com/submariner-io/submariner-operator/pkg/discovery/globalnet"
 	"github.com/submariner-io/submariner-operator/pkg/reporter"
@@ -36,12 +37,19 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
+// PlaceholderToken replaces the cluster's broker token in the printed resources when joining with a join token.
+const PlaceholderToken = "<cluster broker token>"
+
 func ClusterToBroker(brokerInfo *broker.Info, options *Options, clientProducer client.Producer,
 	status reporter.Interface) error {
-	err := checkRequirements(clientProducer.ForKubernetes(), options.IgnoreRequirements, status)
-	if err != nil {
+	var err error
+
+	if client.ModeOf(clientProducer) == client.RenderOnly {
+		status.Warning("The cluster's Kubernetes version isn't checked when only rendering the resources")
+	} else if err = checkRequirements(clientProducer.ForKubernetes(), options.IgnoreRequirements, status); err != nil {
 		return err
 	}
 
@@ -50,19 +58,32 @@ func ClusterToBroker(brokerInfo *broker.Info, options *Options, clientProducer c
 		return status.Error(err, "error validating custom CoreDNS config")
 	}
 
+	if brokerInfo.Bootstrap != nil {
+		if err = brokerInfo.Bootstrap.Validate(options.ClusterID); err != nil {
+			return status.Error(err, "error validating the join token")
+		}
+	}
+
 	status.Start("Gathering relevant information from Broker")
 	defer status.End()
 
-	brokerAdminConfig, err := brokerInfo.GetBrokerAdministratorConfig()
-	if err != nil {
-		return status.Error(err, "Error retrieving broker admin config")
+	// Retrieving the configuration checks the connection to the broker, which isn't accessed when only rendering
+	var brokerAdminConfig *rest.Config
+
+	if client.ModeOf(clientProducer) != client.RenderOnly {
+		brokerAdminConfig, err = brokerInfo.GetBrokerAdministratorConfig()
+		if err != nil {
+			return status.Error(err, "Error retrieving broker admin config")
+		}
 	}
 
-	brokerAdminClientset, err := kubernetes.NewForConfig(brokerAdminConfig)
+	brokerClientProducer, err := brokerProducer(clientProducer, brokerAdminConfig)
 	if err != nil {
 		return status.Error(err, "Error retrieving broker admin connection")
 	}
 
+	brokerAdminClientset := brokerClientProducer.ForKubernetes()
+
 	brokerNamespace := string(brokerInfo.ClientToken.Data["namespace"])
 	netconfig := globalnet.Config{
 		ClusterID:   options.ClusterID,
@@ -70,8 +91,11 @@ func ClusterToBroker(brokerInfo *broker.Info, options *Options, clientProducer c
 		ClusterSize: options.GlobalnetClusterSize,
 	}
 
-	if options.GlobalnetEnabled {
-		err = globalnet.AllocateAndUpdateGlobalCIDRConfigMap(brokerAdminClientset, brokerNamespace, &netconfig, status)
+	if options.GlobalnetEnabled && client.IsRecording(clientProducer) {
+		status.Warning("The global CIDR is allocated by the Broker when the cluster joins; unless specified, it is left empty")
+	} else if options.GlobalnetEnabled {
+		err = globalnet.RequestGlobalCIDRAllocation(brokerClientProducer.ForOperator(), brokerAdminClientset, brokerNamespace,
+			&netconfig, status)
 		if err != nil {
 			return errors.Wrap(err, "unable to determine the global CIDR")
 		}
@@ -79,16 +103,15 @@ func ClusterToBroker(brokerInfo *broker.Info, options *Options, clientProducer c
 
 	status.Start("Deploying the Submariner operator")
 
-	err = deploy.Operator(status, options.ImageVersion, options.Repository, options.ImageOverrideArr, options.OperatorDebug, clientProducer)
+	err = deploy.Operator(status, options.ImageVersion, options.Repository, options.ImageOverrideArr, options.OperatorDebug,
+		crd.SkewPolicyFor(options.ForceCRDUpdate), clientProducer)
 	if err != nil {
 		return status.Error(err, "Error deploying the operator")
 	}
 
-	status.Start("Creating SA for cluster")
-
-	brokerInfo.ClientToken, err = broker.CreateSAForCluster(brokerAdminClientset, options.ClusterID, brokerNamespace)
-	if err != nil {
-		return status.Error(err, "Error creating SA for cluster")
+	if err = retrieveClusterToken(brokerInfo, options.ClusterID, brokerAdminClientset, brokerNamespace,
+		client.IsRecording(clientProducer), status); err != nil {
+		return err
 	}
 
 	status.Start("Connecting to Broker")
@@ -126,9 +149,67 @@ func ClusterToBroker(brokerInfo *broker.Info, options *Options, clientProducer c
 		status.Success("Service discovery is up and running")
 	}
 
+	reportBrokerChanges(brokerClientProducer, status)
+
 	return nil
 }
 
+// retrieveClusterToken replaces the broker token in brokerInfo with the cluster's own token, exchanging the join token
+// for it if brokerInfo contains one. When the changes are only recorded, the join token is left unused and the cluster's
+// token is replaced with PlaceholderToken.
+func retrieveClusterToken(brokerInfo *broker.Info, clusterID string, brokerClientset kubernetes.Interface, brokerNamespace string,
+	recording bool, status reporter.Interface) error {
+	var err error
+
+	if brokerInfo.Bootstrap == nil {
+		status.Start("Creating SA for cluster")
+
+		brokerInfo.ClientToken, err = broker.CreateSAForCluster(brokerClientset, clusterID, brokerNamespace)
+
+		return status.Error(err, "Error creating SA for cluster")
+	}
+
+	status.Start("Exchanging the join token for the cluster's broker token")
+
+	// Exchanging the join token revokes it, so it's only done when the changes are applied
+	if recording {
+		status.Warning("The join token isn't exchanged when only printing the resources; the broker Secret holds a placeholder token")
+
+		brokerInfo.ClientToken = brokerInfo.ClientToken.DeepCopy()
+		brokerInfo.ClientToken.Data[broker.SecretTokenKey] = []byte(PlaceholderToken)
+
+		return nil
+	}
+
+	brokerInfo.ClientToken, err = broker.ExchangeBootstrapToken(brokerClientset, brokerInfo.Bootstrap, brokerNamespace)
+
+	return status.Error(err, "Error exchanging the join token")
+}
+
+// brokerProducer returns the Producer used to access the broker; when the cluster's changes are only recorded, so are
+// the broker's.
+func brokerProducer(clientProducer client.Producer, brokerAdminConfig *rest.Config) (client.Producer, error) {
+	if recording, ok := clientProducer.(*client.RecordingProducer); ok {
+		return recording.ForConfig(brokerAdminConfig)
+	}
+
+	return client.NewProducerFromRestConfig(brokerAdminConfig)
+}
+
+func reportBrokerChanges(brokerClientProducer client.Producer, status reporter.Interface) {
+	recording, ok := brokerClientProducer.(*client.RecordingProducer)
+	if !ok {
+		return
+	}
+
+	status.Start("Listing the changes to the Broker")
+
+	for _, obj := range recording.Objects() {
+		status.Warning("The %s %q would also be applied on the Broker, in namespace %q", obj.GetKind(), obj.GetName(),
+			obj.GetNamespace())
+	}
+}
+
 func submarinerOptionsFrom(joinOptions *Options) *deploy.SubmarinerOptions {
 	return &deploy.SubmarinerOptions{
 		PreferredServer:               joinOptions.PreferredServer,
@@ -19,29 +19,30 @@ limitations under the License.
 package join
 
 type Options struct {
-	PreferredServer               bool
-	ForceUDPEncaps                bool
-	NATTraversal                  bool
-	IgnoreRequirements            bool
-	GlobalnetEnabled              bool
-	IPSecDebug                    bool
-	SubmarinerDebug               bool
-	OperatorDebug                 bool
-	LoadBalancerEnabled           bool
-	HealthCheckEnabled            bool
-	NATTPort                      int
-	IKEPort                       int
-	GlobalnetClusterSize          uint
-	HealthCheckInterval           uint64
-	HealthCheckMaxPacketLossCount uint64
-	ClusterID                     string
-	ServiceCIDR                   string
-	ClusterCIDR                   string
-	GlobalnetCIDR                 string
-	Repository                    string
-	ImageVersion                  string
-	CableDriver                   string
-	CoreDNSCustomConfigMap        string
-	CustomDomains                 []string
-	ImageOverrideArr              []string
+	PreferredServer               bool     `json:"preferredServer,omitempty"`
+	ForceUDPEncaps                bool     `json:"forceUDPEncaps,omitempty"`
+	NATTraversal                  bool     `json:"natTraversal,omitempty"`
+	IgnoreRequirements            bool     `json:"ignoreRequirements,omitempty"`
+	ForceCRDUpdate                bool     `json:"forceCRDUpdate,omitempty"`
+	GlobalnetEnabled              bool     `json:"globalnetEnabled,omitempty"`
+	IPSecDebug                    bool     `json:"ipsecDebug,omitempty"`
+	SubmarinerDebug               bool     `json:"submarinerDebug,omitempty"`
+	OperatorDebug                 bool     `json:"operatorDebug,omitempty"`
+	LoadBalancerEnabled           bool     `json:"loadBalancerEnabled,omitempty"`
+	HealthCheckEnabled            bool     `json:"healthCheckEnabled,omitempty"`
+	NATTPort                      int      `json:"nattPort,omitempty"`
+	IKEPort                       int      `json:"ikePort,omitempty"`
+	GlobalnetClusterSize          uint     `json:"globalnetClusterSize,omitempty"`
+	HealthCheckInterval           uint64   `json:"healthCheckInterval,omitempty"`
+	HealthCheckMaxPacketLossCount uint64   `json:"healthCheckMaxPacketLossCount,omitempty"`
+	ClusterID                     string   `json:"clusterID,omitempty"`
+	ServiceCIDR                   string   `json:"serviceCIDR,omitempty"`
+	ClusterCIDR                   string   `json:"clusterCIDR,omitempty"`
+	GlobalnetCIDR                 string   `json:"globalnetCIDR,omitempty"`
+	Repository                    string   `json:"repository,omitempty"`
+	ImageVersion                  string   `json:"version,omitempty"`
+	CableDriver                   string   `json:"cableDriver,omitempty"`
+	CoreDNSCustomConfigMap        string   `json:"coreDNSCustomConfigMap,omitempty"`
+	CustomDomains                 []string `json:"customDomains,omitempty"`
+	ImageOverrideArr              []string `json:"imageOverrides,omitempty"`
 }
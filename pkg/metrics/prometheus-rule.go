@@ -0,0 +1,148 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	monclientv1 "github.com/coreos/prometheus-operator/pkg/client/versioned/typed/monitoring/v1"
+	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+var ErrPrometheusRuleNotPresent = fmt.Errorf("no PrometheusRule registered with the API")
+
+const PrometheusRuleName = "submariner-operator-alerts"
+
+// CreatePrometheusRule creates or updates the PrometheusRule containing the example Submariner alerts.
+// If CR PrometheusRule is not registered in the Cluster it will not attempt at creating it.
+func CreatePrometheusRule(config *rest.Config, ns string) (*monitoringv1.PrometheusRule, error) {
+	exists, err := hasPrometheusRule(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrPrometheusRuleNotPresent
+	}
+
+	ns, err = monitoringNamespace(config, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := monclientv1.NewForConfigOrDie(config).PrometheusRules(ns)
+	rule := GeneratePrometheusRule(ns)
+
+	existing, err := rules.Get(context.TODO(), rule.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		created, err := rules.Create(context.TODO(), rule, metav1.CreateOptions{})
+		return created, errors.Wrap(err, "error creating PrometheusRule")
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error retrieving PrometheusRule")
+	}
+
+	existing.Labels = rule.Labels
+	existing.Spec = rule.Spec
+
+	updated, err := rules.Update(context.TODO(), existing, metav1.UpdateOptions{})
+
+	return updated, errors.Wrap(err, "error updating PrometheusRule")
+}
+
+// GeneratePrometheusRule generates a prometheus-operator PrometheusRule with example alerts based on
+// the connection and reconciliation metrics exported by the operator. The thresholds are meant as
+// starting points and may need adjusting for a given deployment.
+func GeneratePrometheusRule(ns string) *monitoringv1.PrometheusRule {
+	return &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PrometheusRuleName,
+			Namespace: ns,
+			Labels: map[string]string{
+				"app":  "submariner-operator",
+				"role": "alert-rules",
+			},
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "submariner-connections",
+					Rules: []monitoringv1.Rule{
+						alert("SubmarinerNoGateways", "submariner_gateways == 0", "5m", "critical",
+							"No Submariner gateway is running, so there is no connectivity with other clusters."),
+						alert("SubmarinerConnectionError",
+							`sum by (local_cluster, remote_cluster) (submariner_requested_connections{status="error"}) > 0`,
+							"5m", "critical",
+							"The connection from cluster {{ $labels.local_cluster }} to cluster {{ $labels.remote_cluster }} is failing."),
+						alert("SubmarinerConnectionFlapping",
+							"sum by (local_cluster, remote_cluster) (increase(submariner_connection_state_transitions_total[30m])) > 6",
+							"", "warning",
+							"The connection from cluster {{ $labels.local_cluster }} to cluster {{ $labels.remote_cluster }}"+
+								" changed state {{ $value }} times in the last 30 minutes."),
+						alert("SubmarinerConnectionHighLatency", "submariner_connection_latency_average_seconds > 0.25", "10m", "warning",
+							"The average round-trip time from gateway {{ $labels.local_hostname }} to gateway {{ $labels.remote_hostname }}"+
+								" in cluster {{ $labels.remote_cluster }} is {{ $value | humanizeDuration }}."),
+					},
+				},
+				{
+					Name: "submariner-operator",
+					Rules: []monitoringv1.Rule{
+						alert("SubmarinerOperatorReconcileErrors",
+							"sum by (controller) (rate(submariner_operator_reconcile_errors_total[15m])) > 0", "15m", "warning",
+							"The Submariner operator's {{ $labels.controller }} controller has been failing to reconcile for 15 minutes."),
+						alert("SubmarinerOperatorSlowReconcile",
+							"histogram_quantile(0.9, sum by (controller, le) (rate(submariner_operator_reconcile_duration_seconds_bucket[15m])))"+
+								" > 30", "15m", "warning",
+							"The Submariner operator's {{ $labels.controller }} controller takes more than 30s to reconcile."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func alert(name, expr, duration, severity, description string) monitoringv1.Rule {
+	return monitoringv1.Rule{
+		Alert: name,
+		Expr:  intstr.FromString(expr),
+		For:   duration,
+		Labels: map[string]string{
+			"severity": severity,
+		},
+		Annotations: map[string]string{
+			"description": description,
+		},
+	}
+}
+
+// hasPrometheusRule checks if PrometheusRule is registered in the cluster.
+func hasPrometheusRule(config *rest.Config) (bool, error) {
+	dc := discovery.NewDiscoveryClientForConfigOrDie(config)
+
+	return k8sutil.ResourceExists(dc, "monitoring.coreos.com/v1", "PrometheusRule") // nolint:wrapcheck // No need to wrap here
+}
@@ -52,18 +52,9 @@ func CreateServiceMonitors(config *rest.Config, ns string, services []*v1.Servic
 		return nil, ErrServiceMonitorNotPresent
 	}
 
-	// On OpenShift, we need to create the service monitors in the OpenShift monitoring namespace, not the
-	// services; we need our own clientset rather than the manager's since the latter hasn't started yet
-	// (so its caching infrastructure isn't available, and reads fail)
-	cs, err := clientset.NewForConfig(config)
+	ns, err = monitoringNamespace(config, ns)
 	if err != nil {
-		return nil, errors.Wrap(err, "error getting kube client")
-	}
-
-	if _, err := cs.CoreV1().Namespaces().Get(context.TODO(), openshiftMonitoringNS, metav1.GetOptions{}); err == nil {
-		ns = openshiftMonitoringNS
-	} else if !apierrors.IsNotFound(err) {
-		log.Error(err, "Error checking for the OpenShift monitoring namespace")
+		return nil, err
 	}
 
 	serviceMonitors := make([]*monitoringv1.ServiceMonitor, len(services))
@@ -87,6 +78,25 @@ func CreateServiceMonitors(config *rest.Config, ns string, services []*v1.Servic
 	return serviceMonitors, nil
 }
 
+// monitoringNamespace returns the namespace in which monitoring resources should be created.
+func monitoringNamespace(config *rest.Config, ns string) (string, error) {
+	// On OpenShift, we need to create the monitoring resources in the OpenShift monitoring namespace, not the
+	// services; we need our own clientset rather than the manager's since the latter hasn't started yet
+	// (so its caching infrastructure isn't available, and reads fail)
+	cs, err := clientset.NewForConfig(config)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting kube client")
+	}
+
+	if _, err := cs.CoreV1().Namespaces().Get(context.TODO(), openshiftMonitoringNS, metav1.GetOptions{}); err == nil {
+		return openshiftMonitoringNS, nil
+	} else if !apierrors.IsNotFound(err) {
+		log.Error(err, "Error checking for the OpenShift monitoring namespace")
+	}
+
+	return ns, nil
+}
+
 // GenerateServiceMonitor generates a prometheus-operator ServiceMonitor object
 // based on the passed Service object.
 func GenerateServiceMonitor(ns string, s *v1.Service) *monitoringv1.ServiceMonitor {
@@ -0,0 +1,130 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reporter
+
+import (
+	"fmt"
+)
+
+type Level string
+
+const (
+	SuccessLevel Level = "success"
+	WarningLevel Level = "warning"
+	FailureLevel Level = "failure"
+)
+
+// Message is a single message reported during an operation.
+type Message struct {
+	Level Level  `json:"level"`
+	Text  string `json:"text"`
+}
+
+// Operation is an operation started by Start, along with the messages reported until it ended.
+type Operation struct {
+	Description string    `json:"description,omitempty"`
+	Result      Level     `json:"result"`
+	Messages    []Message `json:"messages,omitempty"`
+}
+
+// Collector is a reporter which records the operations and messages reported to it, for structured output.
+type Collector struct {
+	Operations []Operation
+	current    *Operation
+}
+
+func NewCollector() *Collector {
+	return &Collector{Operations: []Operation{}}
+}
+
+func (c *Collector) Start(message string, args ...interface{}) {
+	c.End()
+	c.current = &Operation{Description: fmt.Sprintf(message, args...), Messages: []Message{}}
+}
+
+func (c *Collector) End() {
+	if c.current == nil {
+		return
+	}
+
+	c.current.Result = SuccessLevel
+
+	for i := range c.current.Messages {
+		if c.current.Messages[i].Level == FailureLevel {
+			c.current.Result = FailureLevel
+			break
+		}
+
+		if c.current.Messages[i].Level == WarningLevel {
+			c.current.Result = WarningLevel
+		}
+	}
+
+	c.Operations = append(c.Operations, *c.current)
+	c.current = nil
+}
+
+func (c *Collector) Success(message string, args ...interface{}) {
+	c.add(SuccessLevel, message, args...)
+}
+
+func (c *Collector) Failure(message string, args ...interface{}) {
+	c.add(FailureLevel, message, args...)
+}
+
+func (c *Collector) Warning(message string, args ...interface{}) {
+	c.add(WarningLevel, message, args...)
+}
+
+func (c *Collector) Error(err error, message string, args ...interface{}) error {
+	return HandleError(c, err, message, args...)
+}
+
+// Result returns the most severe result of the recorded operations.
+func (c *Collector) Result() Level {
+	result := SuccessLevel
+
+	for i := range c.Operations {
+		switch c.Operations[i].Result {
+		case FailureLevel:
+			return FailureLevel
+		case WarningLevel:
+			result = WarningLevel
+		case SuccessLevel:
+		}
+	}
+
+	return result
+}
+
+func (c *Collector) add(level Level, message string, args ...interface{}) {
+	if message == "" {
+		return
+	}
+
+	text := fmt.Sprintf(message, args...)
+
+	// Messages reported outside an operation are recorded as operations of their own
+	if c.current == nil {
+		c.Operations = append(c.Operations, Operation{Description: text, Result: level})
+		return
+	}
+
+	c.current.Messages = append(c.current.Messages, Message{Level: level, Text: text})
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -75,3 +76,76 @@ func Update(dynClient dynamic.Interface, namespace, name string) (bool, error) {
 
 	return created, retryErr // nolint:wrapcheck // No need to wrap here
 }
+
+// RemoveUser removes the given service account from the privileged SCC, returning whether it was present.
+func RemoveUser(dynClient dynamic.Interface, namespace, name string) (bool, error) {
+	sccClient := dynClient.Resource(openshiftSCCGVR)
+	submarinerUser := fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)
+
+	removed := false
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		removed = false
+
+		cr, err := sccClient.Get(context.TODO(), "privileged", metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				return nil
+			}
+			return errors.Wrap(err, "error retrieving SCC resource")
+		}
+		users, found, err := unstructured.NestedStringSlice(cr.Object, "users")
+		if !found || err != nil {
+			return errors.Wrap(err, "error retrieving users field")
+		}
+
+		remaining := make([]interface{}, 0, len(users))
+		for _, user := range users {
+			if user == submarinerUser {
+				removed = true
+			} else {
+				remaining = append(remaining, user)
+			}
+		}
+
+		if !removed {
+			return nil
+		}
+
+		if err := unstructured.SetNestedSlice(cr.Object, remaining, "users"); err != nil {
+			return errors.Wrap(err, "error setting users field")
+		}
+
+		_, err = sccClient.Update(context.TODO(), cr, metav1.UpdateOptions{})
+
+		return errors.Wrap(err, "error updating OpenShift privileged SCC")
+	})
+
+	return removed, retryErr // nolint:wrapcheck // No need to wrap here
+}
+
+// HasUser returns whether the given service account is a user of the privileged SCC.
+func HasUser(dynClient dynamic.Interface, namespace, name string) (bool, error) {
+	cr, err := dynClient.Resource(openshiftSCCGVR).Get(context.TODO(), "privileged", metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, errors.Wrap(err, "error retrieving SCC resource")
+	}
+
+	users, _, err := unstructured.NestedStringSlice(cr.Object, "users")
+	if err != nil {
+		return false, errors.Wrap(err, "error retrieving users field")
+	}
+
+	submarinerUser := fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)
+
+	for _, user := range users {
+		if user == submarinerUser {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -23,7 +23,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -34,56 +34,21 @@ func init() {
 		Short: "Run all diagnostic checks (except those requiring two kubecontexts)",
 		Long:  "This command runs all diagnostic checks (except those requiring two kubecontexts) and reports any issues",
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, diagnoseAll)
+			if outputFormat == "" {
+				fmt.Printf("Skipping inter-cluster firewall check as it requires two kubeconfigs." +
+					" Please run \"subctl diagnose firewall inter-cluster\" command manually.\n\n")
+			}
+
+			runChecks(k8sVersionCheck, cniCheck, connectionsCheck, podsCheck, overlappingCIDRsCheck,
+				requiringSubmariner(kubeProxyModeCheck), requiringSubmariner(firewallMetricsCheck), firewallVxLANCheck)
 		},
 	})
 }
 
-func diagnoseAll(cluster *cmd.Cluster) bool {
-	success := checkK8sVersion(cluster)
-
-	fmt.Println()
-
-	status := cli.NewStatus()
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
-
-		return success
-	}
-
-	success = checkCNIConfig(cluster) && success
-
-	fmt.Println()
-
-	success = checkConnections(cluster) && success
-
-	fmt.Println()
-
-	success = checkPods(cluster) && success
-
-	fmt.Println()
-
-	success = checkOverlappingCIDRs(cluster) && success
-
-	fmt.Println()
-
-	success = checkKubeProxyMode(cluster) && success
-
-	fmt.Println()
-
-	success = checkFirewallMetricsConfig(cluster) && success
-
-	fmt.Println()
-
-	success = checkVxLANConfig(cluster) && success
-
-	fmt.Println()
-
-	fmt.Printf("Skipping inter-cluster firewall check as it requires two kubeconfigs." +
-		" Please run \"subctl diagnose firewall inter-cluster\" command manually.\n")
-
-	return success
+// requiringSubmariner returns a copy of the given check which is skipped on clusters where Submariner isn't installed.
+func requiringSubmariner(c check) check {
+	c.requiresSubmariner = true
+	return c
 }
 
 func getNumNodesOfCluster(cluster *cmd.Cluster) (int, error) {
@@ -95,15 +60,19 @@ func getNumNodesOfCluster(cluster *cmd.Cluster) (int, error) {
 	return len(nodes.Items), nil
 }
 
-func isClusterSingleNode(cluster *cmd.Cluster, status *cli.Status) bool {
+func isClusterSingleNode(cluster *cmd.Cluster, status reporter.Interface) bool {
 	numNodesOfCluster, err := getNumNodesOfCluster(cluster)
 	if err != nil {
-		status.EndWithFailure("Error listing the number of nodes of the cluster: %v", err)
+		status.Failure("Error listing the number of nodes of the cluster: %v", err)
+		status.End()
+
 		return true
 	}
 
 	if numNodesOfCluster == 1 {
-		status.EndWithSuccess("Skipping this check as it's a single node cluster.")
+		status.Success("Skipping this check as it's a single node cluster.")
+		status.End()
+
 		return true
 	}
 
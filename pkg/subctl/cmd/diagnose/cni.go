@@ -23,7 +23,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 	"github.com/submariner-io/submariner/pkg/routeagent_driver/constants"
@@ -50,21 +50,21 @@ func init() {
 		Short: "Check the CNI network plugin",
 		Long:  "This command checks if the detected CNI network plugin is supported by Submariner.",
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, checkCNIConfig)
+			runChecks(cniCheck)
 		},
 	})
 }
 
-func checkCNIConfig(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
-
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
-
-		return true
-	}
+var cniCheck = check{
+	id:       "cni",
+	severity: severityCritical,
+	remediation: "Use a CNI network plugin supported by Submariner; with Calico, create IPPools with disabled set to true" +
+		" for each remote cluster's CIDRs",
+	requiresSubmariner: true,
+	run:                checkCNIConfig,
+}
 
+func checkCNIConfig(cluster *cmd.Cluster, status *reporter.Tracker) bool {
 	status.Start("Checking Submariner support for the CNI network plugin")
 
 	isSupportedPlugin := false
@@ -77,14 +77,17 @@ func checkCNIConfig(cluster *cmd.Cluster) bool {
 	}
 
 	if !isSupportedPlugin {
-		status.EndWithFailure("The detected CNI network plugin (%q) is not supported by Submariner."+
+		status.Failure("The detected CNI network plugin (%q) is not supported by Submariner."+
 			" Supported network plugins: %v\n", cluster.Submariner.Status.NetworkPlugin, supportedNetworkPlugins)
+		status.End()
+
 		return false
 	}
 
-	status.EndWithSuccess("The detected CNI network plugin (%q) is supported", cluster.Submariner.Status.NetworkPlugin)
+	status.Success("The detected CNI network plugin (%q) is supported", cluster.Submariner.Status.NetworkPlugin)
+	status.End()
 
-	return checkCalicoIPPoolsIfCalicoCNI(cluster)
+	return checkCalicoIPPoolsIfCalicoCNI(cluster, status)
 }
 
 func detectCalicoConfigMap(clientSet kubernetes.Interface) (bool, error) {
@@ -102,14 +105,10 @@ func detectCalicoConfigMap(clientSet kubernetes.Interface) (bool, error) {
 	return false, nil
 }
 
-func checkCalicoIPPoolsIfCalicoCNI(info *cmd.Cluster) bool {
-	status := cli.NewStatus()
-
+func checkCalicoIPPoolsIfCalicoCNI(info *cmd.Cluster, status *reporter.Tracker) bool {
 	found, err := detectCalicoConfigMap(info.KubeClient)
 	if err != nil {
-		status.Start(fmt.Sprintf("Error trying to detect the Calico ConfigMap: %s", err))
-		status.EndWith(cli.Failure)
-
+		status.Failure("Error trying to detect the Calico ConfigMap: %s", err)
 		return false
 	}
 
@@ -121,12 +120,16 @@ func checkCalicoIPPoolsIfCalicoCNI(info *cmd.Cluster) bool {
 
 	gateways, err := info.GetGateways()
 	if err != nil {
-		status.EndWithFailure("Error retrieving Gateways: %v", err)
+		status.Failure("Error retrieving Gateways: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if len(gateways) == 0 {
-		status.EndWithWarning("There are no gateways detected on the cluster")
+		status.Warning("There are no gateways detected on the cluster")
+		status.End()
+
 		return false
 	}
 
@@ -134,12 +137,16 @@ func checkCalicoIPPoolsIfCalicoCNI(info *cmd.Cluster) bool {
 
 	ippoolList, err := client.List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
-		status.EndWithFailure("Error obtaining IPPools: %v", err)
+		status.Failure("Error obtaining IPPools: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if len(ippoolList.Items) < 1 {
-		status.EndWithFailure("Could not find any IPPools in the cluster")
+		status.Failure("Could not find any IPPools in the cluster")
+		status.End()
+
 		return false
 	}
 
@@ -148,12 +155,12 @@ func checkCalicoIPPoolsIfCalicoCNI(info *cmd.Cluster) bool {
 	for _, pool := range ippoolList.Items {
 		cidr, found, err := unstructured.NestedString(pool.Object, "spec", "cidr")
 		if err != nil {
-			status.QueueFailureMessage(fmt.Sprintf("Error extracting field cidr from IPPool %q", pool.GetName()))
+			status.Failure("Error extracting field cidr from IPPool %q", pool.GetName())
 			continue
 		}
 
 		if !found {
-			status.QueueFailureMessage(fmt.Sprintf("No CIDR found in IPPool %q", pool.GetName()))
+			status.Failure("No CIDR found in IPPool %q", pool.GetName())
 			continue
 		}
 
@@ -162,13 +169,13 @@ func checkCalicoIPPoolsIfCalicoCNI(info *cmd.Cluster) bool {
 
 	checkGatewaySubnets(gateways, ippools, status)
 
-	result := status.ResultFromMessages()
-	status.EndWith(result)
+	failed := status.HasFailures()
+	status.End()
 
-	return result != cli.Failure
+	return !failed
 }
 
-func checkGatewaySubnets(gateways []submv1.Gateway, ippools map[string]unstructured.Unstructured, status *cli.Status) {
+func checkGatewaySubnets(gateways []submv1.Gateway, ippools map[string]unstructured.Unstructured, status reporter.Interface) {
 	for i := range gateways {
 		gateway := &gateways[i]
 		if gateway.Status.HAStatus != submv1.HAStatusActive {
@@ -182,20 +189,20 @@ func checkGatewaySubnets(gateways []submv1.Gateway, ippools map[string]unstructu
 				if found {
 					isDisabled, err := getSpecBool(ipPool, "disabled")
 					if err != nil {
-						status.QueueFailureMessage(err.Error())
+						status.Failure("%s", err)
 						continue
 					}
 
 					// When disabled is set to true, Calico IPAM will not assign addresses from this Pool.
 					// The IPPools configured for Submariner remote CIDRs should have disabled as true.
 					if !isDisabled {
-						status.QueueFailureMessage(fmt.Sprintf("The IPPool %q with CIDR %q for remote endpoint"+
-							" %q has disabled set to false", ipPool.GetName(), subnet, connection.Endpoint.CableName))
+						status.Failure("The IPPool %q with CIDR %q for remote endpoint"+
+							" %q has disabled set to false", ipPool.GetName(), subnet, connection.Endpoint.CableName)
 						continue
 					}
 				} else {
-					status.QueueFailureMessage(fmt.Sprintf("Could not find any IPPool with CIDR %q for remote"+
-						" endpoint %q", subnet, connection.Endpoint.CableName))
+					status.Failure("Could not find any IPPool with CIDR %q for remote"+
+						" endpoint %q", subnet, connection.Endpoint.CableName)
 					continue
 				}
 			}
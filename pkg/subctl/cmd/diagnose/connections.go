@@ -18,10 +18,8 @@ limitations under the License.
 package diagnose
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 )
@@ -32,31 +30,34 @@ func init() {
 		Short: "Check the Gateway connections",
 		Long:  "This command checks that the Gateway connections to other clusters are all established",
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, checkConnections)
+			runChecks(connectionsCheck)
 		},
 	})
 }
 
-func checkConnections(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
-
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
-
-		return true
-	}
+var connectionsCheck = check{
+	id:                 "gateway-connections",
+	severity:           severityCritical,
+	remediation:        "Check the gateway pod logs, and run the firewall diagnostics to verify that tunnels can be established",
+	requiresSubmariner: true,
+	run:                checkConnections,
+}
 
+func checkConnections(cluster *cmd.Cluster, status *reporter.Tracker) bool {
 	status.Start("Checking gateway connections")
 
 	gateways, err := cluster.GetGateways()
 	if err != nil {
-		status.EndWithFailure("Error retrieving gateways: %v", err)
+		status.Failure("Error retrieving gateways: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if len(gateways) == 0 {
-		status.EndWithFailure("There are no gateways detected")
+		status.Failure("There are no gateways detected")
+		status.End()
+
 		return false
 	}
 
@@ -71,29 +72,30 @@ func checkConnections(cluster *cmd.Cluster) bool {
 		foundActive = true
 
 		if len(gateway.Status.Connections) == 0 {
-			status.QueueFailureMessage(fmt.Sprintf("There are no active connections on gateway %q", gateway.Name))
+			status.Failure("There are no active connections on gateway %q", gateway.Name)
 		}
 
 		for j := range gateway.Status.Connections {
 			connection := &gateway.Status.Connections[j]
 			if connection.Status == submv1.Connecting {
-				status.QueueFailureMessage(fmt.Sprintf("Connection to cluster %q is in progress", connection.Endpoint.ClusterID))
+				status.Failure("Connection to cluster %q is in progress", connection.Endpoint.ClusterID)
 			} else if connection.Status == submv1.ConnectionError {
-				status.QueueFailureMessage(fmt.Sprintf("Connection to cluster %q is not established", connection.Endpoint.ClusterID))
+				status.Failure("Connection to cluster %q is not established", connection.Endpoint.ClusterID)
 			}
 		}
 	}
 
 	if !foundActive {
-		status.QueueFailureMessage("No active gateway was found")
+		status.Failure("No active gateway was found")
 	}
 
-	if status.HasFailureMessages() {
-		status.EndWith(cli.Failure)
+	if status.HasFailures() {
+		status.End()
 		return false
 	}
 
-	status.EndWithSuccess("All connections are established")
+	status.Success("All connections are established")
+	status.End()
 
 	return true
 }
@@ -20,10 +20,12 @@ package diagnose
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
+	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 	"github.com/submariner-io/submariner/pkg/cidr"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,23 +38,28 @@ func init() {
 		Short: "Check the Submariner deployment",
 		Long:  "This command checks that the Submariner components are properly deployed and running with no overlapping CIDRs.",
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, func(cluster *cmd.Cluster) bool {
-				if cluster.Submariner == nil {
-					status := cli.NewStatus()
-					status.Start(cmd.SubmMissingMessage)
-					status.EndWith(cli.Warning)
-					return true
-				}
-
-				return checkOverlappingCIDRs(cluster) && checkPods(cluster)
-			})
+			runChecks(overlappingCIDRsCheck, podsCheck)
 		},
 	})
 }
 
-func checkOverlappingCIDRs(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+var overlappingCIDRsCheck = check{
+	id:                 "overlapping-cidrs",
+	severity:           severityCritical,
+	remediation:        "Enable Globalnet, or redeploy the clusters with non-overlapping CIDRs",
+	requiresSubmariner: true,
+	run:                checkOverlappingCIDRs,
+}
+
+var podsCheck = check{
+	id:                 "pods",
+	severity:           severityCritical,
+	remediation:        "Check the status, events and logs of the failing Submariner pods",
+	requiresSubmariner: true,
+	run:                checkPods,
+}
 
+func checkOverlappingCIDRs(cluster *cmd.Cluster, status *reporter.Tracker) bool {
 	if cluster.Submariner.Spec.GlobalCIDR != "" {
 		status.Start("Globalnet deployment detected - checking if globalnet CIDRs overlap")
 	} else {
@@ -62,57 +69,101 @@ func checkOverlappingCIDRs(cluster *cmd.Cluster) bool {
 	endpointList, err := cluster.SubmClient.SubmarinerV1().Endpoints(cluster.Submariner.Namespace).List(context.TODO(),
 		metav1.ListOptions{})
 	if err != nil {
-		status.EndWithFailure("Error listing the Submariner endpoints: %v", err)
+		status.Failure("Error listing the Submariner endpoints: %v", err)
+		status.End()
+
 		return false
 	}
 
-	for i := range endpointList.Items {
-		source := &endpointList.Items[i]
+	for _, problem := range findOverlappingCIDRs(endpointList.Items) {
+		status.Failure("%s", problem)
+	}
+
+	if status.HasFailures() {
+		status.End()
+		return false
+	}
+
+	if cluster.Submariner.Spec.GlobalCIDR != "" {
+		status.Success("Clusters do not have overlapping globalnet CIDRs")
+	} else {
+		status.Success("Clusters do not have overlapping CIDRs")
+	}
+
+	status.End()
+
+	return true
+}
+
+// findOverlappingCIDRs checks that the subnets of the endpoints in different clusters don't overlap, for each IP family,
+// and returns the problems found.
+func findOverlappingCIDRs(endpoints []submv1.Endpoint) []string {
+	var problems []string
+
+	for i := range endpoints {
+		source := &endpoints[i]
+		sourceSubnets, invalid := subnetsByFamily(source.Spec.Subnets)
+
+		for _, subnet := range invalid {
+			// Ideally this case will never hit, as the subnets are valid CIDRs
+			problems = append(problems, fmt.Sprintf("Error parsing CIDR %q in cluster %q", subnet, source.Spec.ClusterID))
+		}
 
-		destEndpoints := endpointList.Items[i+1:]
+		destEndpoints := endpoints[i+1:]
 		for j := range destEndpoints {
 			dest := &destEndpoints[j]
 
 			// Currently we dont support multiple endpoints in a cluster, hence return an error.
 			// When the corresponding support is added, this check needs to be updated.
 			if source.Spec.ClusterID == dest.Spec.ClusterID {
-				status.QueueFailureMessage(fmt.Sprintf("Found multiple Submariner endpoints (%q and %q) in cluster %q",
+				problems = append(problems, fmt.Sprintf("Found multiple Submariner endpoints (%q and %q) in cluster %q",
 					source.Name, dest.Name, source.Spec.ClusterID))
 				continue
 			}
 
-			for _, subnet := range dest.Spec.Subnets {
-				overlap, err := cidr.IsOverlapping(source.Spec.Subnets, subnet)
-				if err != nil {
-					// Ideally this case will never hit, as the subnets are valid CIDRs
-					status.QueueFailureMessage(fmt.Sprintf("Error parsing CIDR in cluster %q: %s", dest.Spec.ClusterID, err))
-					continue
-				}
+			destSubnets, _ := subnetsByFamily(dest.Spec.Subnets)
 
-				if overlap {
-					status.QueueFailureMessage(fmt.Sprintf("CIDR %q in cluster %q overlaps with cluster %q (CIDRs: %v)",
-						subnet, dest.Spec.ClusterID, source.Spec.ClusterID, source.Spec.Subnets))
+			for _, family := range ipFamilies {
+				for _, subnet := range destSubnets[family] {
+					// The subnets have already been parsed, so there can't be any error here
+					overlap, _ := cidr.IsOverlapping(sourceSubnets[family], subnet)
+					if overlap {
+						problems = append(problems, fmt.Sprintf("%s CIDR %q in cluster %q overlaps with cluster %q (CIDRs: %v)",
+							family, subnet, dest.Spec.ClusterID, source.Spec.ClusterID, sourceSubnets[family]))
+					}
 				}
 			}
 		}
 	}
 
-	if status.HasFailureMessages() {
-		status.EndWith(cli.Failure)
-		return false
-	}
+	return problems
+}
 
-	if cluster.Submariner.Spec.GlobalCIDR != "" {
-		status.EndWithSuccess("Clusters do not have overlapping globalnet CIDRs")
-	} else {
-		status.EndWithSuccess("Clusters do not have overlapping CIDRs")
+var ipFamilies = []string{"IPv4", "IPv6"}
+
+// subnetsByFamily sorts the given subnets by IP family, and also returns the invalid ones.
+func subnetsByFamily(subnets []string) (map[string][]string, []string) {
+	byFamily := map[string][]string{}
+
+	var invalid []string
+
+	for _, subnet := range subnets {
+		ip, _, err := net.ParseCIDR(subnet)
+
+		switch {
+		case err != nil:
+			invalid = append(invalid, subnet)
+		case ip.To4() != nil:
+			byFamily["IPv4"] = append(byFamily["IPv4"], subnet)
+		default:
+			byFamily["IPv6"] = append(byFamily["IPv6"], subnet)
+		}
 	}
 
-	return true
+	return byFamily, invalid
 }
 
-func checkPods(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func checkPods(cluster *cmd.Cluster, status *reporter.Tracker) bool {
 	status.Start("Checking Submariner pods")
 
 	checkDaemonset(cluster.KubeClient, cmd.OperatorNamespace, "submariner-gateway", status)
@@ -131,20 +182,21 @@ func checkPods(cluster *cmd.Cluster) bool {
 
 	checkPodsStatus(cluster.KubeClient, cmd.OperatorNamespace, status)
 
-	if status.HasFailureMessages() {
-		status.EndWith(cli.Failure)
+	if status.HasFailures() {
+		status.End()
 		return false
 	}
 
-	status.EndWithSuccess("All Submariner pods are up and running")
+	status.Success("All Submariner pods are up and running")
+	status.End()
 
 	return true
 }
 
-func checkDeployment(k8sClient kubernetes.Interface, namespace, deploymentName string, status *cli.Status) {
+func checkDeployment(k8sClient kubernetes.Interface, namespace, deploymentName string, status reporter.Interface) {
 	deployment, err := k8sClient.AppsV1().Deployments(namespace).Get(context.TODO(), deploymentName, metav1.GetOptions{})
 	if err != nil {
-		status.QueueFailureMessage(fmt.Sprintf("Error obtaining Deployment %q: %v", deploymentName, err))
+		status.Failure("Error obtaining Deployment %q: %v", deploymentName, err)
 		return
 	}
 
@@ -154,44 +206,44 @@ func checkDeployment(k8sClient kubernetes.Interface, namespace, deploymentName s
 	}
 
 	if deployment.Status.AvailableReplicas != replicas {
-		status.QueueFailureMessage(fmt.Sprintf("The desired number of replicas for Deployment %q (%d)"+
+		status.Failure("The desired number of replicas for Deployment %q (%d)"+
 			" does not match the actual number running (%d)", deploymentName, replicas,
-			deployment.Status.AvailableReplicas))
+			deployment.Status.AvailableReplicas)
 	}
 }
 
-func checkDaemonset(k8sClient kubernetes.Interface, namespace, daemonSetName string, status *cli.Status) {
+func checkDaemonset(k8sClient kubernetes.Interface, namespace, daemonSetName string, status reporter.Interface) {
 	daemonSet, err := k8sClient.AppsV1().DaemonSets(namespace).Get(context.TODO(), daemonSetName, metav1.GetOptions{})
 	if err != nil {
-		status.QueueFailureMessage(fmt.Sprintf("Error obtaining Daemonset %q: %v", daemonSetName, err))
+		status.Failure("Error obtaining Daemonset %q: %v", daemonSetName, err)
 		return
 	}
 
 	if daemonSet.Status.CurrentNumberScheduled != daemonSet.Status.DesiredNumberScheduled {
-		status.QueueFailureMessage(fmt.Sprintf("The desired number of running pods for DaemonSet %q (%d)"+
+		status.Failure("The desired number of running pods for DaemonSet %q (%d)"+
 			" does not match the actual number (%d)", daemonSetName, daemonSet.Status.DesiredNumberScheduled,
-			daemonSet.Status.CurrentNumberScheduled))
+			daemonSet.Status.CurrentNumberScheduled)
 	}
 }
 
-func checkPodsStatus(k8sClient kubernetes.Interface, namespace string, status *cli.Status) {
+func checkPodsStatus(k8sClient kubernetes.Interface, namespace string, status reporter.Interface) {
 	pods, err := k8sClient.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
-		status.QueueFailureMessage(fmt.Sprintf("Error obtaining Pods list: %v", err))
+		status.Failure("Error obtaining Pods list: %v", err)
 		return
 	}
 
 	for i := range pods.Items {
 		pod := &pods.Items[i]
 		if pod.Status.Phase != v1.PodRunning {
-			status.QueueFailureMessage(fmt.Sprintf("Pod %q is not running. (current state is %v)", pod.Name, pod.Status.Phase))
+			status.Failure("Pod %q is not running. (current state is %v)", pod.Name, pod.Status.Phase)
 			continue
 		}
 
 		for j := range pod.Status.ContainerStatuses {
 			c := &pod.Status.ContainerStatuses[j]
 			if c.RestartCount >= 5 {
-				status.QueueWarningMessage(fmt.Sprintf("Pod %q has restarted %d times", pod.Name, c.RestartCount))
+				status.Warning("Pod %q has restarted %d times", pod.Name, c.RestartCount)
 			}
 		}
 	}
@@ -0,0 +1,114 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnose
+
+import (
+	"reflect"
+	"testing"
+
+	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The diagnose package pulls in the e2e framework, whose suite setup needs a live cluster, so these aren't Ginkgo tests.
+func TestFindOverlappingCIDRs(t *testing.T) {
+	endpoint := func(name, clusterID string, subnets ...string) submv1.Endpoint {
+		return submv1.Endpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: submv1.EndpointSpec{
+				ClusterID: clusterID,
+				Subnets:   subnets,
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		endpoints []submv1.Endpoint
+		problems  []string
+	}{
+		{
+			name: "distinct IPv4 CIDRs",
+			endpoints: []submv1.Endpoint{
+				endpoint("east", "east", "10.0.0.0/16", "100.0.0.0/16"),
+				endpoint("west", "west", "10.1.0.0/16", "100.1.0.0/16"),
+			},
+		},
+		{
+			name: "overlapping IPv4 CIDRs",
+			endpoints: []submv1.Endpoint{
+				endpoint("east", "east", "10.0.0.0/16"),
+				endpoint("west", "west", "10.0.128.0/24"),
+			},
+			problems: []string{`IPv4 CIDR "10.0.128.0/24" in cluster "west" overlaps with cluster "east" (CIDRs: [10.0.0.0/16])`},
+		},
+		{
+			name: "distinct dual-stack CIDRs",
+			endpoints: []submv1.Endpoint{
+				endpoint("east", "east", "10.0.0.0/16", "fd00:10::/64"),
+				endpoint("west", "west", "10.1.0.0/16", "fd00:11::/64"),
+			},
+		},
+		{
+			name: "overlapping IPv6 CIDRs",
+			endpoints: []submv1.Endpoint{
+				endpoint("east", "east", "10.0.0.0/16", "fd00:10::/48"),
+				endpoint("west", "west", "10.1.0.0/16", "fd00:10:0:1::/64"),
+			},
+			problems: []string{`IPv6 CIDR "fd00:10:0:1::/64" in cluster "west" overlaps with cluster "east" (CIDRs: [fd00:10::/48])`},
+		},
+		{
+			name: "overlapping CIDRs in both families",
+			endpoints: []submv1.Endpoint{
+				endpoint("east", "east", "10.0.0.0/16", "fd00:10::/64"),
+				endpoint("west", "west", "fd00:10::/64", "10.0.0.0/16"),
+			},
+			problems: []string{
+				`IPv4 CIDR "10.0.0.0/16" in cluster "west" overlaps with cluster "east" (CIDRs: [10.0.0.0/16])`,
+				`IPv6 CIDR "fd00:10::/64" in cluster "west" overlaps with cluster "east" (CIDRs: [fd00:10::/64])`,
+			},
+		},
+		{
+			name: "invalid CIDR",
+			endpoints: []submv1.Endpoint{
+				endpoint("east", "east", "10.0.0.0/33"),
+				endpoint("west", "west", "10.1.0.0/16"),
+			},
+			problems: []string{`Error parsing CIDR "10.0.0.0/33" in cluster "east"`},
+		},
+		{
+			name: "multiple endpoints in a cluster",
+			endpoints: []submv1.Endpoint{
+				endpoint("east-1", "east", "10.0.0.0/16"),
+				endpoint("east-2", "east", "10.0.0.0/16"),
+			},
+			problems: []string{`Found multiple Submariner endpoints ("east-1" and "east-2") in cluster "east"`},
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			if problems := findOverlappingCIDRs(test.endpoints); !reflect.DeepEqual(problems, test.problems) {
+				t.Errorf("expected problems %q, got %q", test.problems, problems)
+			}
+		})
+	}
+}
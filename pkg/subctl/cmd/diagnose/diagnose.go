@@ -18,26 +18,86 @@ limitations under the License.
 package diagnose
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/submariner-io/submariner-operator/internal/restconfig"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 )
 
+type severity string
+
+const (
+	// A critical check failure prevents Submariner from working.
+	severityCritical severity = "critical"
+	// A warning check failure only affects auxiliary functionality.
+	severityWarning severity = "warning"
+)
+
+type checkResult string
+
+const (
+	checkPassed  checkResult = "passed"
+	checkWarning checkResult = "warning"
+	checkFailed  checkResult = "failed"
+	checkSkipped checkResult = "skipped"
+)
+
+// check is a diagnostic check; its ID is part of the machine-readable output and must not change.
+type check struct {
+	id                 string
+	severity           severity
+	remediation        string
+	requiresSubmariner bool
+	run                func(cluster *cmd.Cluster, status *reporter.Tracker) bool
+}
+
+type checkReport struct {
+	ID          string               `json:"id"`
+	Severity    severity             `json:"severity"`
+	Result      checkResult          `json:"result"`
+	Remediation string               `json:"remediation,omitempty"`
+	Operations  []reporter.Operation `json:"operations,omitempty"`
+}
+
+type clusterReport struct {
+	Name   string        `json:"name"`
+	Error  string        `json:"error,omitempty"`
+	Checks []checkReport `json:"checks"`
+}
+
+type diagnoseReport struct {
+	Clusters []clusterReport `json:"clusters"`
+}
+
 var (
 	podNamespace       string
 	verboseOutput      bool
+	outputFormat       string
 	restConfigProducer = restconfig.NewProducer()
 
 	diagnoseCmd = &cobra.Command{
 		Use:   "diagnose",
 		Short: "Run diagnostic checks on the Submariner deployment and report any issues",
 		Long:  "This command runs various diagnostic checks on the Submariner deployment and reports any issues",
+		PersistentPreRunE: func(command *cobra.Command, args []string) error {
+			if outputFormat != "" && outputFormat != cmd.JSONOutput && outputFormat != cmd.YAMLOutput {
+				return fmt.Errorf("unsupported output format %q, must be one of json or yaml", outputFormat)
+			}
+
+			return nil
+		},
 	}
 )
 
 func init() {
 	restConfigProducer.AddKubeConfigFlag(diagnoseCmd)
 	restConfigProducer.AddInClusterConfigFlag(diagnoseCmd)
+	cmd.AddMultiClusterFlags(diagnoseCmd)
+	diagnoseCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "",
+		"output format, one of json or yaml; human-readable text if unset")
 	cmd.AddToRootCommand(diagnoseCmd)
 }
 
@@ -49,3 +109,101 @@ func addNamespaceFlag(command *cobra.Command) {
 	command.Flags().StringVar(&podNamespace, "namespace", "default",
 		"namespace in which validation pods should be deployed")
 }
+
+// runChecks runs the given checks on all the selected clusters, and exits with an error if any of them failed.
+func runChecks(checks ...check) {
+	if outputFormat == "" {
+		cmd.ExecuteMultiCluster(restConfigProducer, func(_ context.Context, cluster *cmd.Cluster) bool {
+			return runChecksOn(cluster, checks)
+		})
+
+		return
+	}
+
+	cmd.ExecuteMultiClusterStructured(restConfigProducer, outputFormat,
+		func(_ context.Context, cluster *cmd.Cluster) (interface{}, bool) {
+			report := collectChecksOn(cluster, checks)
+			return report, !report.failed()
+		},
+		func(clusterName, message string) interface{} {
+			return clusterReport{Name: clusterName, Error: message, Checks: []checkReport{}}
+		})
+}
+
+// runChecksOn runs the given checks on a single cluster, reporting to the terminal.
+func runChecksOn(cluster *cmd.Cluster, checks []check) bool {
+	success := true
+	warnedMissingSubmariner := false
+
+	for i := range checks {
+		if i > 0 {
+			fmt.Fprintln(cluster.Out)
+		}
+
+		status := reporter.NewTracker(cluster.NewStatus())
+
+		if checks[i].requiresSubmariner && cluster.Submariner == nil {
+			if !warnedMissingSubmariner {
+				status.Warning(cmd.SubmMissingMessage)
+			}
+
+			warnedMissingSubmariner = true
+
+			continue
+		}
+
+		success = checks[i].run(cluster, status) && success
+	}
+
+	return success
+}
+
+func collectChecksOn(cluster *cmd.Cluster, checks []check) clusterReport {
+	report := clusterReport{Name: cluster.Name, Checks: []checkReport{}}
+
+	for i := range checks {
+		result := checkReport{
+			ID:          checks[i].id,
+			Severity:    checks[i].severity,
+			Remediation: checks[i].remediation,
+		}
+
+		if checks[i].requiresSubmariner && cluster.Submariner == nil {
+			result.Result = checkSkipped
+			result.Operations = []reporter.Operation{{Description: cmd.SubmMissingMessage, Result: reporter.WarningLevel}}
+			report.Checks = append(report.Checks, result)
+
+			continue
+		}
+
+		collector := reporter.NewCollector()
+		passed := checks[i].run(cluster, reporter.NewTracker(collector))
+		collector.End()
+
+		result.Operations = collector.Operations
+
+		switch {
+		case !passed:
+			result.Result = checkFailed
+		case collector.Result() != reporter.SuccessLevel:
+			result.Result = checkWarning
+		default:
+			result.Result = checkPassed
+			result.Remediation = ""
+		}
+
+		report.Checks = append(report.Checks, result)
+	}
+
+	return report
+}
+
+func (r *clusterReport) failed() bool {
+	for i := range r.Checks {
+		if r.Checks[i].Result == checkFailed {
+			return true
+		}
+	}
+
+	return false
+}
@@ -23,8 +23,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
 	"github.com/submariner-io/submariner-operator/internal/pods"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	subv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -84,12 +84,14 @@ func spawnPod(client kubernetes.Interface, scheduling pods.Scheduling, podName,
 	return pod, nil
 }
 
-func getActiveGatewayNodeName(cluster *cmd.Cluster, hostname string, status *cli.Status) string {
+func getActiveGatewayNodeName(cluster *cmd.Cluster, hostname string, status reporter.Interface) string {
 	nodes, err := cluster.KubeClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{
 		LabelSelector: "submariner.io/gateway=true",
 	})
 	if err != nil {
-		status.EndWithFailure("Error obtaining the Gateway Nodes in cluster %q: %v", cluster.Name, err)
+		status.Failure("Error obtaining the Gateway Nodes in cluster %q: %v", cluster.Name, err)
+		status.End()
+
 		return ""
 	}
 
@@ -104,14 +106,18 @@ func getActiveGatewayNodeName(cluster *cmd.Cluster, hostname string, status *cli
 		// tiny pod to read the hostname and return the corresponding node.
 		sPod, err := spawnSnifferPodOnNode(cluster.KubeClient, node.Name, "default", "hostname")
 		if err != nil {
-			status.EndWithFailure("Error spawning the sniffer pod on the node %q: %v", node.Name, err)
+			status.Failure("Error spawning the sniffer pod on the node %q: %v", node.Name, err)
+			status.End()
+
 			return ""
 		}
 
 		defer sPod.Delete()
 
 		if err = sPod.AwaitCompletion(); err != nil {
-			status.EndWithFailure("Error waiting for the sniffer pod to finish its execution on node %q: %v", node.Name, err)
+			status.Failure("Error waiting for the sniffer pod to finish its execution on node %q: %v", node.Name, err)
+			status.End()
+
 			return ""
 		}
 
@@ -120,16 +126,19 @@ func getActiveGatewayNodeName(cluster *cmd.Cluster, hostname string, status *cli
 		}
 	}
 
-	status.EndWithFailure("Could not find the active Gateway node %q in local cluster in cluster %q",
+	status.Failure("Could not find the active Gateway node %q in local cluster in cluster %q",
 		hostname, cluster.Name)
+	status.End()
 
 	return ""
 }
 
-func getLocalEndpointResource(cluster *cmd.Cluster, status *cli.Status) *subv1.Endpoint {
+func getLocalEndpointResource(cluster *cmd.Cluster, status reporter.Interface) *subv1.Endpoint {
 	endpoints, err := cluster.SubmClient.SubmarinerV1().Endpoints(cmd.OperatorNamespace).List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
-		status.EndWithFailure("Error obtaining the Endpoints in cluster %q: %v", cluster.Name, err)
+		status.Failure("Error obtaining the Endpoints in cluster %q: %v", cluster.Name, err)
+		status.End()
+
 		return nil
 	}
 
@@ -139,15 +148,18 @@ func getLocalEndpointResource(cluster *cmd.Cluster, status *cli.Status) *subv1.E
 		}
 	}
 
-	status.EndWithFailure("Could not find the local Endpoint in cluster %q", cluster.Name)
+	status.Failure("Could not find the local Endpoint in cluster %q", cluster.Name)
+	status.End()
 
 	return nil
 }
 
-func getAnyRemoteEndpointResource(cluster *cmd.Cluster, status *cli.Status) *subv1.Endpoint {
+func getAnyRemoteEndpointResource(cluster *cmd.Cluster, status reporter.Interface) *subv1.Endpoint {
 	endpoints, err := cluster.SubmClient.SubmarinerV1().Endpoints(cmd.OperatorNamespace).List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
-		status.EndWithFailure("Error obtaining the Endpoints in cluster %q: %v", cluster.Name, err)
+		status.Failure("Error obtaining the Endpoints in cluster %q: %v", cluster.Name, err)
+		status.End()
+
 		return nil
 	}
 
@@ -157,7 +169,8 @@ func getAnyRemoteEndpointResource(cluster *cmd.Cluster, status *cli.Status) *sub
 		}
 	}
 
-	status.EndWithFailure("Could not find any remote Endpoint in cluster %q", cluster.Name)
+	status.Failure("Could not find any remote Endpoint in cluster %q", cluster.Name)
+	status.End()
 
 	return nil
 }
@@ -22,7 +22,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 )
 
@@ -36,7 +36,7 @@ func init() {
 		Short: "Check firewall access to metrics",
 		Long:  "This command checks if the firewall configuration allows metrics to be accessed from the Gateway nodes.",
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, checkFirewallMetricsConfig)
+			runChecks(firewallMetricsCheck)
 		},
 	}
 
@@ -46,9 +46,14 @@ func init() {
 	diagnoseFirewallConfigCmd.AddCommand(command)
 }
 
-func checkFirewallMetricsConfig(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+var firewallMetricsCheck = check{
+	id:          "firewall-metrics",
+	severity:    severityWarning,
+	remediation: "Allow TCP/8080 traffic to the gateway nodes from the other nodes in the cluster",
+	run:         checkFirewallMetricsConfig,
+}
 
+func checkFirewallMetricsConfig(cluster *cmd.Cluster, status *reporter.Tracker) bool {
 	status.Start("Checking the firewall configuration to determine if the metrics port (8080) is allowed")
 
 	if isClusterSingleNode(cluster, status) {
@@ -60,7 +65,9 @@ func checkFirewallMetricsConfig(cluster *cmd.Cluster) bool {
 
 	sPod, err := spawnSnifferPodOnGatewayNode(cluster.KubeClient, podNamespace, podCommand)
 	if err != nil {
-		status.EndWithFailure("Error spawning the sniffer pod on the Gateway node: %v", err)
+		status.Failure("Error spawning the sniffer pod on the Gateway node: %v", err)
+		status.End()
+
 		return false
 	}
 
@@ -71,42 +78,50 @@ func checkFirewallMetricsConfig(cluster *cmd.Cluster) bool {
 
 	cPod, err := spawnClientPodOnNonGatewayNode(cluster.KubeClient, podNamespace, podCommand)
 	if err != nil {
-		status.EndWithFailure("Error spawning the client pod on non-Gateway node: %v", err)
+		status.Failure("Error spawning the client pod on non-Gateway node: %v", err)
+		status.End()
+
 		return false
 	}
 
 	defer cPod.Delete()
 
 	if err = cPod.AwaitCompletion(); err != nil {
-		status.EndWithFailure("Error waiting for the client pod to finish its execution: %v", err)
+		status.Failure("Error waiting for the client pod to finish its execution: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if err = sPod.AwaitCompletion(); err != nil {
-		status.EndWithFailure("Error waiting for the sniffer pod to finish its execution: %v", err)
+		status.Failure("Error waiting for the sniffer pod to finish its execution: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if verboseOutput {
-		status.QueueSuccessMessage("tcpdump output from sniffer pod on Gateway node")
-		status.QueueSuccessMessage(sPod.PodOutput)
+		status.Success("tcpdump output from sniffer pod on Gateway node")
+		status.Success("%s", sPod.PodOutput)
 	}
 
 	// Verify that tcpdump output (i.e, from snifferPod) contains the HostIP of clientPod
 	if !strings.Contains(sPod.PodOutput, cPod.Pod.Status.HostIP) {
-		status.EndWithFailure("The tcpdump output from the sniffer pod does not contain the"+
+		status.Failure("The tcpdump output from the sniffer pod does not contain the"+
 			" client pod HostIP. Please check that your firewall configuration allows TCP/8080 traffic"+
 			" on the %q node.", sPod.Pod.Spec.NodeName)
+		status.End()
 
 		return false
 	}
 
-	if status.HasFailureMessages() {
-		status.EndWith(cli.Failure)
+	if status.HasFailures() {
+		status.End()
 		return false
 	}
 
-	status.EndWithSuccess("The firewall configuration allows metrics to be retrieved from Gateway nodes")
+	status.Success("The firewall configuration allows metrics to be retrieved from Gateway nodes")
+	status.End()
 
 	return true
 }
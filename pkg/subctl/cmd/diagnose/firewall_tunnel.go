@@ -24,8 +24,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
-	"github.com/submariner-io/submariner-operator/internal/cli"
 	"github.com/submariner-io/submariner-operator/internal/restconfig"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd/utils"
 	subv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
@@ -75,12 +75,6 @@ func validateTunnelConfig(command *cobra.Command, args []string) {
 	remoteCfg, err := remoteProducer.ForCluster()
 	utils.ExitOnError("The provided remote kubeconfig is invalid", err)
 
-	if !validateTunnelConfigAcrossClusters(localCfg, remoteCfg) {
-		os.Exit(1)
-	}
-}
-
-func validateTunnelConfigAcrossClusters(localCfg, remoteCfg *rest.Config) bool {
 	localCluster := newCluster(localCfg)
 
 	localCluster.Name = localCluster.Submariner.Spec.ClusterID
@@ -89,7 +83,34 @@ func validateTunnelConfigAcrossClusters(localCfg, remoteCfg *rest.Config) bool {
 
 	remoteCluster.Name = remoteCluster.Submariner.Spec.ClusterID
 
-	status := cli.NewStatus()
+	checks := []check{{
+		id:          "firewall-inter-cluster",
+		severity:    severityCritical,
+		remediation: "Allow UDP traffic on the tunnel port to the gateway nodes from the other clusters",
+		run: func(cluster *cmd.Cluster, status *reporter.Tracker) bool {
+			return validateTunnelConfigAcrossClusters(cluster, remoteCluster, status)
+		},
+	}}
+
+	if outputFormat != "" {
+		report := collectChecksOn(localCluster, checks)
+
+		err := cmd.PrintStructured(os.Stdout, outputFormat, &diagnoseReport{Clusters: []clusterReport{report}})
+		utils.ExitOnError("Error printing the diagnostic results", err)
+
+		if report.failed() {
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	if !runChecksOn(localCluster, checks) {
+		os.Exit(1)
+	}
+}
+
+func validateTunnelConfigAcrossClusters(localCluster, remoteCluster *cmd.Cluster, status *reporter.Tracker) bool {
 	status.Start(fmt.Sprintf("Checking if tunnels can be setup on the gateway node of cluster %q", localCluster.Name))
 
 	if isClusterSingleNode(remoteCluster, status) {
@@ -118,7 +139,9 @@ func validateTunnelConfigAcrossClusters(localCfg, remoteCfg *rest.Config) bool {
 
 	sPod, err := spawnSnifferPodOnNode(localCluster.KubeClient, gwNodeName, podNamespace, podCommand)
 	if err != nil {
-		status.EndWithFailure("Error spawning the sniffer pod on the Gateway node: %v", err)
+		status.Failure("Error spawning the sniffer pod on the Gateway node: %v", err)
+		status.End()
+
 		return false
 	}
 
@@ -126,7 +149,9 @@ func validateTunnelConfigAcrossClusters(localCfg, remoteCfg *rest.Config) bool {
 
 	gatewayPodIP := getGatewayIP(remoteCluster, localCluster.Name, status)
 	if gatewayPodIP == "" {
-		status.EndWithFailure("Error retrieving the gateway IP of cluster %q", localCluster.Name)
+		status.Failure("Error retrieving the gateway IP of cluster %q", localCluster.Name)
+		status.End()
+
 		return false
 	}
 
@@ -137,37 +162,45 @@ func validateTunnelConfigAcrossClusters(localCfg, remoteCfg *rest.Config) bool {
 	// sometimes drop the udp traffic from client pod until the tunnels are properly setup.
 	cPod, err := spawnClientPodOnNonGatewayNode(remoteCluster.KubeClient, podNamespace, podCommand)
 	if err != nil {
-		status.EndWithFailure("Error spawning the client pod on non-Gateway node of cluster %q: %v",
+		status.Failure("Error spawning the client pod on non-Gateway node of cluster %q: %v",
 			remoteCluster.Name, err)
+		status.End()
+
 		return false
 	}
 
 	defer cPod.Delete()
 
 	if err = cPod.AwaitCompletion(); err != nil {
-		status.EndWithFailure("Error waiting for the client pod to finish its execution: %v", err)
+		status.Failure("Error waiting for the client pod to finish its execution: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if err = sPod.AwaitCompletion(); err != nil {
-		status.EndWithFailure("Error waiting for the sniffer pod to finish its execution: %v", err)
+		status.Failure("Error waiting for the sniffer pod to finish its execution: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if verboseOutput {
-		status.QueueSuccessMessage("tcpdump output from sniffer pod on Gateway node")
-		status.QueueSuccessMessage(sPod.PodOutput)
+		status.Success("tcpdump output from sniffer pod on Gateway node")
+		status.Success("%s", sPod.PodOutput)
 	}
 
 	if !strings.Contains(sPod.PodOutput, clientMessage) {
-		status.EndWithFailure("The tcpdump output from the sniffer pod does not include the message"+
+		status.Failure("The tcpdump output from the sniffer pod does not include the message"+
 			" sent from client pod. Please check that your firewall configuration allows UDP/%d traffic"+
 			" on the %q node.", tunnelPort, localEndpoint.Spec.Hostname)
+		status.End()
 
 		return false
 	}
 
-	status.EndWithSuccess("Tunnels can be established on the gateway node")
+	status.Success("Tunnels can be established on the gateway node")
+	status.End()
 
 	return true
 }
@@ -185,7 +218,7 @@ func newCluster(cfg *rest.Config) *cmd.Cluster {
 	return cluster
 }
 
-func getTunnelPort(submariner *v1alpha1.Submariner, endpoint *subv1.Endpoint, status *cli.Status) (int32, bool) {
+func getTunnelPort(submariner *v1alpha1.Submariner, endpoint *subv1.Endpoint, status reporter.Interface) (int32, bool) {
 	var tunnelPort int32
 	var err error
 
@@ -193,26 +226,30 @@ func getTunnelPort(submariner *v1alpha1.Submariner, endpoint *subv1.Endpoint, st
 	case "libreswan", "wireguard":
 		tunnelPort, err = endpoint.Spec.GetBackendPort(subv1.UDPPortConfig, int32(submariner.Spec.CeIPSecNATTPort))
 		if err != nil {
-			status.QueueWarningMessage(fmt.Sprintf("Error reading tunnel port: %v", err))
+			status.Warning("Error reading tunnel port: %v", err)
 		}
 
 		return tunnelPort, true
 	default:
-		status.QueueFailureMessage(fmt.Sprintf("Could not determine the tunnel port for cable driver %q",
-			endpoint.Spec.Backend))
+		status.Failure("Could not determine the tunnel port for cable driver %q",
+			endpoint.Spec.Backend)
 		return tunnelPort, false
 	}
 }
 
-func getGatewayIP(cluster *cmd.Cluster, localClusterID string, status *cli.Status) string {
+func getGatewayIP(cluster *cmd.Cluster, localClusterID string, status reporter.Interface) string {
 	gateways, err := cluster.GetGateways()
 	if err != nil {
-		status.EndWithFailure("Error retrieving gateways from cluster %q: %v", cluster.Name, err)
+		status.Failure("Error retrieving gateways from cluster %q: %v", cluster.Name, err)
+		status.End()
+
 		return ""
 	}
 
 	if len(gateways) == 0 {
-		status.EndWithFailure("There are no gateways detected on cluster %q", cluster.Name)
+		status.Failure("There are no gateways detected on cluster %q", cluster.Name)
+		status.End()
+
 		return ""
 	}
 
@@ -238,8 +275,9 @@ func getGatewayIP(cluster *cmd.Cluster, localClusterID string, status *cli.Statu
 		}
 	}
 
-	status.EndWithFailure("The gateway on cluster %q does not have an active connection to cluster %q",
+	status.Failure("The gateway on cluster %q does not have an active connection to cluster %q",
 		cluster.Name, localClusterID)
+	status.End()
 
 	return ""
 }
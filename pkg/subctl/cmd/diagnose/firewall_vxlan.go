@@ -22,7 +22,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 )
 
@@ -36,7 +36,7 @@ func init() {
 		Short: "Check firewall access for intra-cluster Submariner VxLAN traffic",
 		Long:  "This command checks if the firewall configuration allows traffic over vx-submariner interface.",
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, checkVxLANConfig)
+			runChecks(firewallVxLANCheck)
 		},
 	}
 
@@ -45,16 +45,15 @@ func init() {
 	diagnoseFirewallConfigCmd.AddCommand(command)
 }
 
-func checkVxLANConfig(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
-
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
-
-		return true
-	}
+var firewallVxLANCheck = check{
+	id:                 "firewall-intra-cluster",
+	severity:           severityCritical,
+	remediation:        "Allow UDP/4800 traffic between the nodes in the cluster",
+	requiresSubmariner: true,
+	run:                checkVxLANConfig,
+}
 
+func checkVxLANConfig(cluster *cmd.Cluster, status *reporter.Tracker) bool {
 	status.Start("Checking the firewall configuration to determine if VXLAN traffic is allowed")
 
 	if isClusterSingleNode(cluster, status) {
@@ -64,19 +63,20 @@ func checkVxLANConfig(cluster *cmd.Cluster) bool {
 
 	checkFWConfig(cluster, status)
 
-	if status.HasFailureMessages() {
-		status.EndWith(cli.Failure)
+	if status.HasFailures() {
+		status.End()
 		return false
 	}
 
-	status.EndWithSuccess("The firewall configuration allows VXLAN traffic")
+	status.Success("The firewall configuration allows VXLAN traffic")
+	status.End()
 
 	return true
 }
 
-func checkFWConfig(cluster *cmd.Cluster, status *cli.Status) {
+func checkFWConfig(cluster *cmd.Cluster, status reporter.Interface) {
 	if cluster.Submariner.Status.NetworkPlugin == "OVNKubernetes" {
-		status.QueueSuccessMessage("This check is not necessary for the OVNKubernetes CNI plugin")
+		status.Success("This check is not necessary for the OVNKubernetes CNI plugin")
 		return
 	}
 
@@ -99,7 +99,9 @@ func checkFWConfig(cluster *cmd.Cluster, status *cli.Status) {
 
 	sPod, err := spawnSnifferPodOnNode(cluster.KubeClient, gwNodeName, podNamespace, podCommand)
 	if err != nil {
-		status.EndWithFailure("Error spawning the sniffer pod on the Gateway node: %v", err)
+		status.Failure("Error spawning the sniffer pod on the Gateway node: %v", err)
+		status.End()
+
 		return
 	}
 
@@ -110,38 +112,38 @@ func checkFWConfig(cluster *cmd.Cluster, status *cli.Status) {
 
 	cPod, err := spawnClientPodOnNonGatewayNode(cluster.KubeClient, podNamespace, podCommand)
 	if err != nil {
-		status.QueueFailureMessage(fmt.Sprintf("Error spawning the client pod on non-Gateway node: %v", err))
+		status.Failure("Error spawning the client pod on non-Gateway node: %v", err)
 		return
 	}
 
 	defer cPod.Delete()
 
 	if err = cPod.AwaitCompletion(); err != nil {
-		status.QueueFailureMessage(fmt.Sprintf("Error waiting for the client pod to finish its execution: %v", err))
+		status.Failure("Error waiting for the client pod to finish its execution: %v", err)
 		return
 	}
 
 	if err = sPod.AwaitCompletion(); err != nil {
-		status.QueueFailureMessage(fmt.Sprintf("Error waiting for the sniffer pod to finish its execution: %v", err))
+		status.Failure("Error waiting for the sniffer pod to finish its execution: %v", err)
 		return
 	}
 
 	if verboseOutput {
-		status.QueueSuccessMessage("tcpdump output from the sniffer pod on Gateway node")
-		status.QueueSuccessMessage(sPod.PodOutput)
+		status.Success("tcpdump output from the sniffer pod on Gateway node")
+		status.Success("%s", sPod.PodOutput)
 	}
 
 	// Verify that tcpdump output (i.e, from snifferPod) contains the remoteClusterIP
 	if !strings.Contains(sPod.PodOutput, remoteClusterIP) {
-		status.QueueFailureMessage(fmt.Sprintf("The tcpdump output from the sniffer pod does not contain the expected remote"+
-			" endpoint IP %s. Please check that your firewall configuration allows UDP/4800 traffic.", remoteClusterIP))
+		status.Failure("The tcpdump output from the sniffer pod does not contain the expected remote"+
+			" endpoint IP %s. Please check that your firewall configuration allows UDP/4800 traffic.", remoteClusterIP)
 		return
 	}
 
 	// Verify that tcpdump output (i.e, from snifferPod) contains the clientPod IPaddress
 	if !strings.Contains(sPod.PodOutput, cPod.Pod.Status.PodIP) {
-		status.QueueFailureMessage(fmt.Sprintf("The tcpdump output from the sniffer pod does not contain the client pod's IP."+
-			" There seems to be some issue with the IPTable rules programmed on the %q node", cPod.Pod.Spec.NodeName))
+		status.Failure("The tcpdump output from the sniffer pod does not contain the client pod's IP."+
+			" There seems to be some issue with the IPTable rules programmed on the %q node", cPod.Pod.Spec.NodeName)
 		return
 	}
 }
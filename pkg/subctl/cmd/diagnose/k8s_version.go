@@ -19,8 +19,8 @@ package diagnose
 
 import (
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
 	"github.com/submariner-io/submariner-operator/pkg/client"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	"github.com/submariner-io/submariner-operator/pkg/version"
 )
@@ -31,38 +31,48 @@ func init() {
 		Short: "Check the Kubernetes version",
 		Long:  "This command checks if Submariner can be deployed on the Kubernetes version.",
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, checkK8sVersion)
+			runChecks(k8sVersionCheck)
 		},
 	})
 }
 
-func checkK8sVersion(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+var k8sVersionCheck = check{
+	id:          "k8s-version",
+	severity:    severityCritical,
+	remediation: "Upgrade the cluster to a Kubernetes version supported by Submariner",
+	run:         checkK8sVersion,
+}
 
+func checkK8sVersion(cluster *cmd.Cluster, status *reporter.Tracker) bool {
 	status.Start("Checking Submariner support for the Kubernetes version")
 
 	clientProducer, err := client.NewProducerFromRestConfig(cluster.Config)
 	if err != nil {
-		status.EndWithFailure(err.Error())
+		status.Failure("%s", err)
+		status.End()
+
 		return false
 	}
 
 	k8sVersion, failedRequirements, err := version.CheckRequirements(clientProducer.ForKubernetes())
 	if err != nil {
-		status.EndWithFailure(err.Error())
+		status.Failure("%s", err)
+		status.End()
+
 		return false
 	}
 
 	for i := range failedRequirements {
-		status.QueueFailureMessage(failedRequirements[i])
+		status.Failure("%s", failedRequirements[i])
 	}
 
-	if status.HasFailureMessages() {
-		status.EndWith(cli.Failure)
+	if status.HasFailures() {
+		status.End()
 		return false
 	}
 
-	status.EndWithSuccess("Kubernetes version %q is supported", k8sVersion)
+	status.Success("Kubernetes version %q is supported", k8sVersion)
+	status.End()
 
 	return true
 }
@@ -21,8 +21,8 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
 	"github.com/submariner-io/submariner-operator/internal/pods"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 )
 
@@ -37,7 +37,7 @@ func init() {
 		Short: "Check the kube-proxy mode",
 		Long:  "This command checks if the kube-proxy mode is supported by Submariner.",
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, checkKubeProxyMode)
+			runChecks(kubeProxyModeCheck)
 		},
 	}
 
@@ -45,8 +45,14 @@ func init() {
 	diagnoseCmd.AddCommand(command)
 }
 
-func checkKubeProxyMode(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+var kubeProxyModeCheck = check{
+	id:          "kube-proxy-mode",
+	severity:    severityCritical,
+	remediation: "Switch kube-proxy to iptables mode",
+	run:         checkKubeProxyMode,
+}
+
+func checkKubeProxyMode(cluster *cmd.Cluster, status *reporter.Tracker) bool {
 	status.Start("Checking Submariner support for the kube-proxy mode")
 
 	scheduling := pods.Scheduling{ScheduleOn: pods.GatewayNode, Networking: pods.HostNetworking}
@@ -59,18 +65,20 @@ func checkKubeProxyMode(cluster *cmd.Cluster) bool {
 		Command:    kubeProxyIPVSIfaceCommand,
 	})
 	if err != nil {
-		status.EndWithFailure("Error spawning the network pod: %v", err)
+		status.Failure("Error spawning the network pod: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if strings.Contains(podOutput, missingInterface) {
-		status.QueueSuccessMessage("The kube-proxy mode is supported")
+		status.Success("The kube-proxy mode is supported")
 	} else {
-		status.QueueFailureMessage("The cluster is deployed with kube-proxy ipvs mode which Submariner does not support")
+		status.Failure("The cluster is deployed with kube-proxy ipvs mode which Submariner does not support")
 	}
 
-	result := status.ResultFromMessages()
-	status.EndWith(result)
+	failed := status.HasFailures()
+	status.End()
 
-	return result != cli.Failure
+	return !failed
 }
@@ -18,12 +18,21 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	goerrors "errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
 	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/internal/cli"
 	"github.com/submariner-io/submariner-operator/internal/restconfig"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd/utils"
 	submarinerv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
@@ -33,6 +42,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
 )
 
 type Cluster struct {
@@ -42,12 +52,36 @@ type Cluster struct {
 	DynClient  dynamic.Interface
 	SubmClient subClientsetv1.Interface
 	Submariner *v1alpha1.Submariner
+	// Out and ErrOut are where output about the cluster should be written; they are buffered
+	// when clusters are processed in parallel.
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+const (
+	JSONOutput = "json"
+	YAMLOutput = "yaml"
+)
+
+var (
+	parallelClusters int
+	clusterTimeout   time.Duration
+)
+
+// AddMultiClusterFlags adds the flags controlling how ExecuteMultiCluster processes clusters.
+func AddMultiClusterFlags(command *cobra.Command) {
+	command.PersistentFlags().IntVar(&parallelClusters, "parallel", 1,
+		"maximum number of clusters to process concurrently; output is buffered per cluster when processing more than one")
+	command.PersistentFlags().DurationVar(&clusterTimeout, "cluster-timeout", 0,
+		"maximum time to spend processing each cluster, 0 for no limit")
 }
 
 func NewCluster(config *rest.Config, clusterName string) (*Cluster, string) {
 	cluster := &Cluster{
 		Config: config,
 		Name:   clusterName,
+		Out:    os.Stdout,
+		ErrOut: os.Stderr,
 	}
 
 	var err error
@@ -88,10 +122,62 @@ func (c *Cluster) GetGateways() ([]submarinerv1.Gateway, error) {
 	return gateways.Items, nil
 }
 
-func ExecuteMultiCluster(restConfigProducer restconfig.Producer, run func(*Cluster) bool) {
+func (c *Cluster) NewStatus() *cli.Status {
+	return cli.NewStatusTo(c.ErrOut)
+}
+
+type clusterResult struct {
+	name     string
+	output   string
+	success  bool
+	timedOut bool
+	// value is the information collected from the cluster, if any.
+	value interface{}
+	done  chan struct{}
+}
+
+type collectFunc func(ctx context.Context, cluster *Cluster) (interface{}, bool)
+
+// ExecuteMultiCluster runs the given function on all the selected clusters. The context passed to run is cancelled if the
+// cluster timeout expires; requests made through the cluster's clients fail once it is.
+func ExecuteMultiCluster(restConfigProducer restconfig.Producer, run func(ctx context.Context, cluster *Cluster) bool) {
+	configs := restConfigProducer.MustGetForClusters()
+
+	if parallelClusters <= 1 && clusterTimeout == 0 {
+		executeSerially(configs, run)
+		return
+	}
+
+	results := executeConcurrently(configs, func(ctx context.Context, cluster *Cluster) (interface{}, bool) {
+		return nil, run(ctx, cluster)
+	})
+
+	// Print the results in the requested order, as soon as each one is available
 	success := true
 
-	for _, config := range restConfigProducer.MustGetForClusters() {
+	for _, result := range results {
+		<-result.done
+
+		fmt.Printf("Cluster %q\n", result.name)
+		fmt.Print(result.output)
+		fmt.Println()
+
+		success = result.success && success
+	}
+
+	if len(results) > 1 {
+		printSummary(results)
+	}
+
+	if !success {
+		os.Exit(1)
+	}
+}
+
+func executeSerially(configs []restconfig.RestConfig, run func(ctx context.Context, cluster *Cluster) bool) {
+	success := true
+
+	for _, config := range configs {
 		fmt.Printf("Cluster %q\n", config.ClusterName)
 
 		cluster, errMsg := NewCluster(config.Config, config.ClusterName)
@@ -104,7 +190,7 @@ func ExecuteMultiCluster(restConfigProducer restconfig.Producer, run func(*Clust
 			continue
 		}
 
-		success = run(cluster) && success
+		success = run(context.Background(), cluster) && success
 
 		fmt.Println()
 	}
@@ -113,3 +199,190 @@ func ExecuteMultiCluster(restConfigProducer restconfig.Producer, run func(*Clust
 		os.Exit(1)
 	}
 }
+
+// executeConcurrently starts running on all the given clusters, with at most the requested number of clusters in
+// parallel; each result's done channel is closed once its cluster has been processed.
+func executeConcurrently(configs []restconfig.RestConfig, run collectFunc) []*clusterResult {
+	workerCount := parallelClusters
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	results := make([]*clusterResult, len(configs))
+	workers := make(chan struct{}, workerCount)
+
+	for i := range configs {
+		results[i] = &clusterResult{name: configs[i].ClusterName, done: make(chan struct{})}
+
+		go func(config restconfig.RestConfig, result *clusterResult) {
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			executeBuffered(config, run, result)
+			close(result.done)
+		}(configs[i], results[i])
+	}
+
+	return results
+}
+
+// executeBuffered runs on a single cluster, capturing its output; if the cluster doesn't complete within the cluster
+// timeout, its context is cancelled, and the cluster is considered failed once the run returns.
+func executeBuffered(config restconfig.RestConfig, run collectFunc, result *clusterResult) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if clusterTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, clusterTimeout)
+		defer cancel()
+	}
+
+	restConfig := rest.CopyConfig(config.Config)
+	if clusterTimeout > 0 {
+		restConfig.Timeout = clusterTimeout
+	}
+
+	restConfig.Wrap(func(delegate http.RoundTripper) http.RoundTripper {
+		return &contextRoundTripper{ctx: ctx, delegate: delegate}
+	})
+
+	output := &bytes.Buffer{}
+
+	cluster, errMsg := NewCluster(restConfig, config.ClusterName)
+	if cluster == nil {
+		fmt.Fprintln(output, errMsg)
+	} else {
+		cluster.Out = output
+		cluster.ErrOut = output
+		result.value, result.success = run(ctx, cluster)
+	}
+
+	result.output = output.String()
+
+	if goerrors.Is(ctx.Err(), context.DeadlineExceeded) {
+		result.success = false
+		result.timedOut = true
+		result.output += fmt.Sprintf("Timed out after %v\n", clusterTimeout)
+	}
+}
+
+// ExecuteMultiClusterStructured collects information from all the selected clusters, in parallel and with timeouts as
+// requested, and prints it as a single JSON or YAML document once all the clusters have been processed; failed produces
+// the information for clusters which couldn't be accessed or timed out. It exits with an error if any cluster failed.
+func ExecuteMultiClusterStructured(restConfigProducer restconfig.Producer, format string,
+	collect func(ctx context.Context, cluster *Cluster) (interface{}, bool), failed func(clusterName, message string) interface{}) {
+	results := executeConcurrently(restConfigProducer.MustGetForClusters(), collect)
+
+	output := struct {
+		Clusters []interface{} `json:"clusters"`
+	}{Clusters: make([]interface{}, len(results))}
+	success := true
+
+	for i, result := range results {
+		<-result.done
+
+		output.Clusters[i] = result.value
+
+		if result.timedOut || result.value == nil {
+			output.Clusters[i] = failed(result.name, strings.TrimSpace(result.output))
+		}
+
+		success = result.success && success
+	}
+
+	err := PrintStructured(os.Stdout, format, &output)
+	utils.ExitOnError("Error marshalling the cluster information", err)
+
+	if !success {
+		os.Exit(1)
+	}
+}
+
+// PrintStructured writes the given information to out, as YAML if requested, JSON otherwise.
+func PrintStructured(out io.Writer, format string, info interface{}) error {
+	var (
+		data []byte
+		err  error
+	)
+
+	if format == YAMLOutput {
+		data, err = yaml.Marshal(info)
+	} else {
+		data, err = json.MarshalIndent(info, "", "  ")
+		data = append(data, '\n')
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error marshalling the structured output")
+	}
+
+	_, err = out.Write(data)
+
+	return errors.Wrap(err, "error writing the structured output")
+}
+
+// contextRoundTripper aborts requests, including those in flight, once its context is done, so that runs which time out
+// stop promptly.
+type contextRoundTripper struct {
+	ctx      context.Context
+	delegate http.RoundTripper
+}
+
+func (c *contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err // nolint:wrapcheck // No need to wrap here
+	}
+
+	// The request is sent with a context which is cancelled by either the run's context or the request's own
+	ctx, cancel := context.WithCancel(req.Context())
+
+	go func() {
+		select {
+		case <-c.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	resp, err := c.delegate.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err // nolint:wrapcheck // No need to wrap here
+	}
+
+	// The body is read after RoundTrip returns, so the context is only released once the body is closed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close() // nolint:wrapcheck // No need to wrap here
+}
+
+func printSummary(results []*clusterResult) {
+	template := "%-40.39s%-10.9s\n"
+
+	fmt.Println("Summary")
+	fmt.Printf(template, "CLUSTER", "RESULT")
+
+	for _, result := range results {
+		outcome := "passed"
+
+		switch {
+		case result.timedOut:
+			outcome = "timed out"
+		case !result.success:
+			outcome = "failed"
+		}
+
+		fmt.Printf(template, result.name, outcome)
+	}
+}
@@ -26,7 +26,6 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
 	"github.com/submariner-io/submariner-operator/internal/component"
 	"github.com/submariner-io/submariner-operator/internal/exit"
 	"github.com/submariner-io/submariner-operator/internal/restconfig"
@@ -96,45 +95,37 @@ var gatherCmd = &cobra.Command{
 		"can be selected by component (%v) and type (%v). Default is to capture all data.",
 		strings.Join(getAllModuleKeys(), ","), strings.Join(getAllTypeKeys(), ",")),
 	Run: func(command *cobra.Command, args []string) {
-		cmd.ExecuteMultiCluster(restConfigProducer, gatherData)
-	},
-}
-
-func gatherData(cluster *cmd.Cluster) bool {
-	err := checkGatherArguments()
-	exit.OnErrorWithMessage(err, "Invalid arguments")
+		err := checkGatherArguments()
+		exit.OnErrorWithMessage(err, "Invalid arguments")
 
-	if directory == "" {
-		directory = "submariner-" + time.Now().UTC().Format("20060102150405") // submariner-YYYYMMDDHHMMSS
-	}
-
-	if _, err := os.Stat(directory); os.IsNotExist(err) {
-		err := os.MkdirAll(directory, 0o700)
-		if err != nil {
-			exit.OnErrorWithMessage(err, fmt.Sprintf("Error creating directory %q", directory))
+		if directory == "" {
+			directory = "submariner-" + time.Now().UTC().Format("20060102150405") // submariner-YYYYMMDDHHMMSS
 		}
-	}
 
-	gatherDataByCluster(cluster, directory)
+		err = os.MkdirAll(directory, 0o700)
+		exit.OnErrorWithMessage(err, fmt.Sprintf("Error creating directory %q", directory))
 
-	fmt.Printf("Files are stored under directory %q\n", directory)
+		cmd.ExecuteMultiCluster(restConfigProducer, func(ctx context.Context, cluster *cmd.Cluster) bool {
+			return gatherDataByCluster(ctx, cluster, directory)
+		})
 
-	return true
+		fmt.Printf("Files are stored under directory %q\n", directory)
+	},
 }
 
-func gatherDataByCluster(cluster *cmd.Cluster, directory string) {
+func gatherDataByCluster(ctx context.Context, cluster *cmd.Cluster, directory string) bool {
 	var err error
 	clusterName := cluster.Name
-	status := cli.NewReporter()
+	status := cluster.NewStatus()
 
 	clientProducer, err := client.NewProducerFromRestConfig(cluster.Config)
 	if err != nil {
 		status.Failure("Error creating client producer")
 
-		return
+		return false
 	}
 
-	fmt.Printf("Gathering information from cluster %q\n", clusterName)
+	fmt.Fprintf(cluster.Out, "Gathering information from cluster %q\n", clusterName)
 
 	info := Info{
 		RestConfig:           cluster.Config,
@@ -147,13 +138,13 @@ func gatherDataByCluster(cluster *cmd.Cluster, directory string) {
 	}
 
 	info.ServiceDiscovery, err = clientProducer.ForOperator().SubmarinerV1alpha1().ServiceDiscoveries(cmd.OperatorNamespace).
-		Get(context.TODO(), names.ServiceDiscoveryCrName, metav1.GetOptions{})
+		Get(ctx, names.ServiceDiscoveryCrName, metav1.GetOptions{})
 	if err != nil {
 		info.ServiceDiscovery = nil
 
 		if !apierrors.IsNotFound(err) {
 			status.Failure("Error getting ServiceDiscovery resource: %s", err)
-			return
+			return false
 		}
 	}
 
@@ -161,7 +152,7 @@ func gatherDataByCluster(cluster *cmd.Cluster, directory string) {
 		if ok {
 			for dataType, ok := range gatherTypeFlags {
 				if ok {
-					info.Status = cli.NewReporter()
+					info.Status = cluster.NewStatus()
 					info.Status.Start("Gathering %s %s", module, dataType)
 
 					if gatherFuncs[module](dataType, info) {
@@ -172,7 +163,10 @@ func gatherDataByCluster(cluster *cmd.Cluster, directory string) {
 		}
 	}
 
+	info.Status = status
 	gatherClusterSummary(&info)
+
+	return true
 }
 
 // nolint:gocritic // hugeParam: info - purposely passed by value.
@@ -233,7 +227,8 @@ func gatherDiscovery(dataType string, info Info) bool {
 func gatherBroker(dataType string, info Info) bool {
 	switch dataType {
 	case Resources:
-		brokerRestConfig, brokerNamespace, err := restconfig.ForBroker(info.Submariner, info.ServiceDiscovery)
+		brokerRestConfig, brokerNamespace, err := restconfig.ForBroker(info.ClientProducer.ForKubernetes(), info.Submariner,
+			info.ServiceDiscovery)
 		if err != nil {
 			info.Status.Failure("Error getting the broker's rest config: %s", err)
 			return true
@@ -26,6 +26,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/pkg/errors"
 	subctlversion "github.com/submariner-io/submariner-operator/pkg/version"
@@ -39,10 +40,23 @@ import (
 //go:embed layout.gohtml
 var layout string
 
+// summaryMutex serialises the clusters' writes to the shared summary file, since clusters can be gathered in parallel.
+var summaryMutex sync.Mutex
+
 func gatherClusterSummary(info *Info) {
 	dataGathered := getClusterInfo(info)
-	file := createFile(info.DirName)
-	writeToHTML(file, &dataGathered)
+
+	summaryMutex.Lock()
+	defer summaryMutex.Unlock()
+
+	file := createFile(info)
+	if file == nil {
+		return
+	}
+
+	defer file.Close()
+
+	writeToHTML(info, file, &dataGathered)
 }
 
 func getClusterInfo(info *Info) data {
@@ -51,7 +65,7 @@ func getClusterInfo(info *Info) data {
 
 	nConfig, err := getNodeConfig(info)
 	if err != nil {
-		fmt.Println(err)
+		info.Status.Warning("%s", err)
 	}
 
 	d := data{
@@ -69,17 +83,19 @@ func getClusterInfo(info *Info) data {
 func getClusterConfig(info *Info) clusterConfig {
 	gwNodes, err := getGWNodes(info)
 	if err != nil {
-		fmt.Println(err)
+		info.Status.Warning("%s", err)
 	}
 
 	mNodes, err := getMasterNodes(info)
 	if err != nil {
-		fmt.Println(err)
+		info.Status.Warning("%s", err)
 	}
 
 	allNodes, err := listNodes(info, metav1.ListOptions{})
 	if err != nil {
-		fmt.Println(err)
+		info.Status.Warning("%s", err)
+
+		allNodes = &v1.NodeList{}
 	}
 
 	config := clusterConfig{
@@ -107,12 +123,12 @@ func getVersions(info *Info) version {
 
 	k8sServerVersion, err := info.ClientProducer.ForKubernetes().Discovery().ServerVersion()
 	if err != nil {
-		fmt.Println("error in getting k8s server version", err)
+		info.Status.Warning("Error getting the Kubernetes server version: %s", err)
 		Versions.K8sServer = err.Error()
+	} else {
+		Versions.K8sServer = k8sServerVersion.String()
 	}
 
-	Versions.K8sServer = k8sServerVersion.String()
-
 	Versions.Subm = "Not installed"
 	if info.Submariner != nil {
 		Versions.Subm = info.Submariner.Spec.Version
@@ -216,22 +232,23 @@ func listNodes(info *Info, listOptions metav1.ListOptions) (*v1.NodeList, error)
 	return nodes, nil
 }
 
-func createFile(dirname string) io.Writer {
-	fileName := filepath.Join(dirname, "summary.html")
+func createFile(info *Info) *os.File {
+	fileName := filepath.Join(info.DirName, "summary.html")
 
 	f, err := os.OpenFile(fileName, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0o666)
 	if err != nil {
-		fmt.Printf("Error creating file %s\n", fileName)
+		info.Status.Failure("Error creating file %s: %s", fileName, err)
+		return nil
 	}
 
 	return f
 }
 
-func writeToHTML(fileWriter io.Writer, cData *data) {
+func writeToHTML(info *Info, fileWriter io.Writer, cData *data) {
 	t := template.Must(template.New("layout.html").Parse(layout))
 
 	err := t.Execute(fileWriter, cData)
 	if err != nil {
-		fmt.Println(err)
+		info.Status.Failure("Error writing the summary: %s", err)
 	}
 }
@@ -0,0 +1,167 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	operatorClientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd/utils"
+	"github.com/submariner-io/submariner-operator/pkg/subctl/operator/submarinercr"
+	"github.com/submariner-io/submariner-operator/pkg/uninstall"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var (
+	leaveClusterID string
+	leaveTimeout   time.Duration
+	leaveCmd       = &cobra.Command{
+		Use:     "leave <broker-info.subm>",
+		Aliases: []string{"unjoin"},
+		Short:   "Disconnect a cluster from the broker",
+		Long: "This command removes Submariner from the cluster, then removes the cluster from the broker: its Cluster and" +
+			" Endpoints, its globalnet CIDR and its broker service account",
+		Args: cobra.MaximumNArgs(1),
+		Run:  leaveBroker,
+	}
+)
+
+func init() {
+	leaveCmd.Flags().StringVar(&leaveClusterID, "clusterid", "",
+		"ID of the cluster to remove from the broker; defaults to the ID of the cluster in the current context")
+	leaveCmd.Flags().DurationVar(&leaveTimeout, "timeout", 5*time.Minute,
+		"how long to wait for Submariner to be removed from the cluster")
+	restConfigProducer.AddKubeContextFlag(leaveCmd)
+	rootCmd.AddCommand(leaveCmd)
+}
+
+func leaveBroker(cmd *cobra.Command, args []string) {
+	subctlData, kubeClient, submClient, brokerNamespace := brokerClientsFromArgs(args)
+
+	brokerAdminConfig, err := subctlData.GetBrokerAdministratorConfig()
+	utils.ExitOnError("Error retrieving broker admin config", err)
+
+	brokerOperatorClient, err := operatorClientset.NewForConfig(brokerAdminConfig)
+	utils.ExitOnError("Error retrieving broker admin connection", err)
+
+	clusterID := uninstallFromCluster()
+
+	status.Start(fmt.Sprintf("Removing cluster %q from the broker", clusterID))
+	err = broker.RemoveCluster(kubeClient, submClient, brokerOperatorClient, clusterID, brokerNamespace)
+	status.EndWith(cli.CheckForError(err))
+	utils.ExitOnError(fmt.Sprintf("Error removing cluster %q from the broker", clusterID), err)
+}
+
+// uninstallFromCluster deletes the Submariner and ServiceDiscovery resources in the current context, waits for the operator
+// to clean up, and returns the ID of the cluster.
+func uninstallFromCluster() string {
+	clientConfig, err := restConfigProducer.ClientConfig().ClientConfig()
+	utils.ExitOnError("Error connecting to the target cluster", err)
+
+	operatorClient, err := operatorClientset.NewForConfig(clientConfig)
+	utils.ExitOnError("Error connecting to the target cluster", err)
+
+	submariners := operatorClient.SubmarinerV1alpha1().Submariners(OperatorNamespace)
+	serviceDiscoveries := operatorClient.SubmarinerV1alpha1().ServiceDiscoveries(OperatorNamespace)
+
+	clusterID := leaveClusterID
+
+	submariner, err := submariners.Get(context.TODO(), submarinercr.SubmarinerName, metav1.GetOptions{})
+	if err == nil {
+		if clusterID != "" && clusterID != submariner.Spec.ClusterID {
+			utils.ExitWithErrorMsg(fmt.Sprintf("The cluster in the current context is %q, not %q", submariner.Spec.ClusterID,
+				clusterID))
+		}
+
+		clusterID = submariner.Spec.ClusterID
+	} else if !apierrors.IsNotFound(err) {
+		utils.ExitOnError("Error retrieving the Submariner resource", err)
+	}
+
+	serviceDiscovery, sdErr := serviceDiscoveries.Get(context.TODO(), names.ServiceDiscoveryCrName, metav1.GetOptions{})
+	if sdErr == nil && clusterID == "" {
+		clusterID = serviceDiscovery.Spec.ClusterID
+	} else if sdErr != nil && !apierrors.IsNotFound(sdErr) {
+		utils.ExitOnError("Error retrieving the ServiceDiscovery resource", sdErr)
+	}
+
+	if clusterID == "" {
+		utils.ExitWithErrorMsg(SubmMissingMessage + "; specify the ID of the cluster to remove with --clusterid")
+	}
+
+	if err != nil && sdErr != nil {
+		return clusterID
+	}
+
+	status.Start("Removing Submariner from the cluster")
+
+	err = submariners.Delete(context.TODO(), submarinercr.SubmarinerName, metav1.DeleteOptions{})
+	if err == nil || apierrors.IsNotFound(err) {
+		err = serviceDiscoveries.Delete(context.TODO(), names.ServiceDiscoveryCrName, metav1.DeleteOptions{})
+	}
+
+	if apierrors.IsNotFound(err) {
+		err = nil
+	}
+
+	if err == nil {
+		var (
+			remainingKind string
+			remaining     runtime.Object
+		)
+
+		err = wait.PollImmediate(time.Second, leaveTimeout, func() (bool, error) {
+			submariner, err := submariners.Get(context.TODO(), submarinercr.SubmarinerName, metav1.GetOptions{})
+			if err == nil || !apierrors.IsNotFound(err) {
+				remainingKind, remaining = "Submariner", submariner
+				return false, err // nolint:wrapcheck // No need to wrap here
+			}
+
+			serviceDiscovery, err := serviceDiscoveries.Get(context.TODO(), names.ServiceDiscoveryCrName, metav1.GetOptions{})
+			if err == nil || !apierrors.IsNotFound(err) {
+				remainingKind, remaining = "ServiceDiscovery", serviceDiscovery
+				return false, err // nolint:wrapcheck // No need to wrap here
+			}
+
+			return true, nil
+		})
+
+		if errors.Is(err, wait.ErrWaitTimeout) {
+			// Explain when the operator timed out too, and left the resource in place
+			err = uninstall.TimeoutError(remainingKind, remaining.(metav1.Object).GetName(), remaining)
+		}
+
+		err = errors.Wrap(err, "error waiting for Submariner to be removed")
+	}
+
+	status.EndWith(cli.CheckForError(err))
+	utils.ExitOnError("Error removing Submariner from the cluster", err)
+
+	return clusterID
+}
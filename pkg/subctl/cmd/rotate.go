@@ -0,0 +1,233 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/broker"
+	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd/utils"
+	"github.com/submariner-io/submariner-operator/pkg/subctl/datafile"
+	submarinerClientset "github.com/submariner-io/submariner/pkg/client/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	rotateCmd = &cobra.Command{
+		Use:   "rotate",
+		Short: "Rotate the credentials used by the connected clusters",
+		Long: "This command replaces credentials on the broker; the new credentials are synced to the connected clusters," +
+			" whose components are restarted to use them",
+	}
+	rotateBrokerCredentialsCmd = &cobra.Command{
+		Use:   "broker-credentials <broker-info.subm>",
+		Short: "Rotate the clusters' broker access tokens",
+		Long: "This command mints a new broker access token for each connected cluster, and revokes the previous tokens once" +
+			" every cluster's Endpoints on the broker have been refreshed with its new token, which the Submariner operator" +
+			" does once all the cluster's components using the token have restarted",
+		Args: cobra.MaximumNArgs(1),
+		Run:  rotateBrokerCredentials,
+	}
+	rotatePSKCmd = &cobra.Command{
+		Use:   "psk <broker-info.subm>",
+		Short: "Rotate the IPsec PSK",
+		Long: "This command generates a new IPsec PSK, distributes it to the connected clusters, and updates the given" +
+			" broker information file so that subsequently joined clusters use it. The gateways' restart is checked on each" +
+			" connected cluster, so the kubeconfig contexts of all the connected clusters must be available",
+		Args: cobra.MaximumNArgs(1),
+		Run:  rotatePSK,
+	}
+	rotateTimeout time.Duration
+)
+
+func init() {
+	rotateCmd.PersistentFlags().DurationVar(&rotateTimeout, "timeout", 5*time.Minute,
+		"how long to wait for each cluster to restart with the new credentials")
+	restConfigProducer.AddKubeContextMultiFlag(rotatePSKCmd, "comma-separated list of kubeconfig contexts of the connected clusters,"+
+		" can be specified multiple times.\nIf none specified, all contexts referenced by the kubeconfig are used")
+	rotateCmd.AddCommand(rotateBrokerCredentialsCmd)
+	rotateCmd.AddCommand(rotatePSKCmd)
+	rootCmd.AddCommand(rotateCmd)
+}
+
+func rotateBrokerCredentials(cmd *cobra.Command, args []string) {
+	_, kubeClient, submClient, brokerNamespace := brokerClientsFromArgs(args)
+	clusterIDs := brokerClusterIDs(submClient, brokerNamespace)
+	newTokens := map[string][]byte{}
+
+	for _, clusterID := range clusterIDs {
+		status.Start(fmt.Sprintf("Rotating the broker token for cluster %q", clusterID))
+		newToken, err := broker.RotateClusterToken(kubeClient, clusterID, brokerNamespace)
+		status.EndWith(cli.CheckForError(err))
+		utils.ExitOnError(fmt.Sprintf("Error rotating the broker token for cluster %q", clusterID), err)
+
+		newTokens[clusterID] = newToken.Data[broker.SecretTokenKey]
+	}
+
+	// The clusters restart concurrently, so waiting for each in turn doesn't add up their restart times
+	for _, clusterID := range clusterIDs {
+		status.Start(fmt.Sprintf("Waiting for the Endpoints of cluster %q to be refreshed with its new broker token", clusterID))
+		err := broker.WaitForEndpointsRefresh(submClient, clusterID, brokerNamespace, newTokens[clusterID], rotateTimeout)
+		status.EndWith(cli.CheckForError(err))
+		utils.ExitOnError(fmt.Sprintf("Error waiting for cluster %q to use its new broker token; the previous tokens have not"+
+			" been revoked", clusterID), err)
+	}
+
+	for _, clusterID := range clusterIDs {
+		status.Start(fmt.Sprintf("Revoking the previous broker tokens for cluster %q", clusterID))
+		err := broker.RevokeSupersededClusterTokens(kubeClient, clusterID, brokerNamespace)
+		status.EndWith(cli.CheckForError(err))
+		utils.ExitOnError(fmt.Sprintf("Error revoking the previous broker tokens for cluster %q", clusterID), err)
+	}
+}
+
+func rotatePSK(cmd *cobra.Command, args []string) {
+	subctlData, kubeClient, submClient, brokerNamespace := brokerClientsFromArgs(args)
+	gateways := connectedClusterGateways(brokerClusterIDs(submClient, brokerNamespace))
+
+	status.Start("Rotating the IPsec PSK")
+
+	pskSecret, err := broker.RotateIPSecPSK(kubeClient, brokerNamespace)
+	status.EndWith(cli.CheckForError(err))
+	utils.ExitOnError("Error rotating the IPsec PSK", err)
+
+	status.Start(fmt.Sprintf("Updating %s with the new IPsec PSK", args[0]))
+
+	newFilename, err := datafile.BackupIfExists(args[0])
+	if err == nil {
+		status.QueueSuccessMessage(fmt.Sprintf("Backed up previous %s to %s", args[0], newFilename))
+
+		subctlData.IPSecPSK = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: pskSecret.Name},
+			Data:       pskSecret.Data,
+		}
+		err = subctlData.WriteToFile(args[0])
+	}
+
+	status.EndWith(cli.CheckForError(err))
+	utils.ExitOnError(fmt.Sprintf("Error updating %s", args[0]), err)
+
+	waitForGatewaysToRestart(gateways)
+}
+
+func brokerClientsFromArgs(args []string) (*datafile.SubctlData, kubernetes.Interface, submarinerClientset.Interface, string) {
+	err := checkArgumentPassed(args)
+	utils.ExitOnError("Argument missing", err)
+
+	subctlData, err := datafile.NewFromFile(args[0])
+	utils.ExitOnError("Error loading the broker information from the given file", err)
+
+	brokerAdminConfig, err := subctlData.GetBrokerAdministratorConfig()
+	utils.ExitOnError("Error retrieving broker admin config", err)
+
+	kubeClient, err := kubernetes.NewForConfig(brokerAdminConfig)
+	utils.ExitOnError("Error retrieving broker admin connection", err)
+
+	submClient, err := submarinerClientset.NewForConfig(brokerAdminConfig)
+	utils.ExitOnError("Error retrieving broker admin connection", err)
+
+	return subctlData, kubeClient, submClient, string(subctlData.ClientToken.Data[broker.SecretNamespaceKey])
+}
+
+func brokerClusterIDs(submClient submarinerClientset.Interface, brokerNamespace string) []string {
+	clusters, err := submClient.SubmarinerV1().Clusters(brokerNamespace).List(context.TODO(), metav1.ListOptions{})
+	utils.ExitOnError("Error listing the clusters connected to the broker", err)
+
+	clusterIDs := []string{}
+	for i := range clusters.Items {
+		clusterIDs = append(clusterIDs, clusters.Items[i].Spec.ClusterID)
+	}
+
+	return clusterIDs
+}
+
+// clusterGateways identifies the gateway pods of a connected cluster, as they were before the credentials were rotated.
+type clusterGateways struct {
+	clusterID  string
+	kubeClient kubernetes.Interface
+	namespace  string
+	pods       map[types.UID]bool
+}
+
+// connectedClusterGateways finds the given clusters among the requested kubeconfig contexts, and records their current
+// gateway pods; every cluster must be found, otherwise the previous credentials could be revoked while still in use.
+func connectedClusterGateways(clusterIDs []string) []clusterGateways {
+	gateways := []clusterGateways{}
+	found := map[string]bool{}
+
+	for _, config := range restConfigProducer.MustGetForClusters() {
+		submariner, err := utils.GetSubmarinerResourceWithError(config.Config)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+
+		utils.ExitOnError(fmt.Sprintf("Error retrieving the Submariner resource on cluster %q", config.ClusterName), err)
+
+		if found[submariner.Spec.ClusterID] {
+			continue
+		}
+
+		kubeClient, err := kubernetes.NewForConfig(config.Config)
+		utils.ExitOnError(fmt.Sprintf("Error creating the Kubernetes client for cluster %q", config.ClusterName), err)
+
+		pods, err := broker.GatewayPods(kubeClient, submariner.Namespace)
+		utils.ExitOnError(fmt.Sprintf("Error retrieving the gateway pods on cluster %q", config.ClusterName), err)
+
+		found[submariner.Spec.ClusterID] = true
+		gateways = append(gateways, clusterGateways{
+			clusterID:  submariner.Spec.ClusterID,
+			kubeClient: kubeClient,
+			namespace:  submariner.Namespace,
+			pods:       pods,
+		})
+	}
+
+	missing := []string{}
+
+	for _, clusterID := range clusterIDs {
+		if !found[clusterID] {
+			missing = append(missing, clusterID)
+		}
+	}
+
+	if len(missing) > 0 {
+		utils.ExitWithErrorMsg(fmt.Sprintf("No kubeconfig context was found for the connected clusters %v; their gateways'"+
+			" restart can't be checked. Specify their contexts using --kubecontexts", missing))
+	}
+
+	return gateways
+}
+
+func waitForGatewaysToRestart(gateways []clusterGateways) {
+	for i := range gateways {
+		status.Start(fmt.Sprintf("Waiting for the gateways of cluster %q to restart with the new credentials", gateways[i].clusterID))
+		err := broker.WaitForGatewaysRestart(gateways[i].kubeClient, gateways[i].namespace, gateways[i].pods, rotateTimeout)
+		status.EndWith(cli.CheckForError(err))
+		utils.ExitOnError(fmt.Sprintf("Error waiting for the gateways of cluster %q to restart; the previous credentials have"+
+			" not been revoked", gateways[i].clusterID), err)
+	}
+}
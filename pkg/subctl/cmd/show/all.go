@@ -18,11 +18,7 @@ limitations under the License.
 package show
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
-	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 )
 
 func init() {
@@ -30,41 +26,11 @@ func init() {
 		Use:   "all",
 		Short: "Show information related to a submariner cluster",
 		Long: `This command shows information related to a submariner cluster:
-		      networks, endpoints, gateways, connections and component versions.`,
+		      networks, endpoints, gateways, connections, component versions and CRDs.`,
 		PreRunE: restConfigProducer.CheckVersionMismatch,
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, showAll)
+			runShow(connectionsSection, endpointsSection, gatewaysSection, networkSection, versionsSection,
+				crdsSection)
 		},
 	})
 }
-
-func showAll(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
-
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
-
-		return true
-	}
-
-	success := showConnections(cluster)
-
-	fmt.Println()
-
-	success = showEndpoints(cluster) && success
-
-	fmt.Println()
-
-	success = showGateways(cluster) && success
-
-	fmt.Println()
-
-	success = showNetwork(cluster) && success
-
-	fmt.Println()
-
-	success = showVersions(cluster) && success
-
-	return success
-}
@@ -18,24 +18,32 @@ limitations under the License.
 package show
 
 import (
+	"io"
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/table"
 	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 )
 
 type connectionStatus struct {
-	gateway     string
-	cluster     string
-	remoteIP    string
-	usingNAT    string
-	cableDriver string
-	subnets     string
-	rtt         string
-	status      submv1.ConnectionStatus
+	Gateway       string                  `json:"gateway"`
+	Cluster       string                  `json:"cluster"`
+	RemoteIP      string                  `json:"remoteIP"`
+	UsingNAT      bool                    `json:"usingNAT"`
+	CableDriver   string                  `json:"cableDriver"`
+	Subnets       []string                `json:"subnets"`
+	Status        submv1.ConnectionStatus `json:"status"`
+	StatusMessage string                  `json:"statusMessage,omitempty"`
+	LatencyRTT    *submv1.LatencyRTTSpec  `json:"latencyRTT,omitempty"`
+}
+
+var connectionsSection = section{
+	collect:            getConnectionsStatus,
+	print:              printConnections,
+	requiresSubmariner: true,
 }
 
 func init() {
@@ -45,61 +53,67 @@ func init() {
 		Long:    `This command shows information about submariner endpoint connections with other clusters.`,
 		PreRunE: restConfigProducer.CheckVersionMismatch,
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, showConnections)
+			runShow(connectionsSection)
 		},
 	})
 }
 
-func getConnectionsStatus(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func getConnectionsStatus(cluster *cmd.Cluster, info *clusterInfo, status reporter.Interface) bool {
 	status.Start("Showing Connections")
 
 	gateways, err := cluster.GetGateways()
 	if err != nil {
-		status.EndWithFailure("Error retrieving gateways: %v", err)
+		status.Failure("Error retrieving gateways: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if len(gateways) == 0 {
-		status.EndWithFailure("There are no gateways detected")
+		status.Failure("There are no gateways detected")
+		status.End()
+
 		return false
 	}
 
-	var connStatus []interface{}
+	var connStatus []connectionStatus
 
 	for i := range gateways {
 		gateway := &gateways[i]
 		for i := range gateway.Status.Connections {
 			connection := &gateway.Status.Connections[i]
-			subnets := strings.Join(connection.Endpoint.Subnets, ", ")
 
 			ip, nat := remoteIPAndNATForConnection(connection)
 
 			connStatus = append(connStatus, connectionStatus{
-				gateway:     connection.Endpoint.Hostname,
-				cluster:     connection.Endpoint.ClusterID,
-				remoteIP:    ip,
-				usingNAT:    nat,
-				cableDriver: connection.Endpoint.Backend,
-				subnets:     subnets,
-				status:      connection.Status,
-				rtt:         getAverageRTTForConnection(connection),
+				Gateway:       connection.Endpoint.Hostname,
+				Cluster:       connection.Endpoint.ClusterID,
+				RemoteIP:      ip,
+				UsingNAT:      nat,
+				CableDriver:   connection.Endpoint.Backend,
+				Subnets:       connection.Endpoint.Subnets,
+				Status:        connection.Status,
+				StatusMessage: connection.StatusMessage,
+				LatencyRTT:    connection.LatencyRTT,
 			})
 		}
 	}
 
 	if len(connStatus) == 0 {
-		status.EndWithFailure("No connections found")
+		status.Failure("No connections found")
+		status.End()
+
 		return false
 	}
 
-	status.EndWith(cli.Success)
-	connectionPrinter.Print(connStatus)
+	status.End()
+
+	info.Connections = connStatus
 
 	return true
 }
 
-func getAverageRTTForConnection(connection *submv1.Connection) string {
+func getAverageRTTForConnection(connection *connectionStatus) string {
 	rtt := ""
 	if connection.LatencyRTT != nil {
 		rtt = connection.LatencyRTT.Average
@@ -108,35 +122,37 @@ func getAverageRTTForConnection(connection *submv1.Connection) string {
 	return rtt
 }
 
-func remoteIPAndNATForConnection(connection *submv1.Connection) (string, string) {
-	usingNAT := "no"
-
+func remoteIPAndNATForConnection(connection *submv1.Connection) (string, bool) {
 	if connection.UsingIP != "" {
-		if connection.UsingNAT {
-			usingNAT = "yes"
-		}
-
-		return connection.UsingIP, usingNAT
+		return connection.UsingIP, connection.UsingNAT
 	}
 
 	if connection.Endpoint.NATEnabled {
-		return connection.Endpoint.PublicIP, "yes"
+		return connection.Endpoint.PublicIP, true
 	}
 
-	return connection.Endpoint.PrivateIP, "no"
+	return connection.Endpoint.PrivateIP, false
 }
 
-func showConnections(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func printConnections(out io.Writer, info *clusterInfo) {
+	connStatus := make([]interface{}, len(info.Connections))
+	for i := range info.Connections {
+		connStatus[i] = &info.Connections[i]
+	}
 
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
+	if outputFormat == wideOutput {
+		wideConnectionPrinter.Fprint(out, connStatus)
+	} else {
+		connectionPrinter.Fprint(out, connStatus)
+	}
+}
 
-		return true
+func yesOrNo(value bool) string {
+	if value {
+		return "yes"
 	}
 
-	return getConnectionsStatus(cluster)
+	return "no"
 }
 
 var connectionPrinter = table.Printer{
@@ -151,10 +167,40 @@ var connectionPrinter = table.Printer{
 		{Name: "RTT avg.", MaxLength: 12},
 	},
 	RowConverterFunc: func(obj interface{}) []string {
-		item := obj.(connectionStatus)
+		item := obj.(*connectionStatus)
+		return []string{
+			item.Gateway, item.Cluster, item.RemoteIP, yesOrNo(item.UsingNAT), item.CableDriver,
+			strings.Join(item.Subnets, ", "), string(item.Status), getAverageRTTForConnection(item),
+		}
+	},
+}
+
+var wideConnectionPrinter = table.Printer{
+	Headers: []table.Header{
+		{Name: "GATEWAY", MaxLength: 63},
+		{Name: "CLUSTER", MaxLength: 63},
+		{Name: "REMOTE IP", MaxLength: 39},
+		{Name: "NAT", MaxLength: 3},
+		{Name: "CABLE DRIVER", MaxLength: 19},
+		{Name: "SUBNETS", MaxLength: 255},
+		{Name: "STATUS", MaxLength: 15},
+		{Name: "RTT min.", MaxLength: 12},
+		{Name: "RTT avg.", MaxLength: 12},
+		{Name: "RTT max.", MaxLength: 12},
+		{Name: "RTT last", MaxLength: 12},
+		{Name: "MESSAGE", MaxLength: 255},
+	},
+	RowConverterFunc: func(obj interface{}) []string {
+		item := obj.(*connectionStatus)
+		rtt := submv1.LatencyRTTSpec{}
+		if item.LatencyRTT != nil {
+			rtt = *item.LatencyRTT
+		}
+
 		return []string{
-			item.gateway, item.cluster, item.remoteIP, item.usingNAT, item.cableDriver,
-			item.subnets, string(item.status), item.rtt,
+			item.Gateway, item.Cluster, item.RemoteIP, yesOrNo(item.UsingNAT), item.CableDriver,
+			strings.Join(item.Subnets, ", "), string(item.Status), rtt.Min, rtt.Average, rtt.Max, rtt.Last,
+			item.StatusMessage,
 		}
 	},
 }
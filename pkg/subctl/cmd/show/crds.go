@@ -0,0 +1,143 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/submariner-io/admiral/pkg/stringset"
+	"github.com/submariner-io/submariner-operator/pkg/crd"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
+	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
+	"github.com/submariner-io/submariner-operator/pkg/version"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	skewNone    = "none"
+	skewNewer   = "newer than the operator"
+	skewOlder   = "older than the operator"
+	skewUnknown = "unknown"
+)
+
+// The API groups of the Submariner, Lighthouse and MCS CRDs.
+var crdGroups = stringset.New("submariner.io", "lighthouse.submariner.io", "multicluster.x-k8s.io")
+
+type crdStatus struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Skew    string `json:"skew"`
+}
+
+var crdsSection = section{
+	collect: getCRDs,
+	print:   printCRDs,
+}
+
+func init() {
+	showCmd.AddCommand(&cobra.Command{
+		Use:   "crds",
+		Short: "Show the installed Submariner CRDs",
+		Long: `This command shows the Submariner, Lighthouse and MCS CRDs installed in a cluster, the version of subctl or
+of the operator which installed them, and how that version compares to the running operator.`,
+		PreRunE: restConfigProducer.CheckVersionMismatch,
+		Run: func(command *cobra.Command, args []string) {
+			runShow(crdsSection)
+		},
+	})
+}
+
+func getCRDs(cluster *cmd.Cluster, info *clusterInfo, status reporter.Interface) bool {
+	status.Start("Showing CRDs")
+	defer status.End()
+
+	crdClient, err := clientset.NewForConfig(cluster.Config)
+	if err != nil {
+		status.Failure("Unable to get the API extensions client: %v", err)
+		return false
+	}
+
+	crds, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		status.Failure("Error retrieving the CRDs: %v", err)
+		return false
+	}
+
+	operatorVersion, _, err := getOperatorImage(cluster.KubeClient)
+	if apierrors.IsNotFound(err) {
+		status.Warning("The operator isn't deployed, the CRD versions can't be compared to it")
+	} else if err != nil {
+		status.Failure("Unable to get the Operator version: %v", err)
+		return false
+	}
+
+	info.CRDs = []crdStatus{}
+
+	for i := range crds.Items {
+		if !crdGroups.Contains(crds.Items[i].Spec.Group) {
+			continue
+		}
+
+		installed := crds.Items[i].Annotations[crd.VersionAnnotation]
+		skew := skewUnknown
+
+		if comparison, ok := version.Compare(installed, operatorVersion); ok {
+			switch {
+			case comparison > 0:
+				skew = skewNewer
+			case comparison < 0:
+				skew = skewOlder
+			default:
+				skew = skewNone
+			}
+		}
+
+		if skew == skewNewer || skew == skewOlder {
+			status.Warning("The CRD %q was installed by version %s, which is %s (%s)", crds.Items[i].Name, installed, skew,
+				operatorVersion)
+		}
+
+		info.CRDs = append(info.CRDs, crdStatus{Name: crds.Items[i].Name, Version: installed, Skew: skew})
+	}
+
+	return true
+}
+
+func printCRDs(out io.Writer, info *clusterInfo) {
+	template := "%-48.47s%-16.15s%s\n"
+	if outputFormat == wideOutput {
+		template = "%-48s%-16s%s\n"
+	}
+
+	fmt.Fprintf(out, template, "NAME", "VERSION", "SKEW")
+
+	for _, item := range info.CRDs {
+		installed := item.Version
+		if installed == "" {
+			installed = skewUnknown
+		}
+
+		fmt.Fprintf(out, template, item.Name, installed, item.Skew)
+	}
+}
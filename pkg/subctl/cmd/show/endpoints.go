@@ -19,27 +19,42 @@ package show
 
 import (
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
+	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 )
 
 type endpointStatus struct {
-	clusterID    string
-	endpointIP   string
-	publicIP     string
-	cableDriver  string
-	endpointType string
+	ClusterID     string   `json:"clusterID"`
+	Hostname      string   `json:"hostname"`
+	EndpointIP    string   `json:"endpointIP"`
+	PublicIP      string   `json:"publicIP"`
+	HealthCheckIP string   `json:"healthCheckIP,omitempty"`
+	CableDriver   string   `json:"cableDriver"`
+	Subnets       []string `json:"subnets"`
+	EndpointType  string   `json:"type"`
 }
 
-func newEndpointsStatusFrom(clusterID, endpointIP, publicIP, cableDriver, endpointType string) endpointStatus {
+var endpointsSection = section{
+	collect:            getEndpointsStatus,
+	print:              printEndpoints,
+	requiresSubmariner: true,
+}
+
+func newEndpointsStatusFrom(endpoint *submv1.EndpointSpec, endpointType string) endpointStatus {
 	return endpointStatus{
-		clusterID:    clusterID,
-		endpointIP:   endpointIP,
-		publicIP:     publicIP,
-		cableDriver:  cableDriver,
-		endpointType: endpointType,
+		ClusterID:     endpoint.ClusterID,
+		Hostname:      endpoint.Hostname,
+		EndpointIP:    endpoint.PrivateIP,
+		PublicIP:      endpoint.PublicIP,
+		HealthCheckIP: endpoint.HealthCheckIP,
+		CableDriver:   endpoint.Backend,
+		Subnets:       endpoint.Subnets,
+		EndpointType:  endpointType,
 	}
 }
 
@@ -50,23 +65,26 @@ func init() {
 		Long:    `This command shows information about submariner endpoints in a cluster.`,
 		PreRunE: restConfigProducer.CheckVersionMismatch,
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, showEndpoints)
+			runShow(endpointsSection)
 		},
 	})
 }
 
-func getEndpointsStatus(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func getEndpointsStatus(cluster *cmd.Cluster, info *clusterInfo, status reporter.Interface) bool {
 	status.Start("Showing Endpoints")
 
 	gateways, err := cluster.GetGateways()
 	if err != nil {
-		status.EndWithFailure("Error retrieving gateways: %v", err)
+		status.Failure("Error retrieving gateways: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if len(gateways) == 0 {
-		status.EndWithFailure("There are no gateways detected")
+		status.Failure("There are no gateways detected")
+		status.End()
+
 		return false
 	}
 
@@ -74,60 +92,54 @@ func getEndpointsStatus(cluster *cmd.Cluster) bool {
 
 	for i := range gateways {
 		gateway := &gateways[i]
-		epStatus = append(epStatus, newEndpointsStatusFrom(
-			gateway.Status.LocalEndpoint.ClusterID,
-			gateway.Status.LocalEndpoint.PrivateIP,
-			gateway.Status.LocalEndpoint.PublicIP,
-			gateway.Status.LocalEndpoint.Backend,
-			"local"))
+		epStatus = append(epStatus, newEndpointsStatusFrom(&gateway.Status.LocalEndpoint, "local"))
 
 		for i := range gateway.Status.Connections {
-			connection := &gateway.Status.Connections[i]
-			epStatus = append(epStatus, newEndpointsStatusFrom(
-				connection.Endpoint.ClusterID,
-				connection.Endpoint.PrivateIP,
-				connection.Endpoint.PublicIP,
-				connection.Endpoint.Backend,
-				"remote"))
+			epStatus = append(epStatus, newEndpointsStatusFrom(&gateway.Status.Connections[i].Endpoint, "remote"))
 		}
 	}
 
 	if len(epStatus) == 0 {
-		status.EndWithFailure("No Endpoints found")
+		status.Failure("No Endpoints found")
+		status.End()
+
 		return false
 	}
 
-	status.EndWith(cli.Success)
-	printEndpoints(epStatus)
+	status.End()
+
+	info.Endpoints = epStatus
 
 	return true
 }
 
-func showEndpoints(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func printEndpoints(out io.Writer, info *clusterInfo) {
+	if outputFormat == wideOutput {
+		template := "%-30.29s%-32.31s%-16.15s%-16.15s%-16.15s%-20.19s%-8.7s%s\n"
 
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
+		fmt.Fprintf(out, template, "CLUSTER ID", "HOSTNAME", "ENDPOINT IP", "PUBLIC IP", "HEALTH CHECK IP", "CABLE DRIVER", "TYPE",
+			"SUBNETS")
 
-		return true
-	}
+		for _, item := range info.Endpoints {
+			fmt.Fprintf(out, template, item.ClusterID, item.Hostname, item.EndpointIP, item.PublicIP, item.HealthCheckIP,
+				item.CableDriver, item.EndpointType, strings.Join(item.Subnets, ", "))
+		}
 
-	return getEndpointsStatus(cluster)
-}
+		return
+	}
 
-func printEndpoints(endpoints []endpointStatus) {
 	template := "%-30.29s%-16.15s%-16.15s%-20.19s%-16.15s\n"
 
-	fmt.Printf(template, "CLUSTER ID", "ENDPOINT IP", "PUBLIC IP", "CABLE DRIVER", "TYPE")
+	fmt.Fprintf(out, template, "CLUSTER ID", "ENDPOINT IP", "PUBLIC IP", "CABLE DRIVER", "TYPE")
 
-	for _, item := range endpoints {
-		fmt.Printf(
+	for _, item := range info.Endpoints {
+		fmt.Fprintf(
+			out,
 			template,
-			item.clusterID,
-			item.endpointIP,
-			item.publicIP,
-			item.cableDriver,
-			item.endpointType)
+			item.ClusterID,
+			item.EndpointIP,
+			item.PublicIP,
+			item.CableDriver,
+			item.EndpointType)
 	}
 }
@@ -19,17 +19,27 @@ package show
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	submv1 "github.com/submariner-io/submariner/pkg/apis/submariner.io/v1"
 )
 
 type gatewayStatus struct {
-	node     string
-	haStatus submv1.HAStatus
-	summary  string
+	Node          string          `json:"node"`
+	HAStatus      submv1.HAStatus `json:"haStatus"`
+	Summary       string          `json:"summary"`
+	Connections   int             `json:"connections"`
+	Established   int             `json:"establishedConnections"`
+	StatusFailure string          `json:"statusFailure,omitempty"`
+}
+
+var gatewaysSection = section{
+	collect:            getGatewaysStatus,
+	print:              printGateways,
+	requiresSubmariner: true,
 }
 
 func init() {
@@ -39,23 +49,26 @@ func init() {
 		Long:    `This command shows summary information about the submariner gateways in a cluster.`,
 		PreRunE: restConfigProducer.CheckVersionMismatch,
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, showGateways)
+			runShow(gatewaysSection)
 		},
 	})
 }
 
-func getGatewaysStatus(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func getGatewaysStatus(cluster *cmd.Cluster, info *clusterInfo, status reporter.Interface) bool {
 	status.Start("Showing Gateways")
 
 	gateways, err := cluster.GetGateways()
 	if err != nil {
-		status.EndWithFailure("Error retrieving gateways: %v", err)
+		status.Failure("Error retrieving gateways: %v", err)
+		status.End()
+
 		return false
 	}
 
 	if len(gateways) == 0 {
-		status.EndWithFailure("There are no gateways detected")
+		status.Failure("There are no gateways detected")
+		status.End()
+
 		return false
 	}
 
@@ -87,45 +100,51 @@ func getGatewaysStatus(cluster *cmd.Cluster) bool {
 
 		gwStatus = append(gwStatus,
 			gatewayStatus{
-				node:     enpoint,
-				haStatus: haStatus,
-				summary:  summary,
+				Node:          enpoint,
+				HAStatus:      haStatus,
+				Summary:       summary,
+				Connections:   totalConnections,
+				Established:   countConnected,
+				StatusFailure: gateway.Status.StatusFailure,
 			})
 	}
 
 	if len(gwStatus) == 0 {
-		status.EndWithFailure("No Gateways found")
+		status.Failure("No Gateways found")
+		status.End()
+
 		return false
 	}
 
-	status.EndWith(cli.Success)
-	printGateways(gwStatus)
+	status.End()
+
+	info.Gateways = gwStatus
 
 	return true
 }
 
-func showGateways(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func printGateways(out io.Writer, info *clusterInfo) {
+	if outputFormat == wideOutput {
+		template := "%-64.63s%-16s%-14d%-14d%s\n"
 
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
+		fmt.Fprintf(out, "%-64.63s%-16s%-14s%-14s%s\n", "NODE", "HA STATUS", "CONNECTIONS", "ESTABLISHED", "SUMMARY")
 
-		return true
-	}
+		for _, item := range info.Gateways {
+			fmt.Fprintf(out, template, item.Node, item.HAStatus, item.Connections, item.Established, item.Summary)
+		}
 
-	return getGatewaysStatus(cluster)
-}
+		return
+	}
 
-func printGateways(gateways []gatewayStatus) {
 	template := "%-32.31s%-16s%-32s\n"
-	fmt.Printf(template, "NODE", "HA STATUS", "SUMMARY")
+	fmt.Fprintf(out, template, "NODE", "HA STATUS", "SUMMARY")
 
-	for _, item := range gateways {
-		fmt.Printf(
+	for _, item := range info.Gateways {
+		fmt.Fprintf(
+			out,
 			template,
-			item.node,
-			item.haStatus,
-			item.summary)
+			item.Node,
+			item.HAStatus,
+			item.Summary)
 	}
 }
@@ -20,15 +20,33 @@ package show
 
 import (
 	"fmt"
+	"io"
+	"sort"
 
 	"github.com/spf13/cobra"
-	"github.com/submariner-io/submariner-operator/internal/cli"
-	"github.com/submariner-io/submariner-operator/internal/exit"
 	submarinerclientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
 	"github.com/submariner-io/submariner-operator/pkg/discovery/network"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 )
 
+type networkDetails struct {
+	// DiscoveredBy is "submariner" if the details come from the Submariner resource, "discovery" otherwise.
+	DiscoveredBy     string            `json:"discoveredBy"`
+	NetworkPlugin    string            `json:"networkPlugin"`
+	ServiceCIDRs     []string          `json:"serviceCIDRs"`
+	ClusterCIDRs     []string          `json:"clusterCIDRs"`
+	IPv6ServiceCIDRs []string          `json:"ipv6ServiceCIDRs,omitempty"`
+	IPv6ClusterCIDRs []string          `json:"ipv6ClusterCIDRs,omitempty"`
+	GlobalCIDR       string            `json:"globalCIDR,omitempty"`
+	PluginSettings   map[string]string `json:"pluginSettings,omitempty"`
+}
+
+var networkSection = section{
+	collect: getNetworkDetails,
+	print:   printNetwork,
+}
+
 func init() {
 	showCmd.AddCommand(&cobra.Command{
 		Use:   "networks",
@@ -37,42 +55,99 @@ func init() {
 		      and the relevant network details from your cluster.`,
 		PreRunE: restConfigProducer.CheckVersionMismatch,
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, showNetwork)
+			runShow(networkSection)
 		},
 	})
 }
 
-func showNetwork(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func getNetworkDetails(cluster *cmd.Cluster, info *clusterInfo, status reporter.Interface) bool {
 	status.Start("Showing Network details")
 
-	var clusterNetwork *network.ClusterNetwork
-	var msg string
-
 	if cluster.Submariner != nil {
-		msg = "    Discovered network details via Submariner:"
-		clusterNetwork = &network.ClusterNetwork{
-			PodCIDRs:      []string{cluster.Submariner.Status.ClusterCIDR},
-			ServiceCIDRs:  []string{cluster.Submariner.Status.ServiceCIDR},
+		info.Network = &networkDetails{
+			DiscoveredBy:  "submariner",
 			NetworkPlugin: cluster.Submariner.Status.NetworkPlugin,
+			ServiceCIDRs:  []string{cluster.Submariner.Status.ServiceCIDR},
+			ClusterCIDRs:  []string{cluster.Submariner.Status.ClusterCIDR},
 			GlobalCIDR:    cluster.Submariner.Status.GlobalCIDR,
 		}
-	} else {
-		msg = "    Discovered network details"
 
-		submarinerClient, err := submarinerclientset.NewForConfig(cluster.Config)
-		exit.OnErrorWithMessage(err, "Unable to get the Submariner client")
+		status.End()
 
-		clusterNetwork, err = network.Discover(cluster.DynClient, cluster.KubeClient, submarinerClient, cmd.OperatorNamespace)
-		exit.OnErrorWithMessage(err, "There was an error discovering network details for this cluster")
+		return true
+	}
+
+	submarinerClient, err := submarinerclientset.NewForConfig(cluster.Config)
+	if err != nil {
+		status.Failure("Unable to get the Submariner client: %v", err)
+		status.End()
+
+		return false
+	}
+
+	clusterNetwork, err := network.Discover(cluster.DynClient, cluster.KubeClient, submarinerClient, cmd.OperatorNamespace)
+	if err != nil {
+		status.Failure("There was an error discovering network details for this cluster: %v", err)
+		status.End()
+
+		return false
 	}
 
 	if clusterNetwork != nil {
-		fmt.Println(msg)
+		info.Network = &networkDetails{
+			DiscoveredBy:     "discovery",
+			NetworkPlugin:    clusterNetwork.NetworkPlugin,
+			ServiceCIDRs:     clusterNetwork.ServiceCIDRs,
+			ClusterCIDRs:     clusterNetwork.PodCIDRs,
+			IPv6ServiceCIDRs: clusterNetwork.IPv6ServiceCIDRs,
+			IPv6ClusterCIDRs: clusterNetwork.IPv6PodCIDRs,
+			GlobalCIDR:       clusterNetwork.GlobalCIDR,
+			PluginSettings:   clusterNetwork.PluginSettings,
+		}
 	}
 
-	clusterNetwork.Show()
-	status.EndWith(cli.Success)
+	status.End()
 
 	return true
 }
+
+func printNetwork(out io.Writer, info *clusterInfo) {
+	if info.Network == nil {
+		fmt.Fprintln(out, "    No network details discovered")
+		return
+	}
+
+	if info.Network.DiscoveredBy == "submariner" {
+		fmt.Fprintln(out, "    Discovered network details via Submariner:")
+	} else {
+		fmt.Fprintln(out, "    Discovered network details")
+	}
+
+	clusterNetwork := network.ClusterNetwork{
+		PodCIDRs:         info.Network.ClusterCIDRs,
+		ServiceCIDRs:     info.Network.ServiceCIDRs,
+		IPv6PodCIDRs:     info.Network.IPv6ClusterCIDRs,
+		IPv6ServiceCIDRs: info.Network.IPv6ServiceCIDRs,
+		NetworkPlugin:    info.Network.NetworkPlugin,
+		GlobalCIDR:       info.Network.GlobalCIDR,
+	}
+
+	clusterNetwork.ShowTo(out)
+
+	if outputFormat != wideOutput || len(info.Network.PluginSettings) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(info.Network.PluginSettings))
+	for key := range info.Network.PluginSettings {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	fmt.Fprintln(out, "        Plugin settings:")
+
+	for _, key := range keys {
+		fmt.Fprintf(out, "            %s: %s\n", key, info.Network.PluginSettings[key])
+	}
+}
@@ -0,0 +1,131 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/submariner-io/submariner-operator/internal/cli"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
+	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
+)
+
+const wideOutput = "wide"
+
+// clusterInfo is the structured output for a single cluster; only the sections requested are present.
+type clusterInfo struct {
+	Name        string             `json:"name"`
+	Errors      []string           `json:"errors,omitempty"`
+	Warnings    []string           `json:"warnings,omitempty"`
+	Connections []connectionStatus `json:"connections,omitempty"`
+	Endpoints   []endpointStatus   `json:"endpoints,omitempty"`
+	Gateways    []gatewayStatus    `json:"gateways,omitempty"`
+	Network     *networkDetails    `json:"network,omitempty"`
+	Versions    []versionImageInfo `json:"versions,omitempty"`
+	CRDs        []crdStatus        `json:"crds,omitempty"`
+}
+
+// section is a part of the information shown about a cluster.
+type section struct {
+	// collect retrieves the information into info, reporting any problems to status; it returns false on failure.
+	collect func(cluster *cmd.Cluster, info *clusterInfo, status reporter.Interface) bool
+	// print renders the collected information as text.
+	print              func(out io.Writer, info *clusterInfo)
+	requiresSubmariner bool
+}
+
+// runShow shows the given sections for all the selected clusters, in the requested output format.
+func runShow(sections ...section) {
+	if outputFormat != cmd.JSONOutput && outputFormat != cmd.YAMLOutput {
+		cmd.ExecuteMultiCluster(restConfigProducer, func(_ context.Context, cluster *cmd.Cluster) bool {
+			return showSections(cluster, sections...)
+		})
+
+		return
+	}
+
+	cmd.ExecuteMultiClusterStructured(restConfigProducer, outputFormat,
+		func(_ context.Context, cluster *cmd.Cluster) (interface{}, bool) {
+			info := collectSections(cluster, sections...)
+			return info, len(info.Errors) == 0
+		},
+		func(clusterName, message string) interface{} {
+			return clusterInfo{Name: clusterName, Errors: []string{message}}
+		})
+}
+
+func showSections(cluster *cmd.Cluster, sections ...section) bool {
+	status := cluster.NewStatus()
+	info := &clusterInfo{Name: cluster.Name}
+	success := true
+
+	for i := range sections {
+		if sections[i].requiresSubmariner && cluster.Submariner == nil {
+			status.Start(cmd.SubmMissingMessage)
+			status.EndWith(cli.Warning)
+
+			return success
+		}
+
+		if i > 0 {
+			fmt.Fprintln(cluster.Out)
+		}
+
+		if !sections[i].collect(cluster, info, status) {
+			success = false
+			continue
+		}
+
+		sections[i].print(cluster.Out, info)
+	}
+
+	return success
+}
+
+func collectSections(cluster *cmd.Cluster, sections ...section) clusterInfo {
+	info := clusterInfo{Name: cluster.Name}
+	collector := reporter.NewCollector()
+
+	for i := range sections {
+		if sections[i].requiresSubmariner && cluster.Submariner == nil {
+			info.Warnings = append(info.Warnings, cmd.SubmMissingMessage)
+			break
+		}
+
+		sections[i].collect(cluster, &info, collector)
+	}
+
+	collector.End()
+
+	for i := range collector.Operations {
+		for _, message := range collector.Operations[i].Messages {
+			switch message.Level {
+			case reporter.FailureLevel:
+				info.Errors = append(info.Errors, message.Text)
+			case reporter.WarningLevel:
+				info.Warnings = append(info.Warnings, message.Text)
+			case reporter.SuccessLevel:
+			}
+		}
+	}
+
+	return info
+}
@@ -19,6 +19,8 @@ limitations under the License.
 package show
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/submariner-io/submariner-operator/internal/restconfig"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
@@ -29,12 +31,28 @@ var (
 	showCmd = &cobra.Command{
 		Use:   "show",
 		Short: "Show information about submariner",
-		Long:  `This command shows information about some aspect of the submariner deployment in a cluster.`,
+		Long: `This command shows information about some aspect of the submariner deployment in a cluster.
+
+With --output json or yaml, the information is printed as a document with a "clusters" list; each entry has
+the cluster "name", any "errors" and "warnings", and the "connections", "endpoints", "gateways", "network",
+"versions" and "crds" requested by the subcommand.`,
+		PersistentPreRunE: func(command *cobra.Command, args []string) error {
+			switch outputFormat {
+			case "", wideOutput, cmd.JSONOutput, cmd.YAMLOutput:
+				return nil
+			}
+
+			return fmt.Errorf("unsupported output format %q, must be one of wide, json or yaml", outputFormat)
+		},
 	}
 	restConfigProducer = restconfig.NewProducer()
+	outputFormat       string
 )
 
 func init() {
 	restConfigProducer.AddKubeConfigFlag(showCmd)
+	cmd.AddMultiClusterFlags(showCmd)
+	showCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "",
+		"output format, one of wide, json or yaml; tables if unset")
 	cmd.AddToRootCommand(showCmd)
 }
@@ -20,15 +20,15 @@ package show
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
-	"github.com/submariner-io/submariner-operator/internal/cli"
-	"github.com/submariner-io/submariner-operator/internal/exit"
 	submarinerclientset "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
 	"github.com/submariner-io/submariner-operator/pkg/images"
 	"github.com/submariner-io/submariner-operator/pkg/names"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/cmd"
 	"github.com/submariner-io/submariner-operator/pkg/subctl/operator/submarinercr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -43,22 +43,28 @@ func init() {
 		Long:    `This command shows the versions of the submariner components in the cluster.`,
 		PreRunE: restConfigProducer.CheckVersionMismatch,
 		Run: func(command *cobra.Command, args []string) {
-			cmd.ExecuteMultiCluster(restConfigProducer, showVersions)
+			runShow(versionsSection)
 		},
 	})
 }
 
 type versionImageInfo struct {
-	component  string
-	repository string
-	version    string
+	Component  string `json:"component"`
+	Repository string `json:"repository"`
+	Version    string `json:"version"`
+}
+
+var versionsSection = section{
+	collect:            getVersions,
+	print:              printVersions,
+	requiresSubmariner: true,
 }
 
 func newVersionInfoFrom(repository, component, version string) versionImageInfo {
 	return versionImageInfo{
-		component:  component,
-		repository: repository,
-		version:    version,
+		Component:  component,
+		Repository: repository,
+		Version:    version,
 	}
 }
 
@@ -68,18 +74,28 @@ func getSubmarinerVersion(submariner *v1alpha1.Submariner, versions []versionIma
 }
 
 func getOperatorVersion(clientSet kubernetes.Interface, versions []versionImageInfo) ([]versionImageInfo, error) {
-	operatorConfig, err := clientSet.AppsV1().Deployments(cmd.OperatorNamespace).Get(context.TODO(), names.OperatorComponent, v1.GetOptions{})
+	version, repository, err := getOperatorImage(clientSet)
 	if err != nil {
-		return nil, errors.Wrap(err, "error retrieving Deployment")
+		return nil, err
 	}
 
-	operatorFullImageStr := operatorConfig.Spec.Template.Spec.Containers[0].Image
-	version, repository := images.ParseOperatorImage(operatorFullImageStr)
 	versions = append(versions, newVersionInfoFrom(repository, names.OperatorComponent, version))
 
 	return versions, nil
 }
 
+func getOperatorImage(clientSet kubernetes.Interface) (version, repository string, err error) {
+	operatorConfig, err := clientSet.AppsV1().Deployments(cmd.OperatorNamespace).Get(context.TODO(), names.OperatorComponent, v1.GetOptions{})
+	if err != nil {
+		return "", "", errors.Wrap(err, "error retrieving Deployment")
+	}
+
+	operatorFullImageStr := operatorConfig.Spec.Template.Spec.Containers[0].Image
+	version, repository = images.ParseOperatorImage(operatorFullImageStr)
+
+	return version, repository, nil
+}
+
 func getServiceDiscoveryVersions(submarinerClient submarinerclientset.Interface, versions []versionImageInfo) ([]versionImageInfo, error) {
 	lighthouseAgentConfig, err := submarinerClient.SubmarinerV1alpha1().ServiceDiscoveries(cmd.OperatorNamespace).Get(
 		context.TODO(), names.ServiceDiscoveryCrName, v1.GetOptions{})
@@ -97,50 +113,58 @@ func getServiceDiscoveryVersions(submarinerClient submarinerclientset.Interface,
 	return versions, nil
 }
 
-func getVersions(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+func getVersions(cluster *cmd.Cluster, info *clusterInfo, status reporter.Interface) bool {
 	status.Start("Showing versions")
 
 	var versions []versionImageInfo
+
 	submarinerClient, err := submarinerclientset.NewForConfig(cluster.Config)
-	exit.OnErrorWithMessage(err, "Unable to get the Submariner client")
+	if err != nil {
+		status.Failure("Unable to get the Submariner client: %v", err)
+		status.End()
+
+		return false
+	}
 
 	versions = getSubmarinerVersion(cluster.Submariner, versions)
 
 	versions, err = getOperatorVersion(cluster.KubeClient, versions)
-	exit.OnErrorWithMessage(err, "Unable to get the Operator version")
-
-	versions, err = getServiceDiscoveryVersions(submarinerClient, versions)
-	exit.OnErrorWithMessage(err, "Unable to get the Service-Discovery version")
+	if err != nil {
+		status.Failure("Unable to get the Operator version: %v", err)
+		status.End()
 
-	printVersions(versions)
-	status.EndWith(cli.Success)
+		return false
+	}
 
-	return true
-}
+	versions, err = getServiceDiscoveryVersions(submarinerClient, versions)
+	if err != nil {
+		status.Failure("Unable to get the Service-Discovery version: %v", err)
+		status.End()
 
-func showVersions(cluster *cmd.Cluster) bool {
-	status := cli.NewStatus()
+		return false
+	}
 
-	if cluster.Submariner == nil {
-		status.Start(cmd.SubmMissingMessage)
-		status.EndWith(cli.Warning)
+	status.End()
 
-		return true
-	}
+	info.Versions = versions
 
-	return getVersions(cluster)
+	return true
 }
 
-func printVersions(versions []versionImageInfo) {
+func printVersions(out io.Writer, info *clusterInfo) {
 	template := "%-32.31s%-54.53s%-16.15s\n"
-	fmt.Printf(template, "COMPONENT", "REPOSITORY", "VERSION")
+	if outputFormat == wideOutput {
+		template = "%-32s%-54s%s\n"
+	}
+
+	fmt.Fprintf(out, template, "COMPONENT", "REPOSITORY", "VERSION")
 
-	for _, item := range versions {
-		fmt.Printf(
+	for _, item := range info.Versions {
+		fmt.Fprintf(
+			out,
 			template,
-			item.component,
-			item.repository,
-			item.version)
+			item.Component,
+			item.Repository,
+			item.Version)
 	}
 }
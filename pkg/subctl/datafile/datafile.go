@@ -29,6 +29,7 @@ import (
 	"github.com/submariner-io/admiral/pkg/stringset"
 	"github.com/submariner-io/submariner-operator/internal/component"
 	"github.com/submariner-io/submariner-operator/internal/constants"
+	"github.com/submariner-io/submariner-operator/internal/envelope"
 	"github.com/submariner-io/submariner-operator/internal/rbac"
 	submarinerClientset "github.com/submariner-io/submariner/pkg/client/clientset/versioned"
 	v1 "k8s.io/api/core/v1"
@@ -107,6 +108,11 @@ func NewFromFile(filename string) (*SubctlData, error) {
 		return nil, errors.Wrapf(err, "error reading file %q", filename)
 	}
 
+	dat, err = envelope.Unwrap(dat)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error reading file %q", filename)
+	}
+
 	return NewFromString(string(dat))
 }
 
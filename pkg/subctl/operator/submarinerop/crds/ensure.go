@@ -45,6 +45,13 @@ func Ensure(crdUpdater crd.Updater) (bool, error) {
 
 	brokerCreated, err := crdUpdater.CreateOrUpdateFromEmbedded(context.TODO(),
 		embeddedyamls.Deploy_crds_submariner_io_brokers_yaml)
+	if err != nil {
+		return false, errors.Wrap(err, "error provisioning Broker CRD")
+	}
+
+	globalnetAllocationCreated, err := crdUpdater.CreateOrUpdateFromEmbedded(context.TODO(),
+		embeddedyamls.Deploy_crds_submariner_io_globalnetallocations_yaml)
 
-	return submarinerCreated || serviceDiscoveryCreated || brokerCreated, errors.Wrap(err, "error provisioning Broker CRD")
+	return submarinerCreated || serviceDiscoveryCreated || brokerCreated || globalnetAllocationCreated,
+		errors.Wrap(err, "error provisioning GlobalnetAllocation CRD")
 }
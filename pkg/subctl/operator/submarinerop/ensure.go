@@ -31,8 +31,9 @@ import (
 )
 
 // nolint:wrapcheck // No need to wrap errors here.
-func Ensure(status reporter.Interface, clientProducer client.Producer, operatorNamespace, operatorImage string, debug bool) error {
-	if created, err := opcrds.Ensure(crd.UpdaterFromClientSet(clientProducer.ForCRD())); err != nil {
+func Ensure(status reporter.Interface, clientProducer client.Producer, operatorNamespace, operatorImage string, debug bool,
+	crdPolicy crd.SkewPolicy) error {
+	if created, err := opcrds.Ensure(crd.WithSkewPolicy(crd.UpdaterFromClientSet(clientProducer.ForCRD()), crdPolicy)); err != nil {
 		return err
 	} else if created {
 		status.Success("Created operator CRDs")
@@ -18,7 +18,11 @@ limitations under the License.
 
 package table
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"os"
+)
 
 type Header struct {
 	Name      string
@@ -31,6 +35,11 @@ type Printer struct {
 }
 
 func (p *Printer) Print(objects []interface{}) {
+	p.Fprint(os.Stdout, objects)
+}
+
+// Fprint prints the table to the given writer.
+func (p *Printer) Fprint(w io.Writer, objects []interface{}) {
 	rowList := p.generateRowList(objects)
 
 	columnLengths := p.findColumnLengths(rowList)
@@ -42,7 +51,7 @@ func (p *Printer) Print(objects []interface{}) {
 			rowInterfaces[i] = row[i]
 		}
 
-		fmt.Printf(template, rowInterfaces...)
+		fmt.Fprintf(w, template, rowInterfaces...)
 	}
 }
 
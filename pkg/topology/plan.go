@@ -0,0 +1,220 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/internal/constants"
+	"github.com/submariner-io/submariner-operator/pkg/brokercr"
+	operatorclient "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionUnchanged Action = "unchanged"
+)
+
+// Change is a setting whose deployed value differs from the desired one.
+type Change struct {
+	Field   string
+	Current interface{}
+	Desired interface{}
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Field, c.Current, c.Desired)
+}
+
+// Plan is the action needed to converge a cluster to its desired configuration.
+type Plan struct {
+	Context string
+	Action  Action
+	Changes []Change
+}
+
+func (p *Plan) String() string {
+	if len(p.Changes) == 0 {
+		return fmt.Sprintf("%s: %s", p.Context, p.Action)
+	}
+
+	changes := make([]string, len(p.Changes))
+	for i := range p.Changes {
+		changes[i] = p.Changes[i].String()
+	}
+
+	return fmt.Sprintf("%s: %s (%s)", p.Context, p.Action, strings.Join(changes, ", "))
+}
+
+// PlanBroker compares the desired broker with the Broker resource deployed in the broker cluster.
+func PlanBroker(broker *Broker, client operatorclient.Interface) (*Plan, error) {
+	plan := &Plan{Context: broker.Context}
+
+	existing, err := client.SubmarinerV1alpha1().Brokers(broker.BrokerNamespace).Get(context.TODO(), brokercr.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		plan.Action = ActionCreate
+		return plan, nil
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "error retrieving the Broker resource from context %q", broker.Context)
+	}
+
+	current := &existing.Spec
+	desired := &broker.BrokerSpec
+
+	plan.compare("components", current.Components, desired.Components)
+	plan.compare("globalnetEnabled", current.GlobalnetEnabled, desired.GlobalnetEnabled)
+	plan.compare("defaultCustomDomains", current.DefaultCustomDomains, desired.DefaultCustomDomains)
+
+	if desired.GlobalnetEnabled {
+		plan.compare("globalnetCIDRRange", current.GlobalnetCIDRRange, desired.GlobalnetCIDRRange)
+		plan.compare("defaultGlobalnetClusterSize", current.DefaultGlobalnetClusterSize, desired.DefaultGlobalnetClusterSize)
+	}
+
+	plan.setUpdateAction()
+
+	return plan, nil
+}
+
+// PlanCluster compares the desired cluster with the Submariner, or failing that ServiceDiscovery, resource deployed
+// in the cluster.
+func PlanCluster(cluster *Cluster, client operatorclient.Interface) (*Plan, error) {
+	plan := &Plan{Context: cluster.Context}
+
+	submariner, err := client.SubmarinerV1alpha1().Submariners(constants.OperatorNamespace).Get(context.TODO(),
+		constants.SubmarinerName, metav1.GetOptions{})
+	if err == nil {
+		plan.compareSubmariner(&submariner.Spec, cluster)
+		plan.setUpdateAction()
+
+		return plan, nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "error retrieving the Submariner resource from context %q", cluster.Context)
+	}
+
+	serviceDiscovery, err := client.SubmarinerV1alpha1().ServiceDiscoveries(constants.OperatorNamespace).Get(context.TODO(),
+		names.ServiceDiscoveryCrName, metav1.GetOptions{})
+	if err == nil {
+		plan.compareServiceDiscovery(&serviceDiscovery.Spec, cluster)
+		plan.setUpdateAction()
+
+		return plan, nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "error retrieving the ServiceDiscovery resource from context %q", cluster.Context)
+	}
+
+	plan.Action = ActionCreate
+
+	return plan, nil
+}
+
+func (p *Plan) compareSubmariner(current *v1alpha1.SubmarinerSpec, desired *Cluster) {
+	p.compare("clusterID", current.ClusterID, desired.ClusterID)
+	p.compare("repository", current.Repository, defaultString(desired.Repository, v1alpha1.DefaultRepo))
+	p.compare("version", current.Version, defaultString(desired.ImageVersion, v1alpha1.DefaultSubmarinerOperatorVersion))
+	p.compare("natTraversal", current.NatEnabled, desired.NATTraversal)
+	p.compare("nattPort", current.CeIPSecNATTPort, desired.NATTPort)
+	p.compare("ikePort", current.CeIPSecIKEPort, desired.IKEPort)
+	p.compare("ipsecDebug", current.CeIPSecDebug, desired.IPSecDebug)
+	p.compare("forceUDPEncaps", current.CeIPSecForceUDPEncaps, desired.ForceUDPEncaps)
+	p.compare("preferredServer", current.CeIPSecPreferredServer, desired.PreferredServer)
+	p.compare("submarinerDebug", current.Debug, desired.SubmarinerDebug)
+	p.compare("cableDriver", current.CableDriver, desired.CableDriver)
+	p.compare("loadBalancerEnabled", current.LoadBalancerEnabled, desired.LoadBalancerEnabled)
+
+	if desired.ClusterCIDR != "" {
+		p.compare("clusterCIDR", current.ClusterCIDR, desired.ClusterCIDR)
+	}
+
+	if desired.ServiceCIDR != "" {
+		p.compare("serviceCIDR", current.ServiceCIDR, desired.ServiceCIDR)
+	}
+
+	if desired.GlobalnetCIDR != "" {
+		p.compare("globalnetCIDR", current.GlobalCIDR, desired.GlobalnetCIDR)
+	}
+
+	if len(desired.CustomDomains) > 0 {
+		p.compare("customDomains", current.CustomDomains, desired.CustomDomains)
+	}
+
+	healthCheck := current.ConnectionHealthCheck
+	if healthCheck == nil {
+		healthCheck = &v1alpha1.HealthCheckSpec{}
+	}
+
+	p.compare("healthCheckEnabled", healthCheck.Enabled, desired.HealthCheckEnabled)
+	p.compare("healthCheckInterval", healthCheck.IntervalSeconds, desired.HealthCheckInterval)
+	p.compare("healthCheckMaxPacketLossCount", healthCheck.MaxPacketLossCount, desired.HealthCheckMaxPacketLossCount)
+}
+
+func (p *Plan) compareServiceDiscovery(current *v1alpha1.ServiceDiscoverySpec, desired *Cluster) {
+	p.compare("clusterID", current.ClusterID, desired.ClusterID)
+	p.compare("repository", current.Repository, desired.Repository)
+	p.compare("version", current.Version, desired.ImageVersion)
+	p.compare("submarinerDebug", current.Debug, desired.SubmarinerDebug)
+
+	if len(desired.CustomDomains) > 0 {
+		p.compare("customDomains", current.CustomDomains, desired.CustomDomains)
+	}
+}
+
+func (p *Plan) compare(field string, current, desired interface{}) {
+	// An unset list and an empty list are equivalent
+	currentList, isList := current.([]string)
+	if desiredList, ok := desired.([]string); isList && ok && len(currentList) == 0 && len(desiredList) == 0 {
+		return
+	}
+
+	if !reflect.DeepEqual(current, desired) {
+		p.Changes = append(p.Changes, Change{Field: field, Current: current, Desired: desired})
+	}
+}
+
+func (p *Plan) setUpdateAction() {
+	if len(p.Changes) > 0 {
+		p.Action = ActionUpdate
+	} else {
+		p.Action = ActionUnchanged
+	}
+}
+
+func defaultString(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
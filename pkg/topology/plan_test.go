@@ -0,0 +1,198 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/internal/constants"
+	"github.com/submariner-io/submariner-operator/pkg/brokercr"
+	fakeOperator "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned/fake"
+	"github.com/submariner-io/submariner-operator/pkg/deploy"
+	"github.com/submariner-io/submariner-operator/pkg/join"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	"github.com/submariner-io/submariner-operator/pkg/topology"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ctx = context.TODO()
+
+var _ = Describe("PlanCluster", func() {
+	var (
+		operatorClient *fakeOperator.Clientset
+		cluster        *topology.Cluster
+	)
+
+	BeforeEach(func() {
+		operatorClient = fakeOperator.NewSimpleClientset()
+		cluster = &topology.Cluster{
+			Context: "east",
+			Options: join.Options{
+				ClusterID:                     "east",
+				NATTraversal:                  true,
+				NATTPort:                      4500,
+				IKEPort:                       500,
+				HealthCheckEnabled:            true,
+				HealthCheckInterval:           1,
+				HealthCheckMaxPacketLossCount: 5,
+			},
+		}
+	})
+
+	When("nothing is deployed", func() {
+		It("should plan to create", func() {
+			plan, err := topology.PlanCluster(cluster, operatorClient)
+			Expect(err).To(Succeed())
+			Expect(plan.Action).To(Equal(topology.ActionCreate))
+			Expect(plan.Changes).To(BeEmpty())
+		})
+	})
+
+	When("a Submariner resource is deployed", func() {
+		var submariner *v1alpha1.Submariner
+
+		BeforeEach(func() {
+			submariner = &v1alpha1.Submariner{
+				ObjectMeta: metav1.ObjectMeta{Name: constants.SubmarinerName, Namespace: constants.OperatorNamespace},
+				Spec: v1alpha1.SubmarinerSpec{
+					ClusterID:       "east",
+					Repository:      v1alpha1.DefaultRepo,
+					Version:         v1alpha1.DefaultSubmarinerOperatorVersion,
+					NatEnabled:      true,
+					CeIPSecNATTPort: 4500,
+					CeIPSecIKEPort:  500,
+					ClusterCIDR:     "10.0.0.0/16",
+					ConnectionHealthCheck: &v1alpha1.HealthCheckSpec{
+						Enabled:            true,
+						IntervalSeconds:    1,
+						MaxPacketLossCount: 5,
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			_, err := operatorClient.SubmarinerV1alpha1().Submariners(constants.OperatorNamespace).Create(ctx, submariner,
+				metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+		})
+
+		Context("and matches the desired configuration", func() {
+			It("should plan no changes", func() {
+				plan, err := topology.PlanCluster(cluster, operatorClient)
+				Expect(err).To(Succeed())
+				Expect(plan.Action).To(Equal(topology.ActionUnchanged))
+				Expect(plan.Changes).To(BeEmpty())
+			})
+		})
+
+		Context("and differs from the desired configuration", func() {
+			BeforeEach(func() {
+				cluster.NATTraversal = false
+				cluster.ClusterCIDR = "10.1.0.0/16"
+			})
+
+			It("should plan an update listing the changes", func() {
+				plan, err := topology.PlanCluster(cluster, operatorClient)
+				Expect(err).To(Succeed())
+				Expect(plan.Action).To(Equal(topology.ActionUpdate))
+				Expect(plan.Changes).To(ConsistOf(
+					topology.Change{Field: "natTraversal", Current: true, Desired: false},
+					topology.Change{Field: "clusterCIDR", Current: "10.0.0.0/16", Desired: "10.1.0.0/16"}))
+			})
+		})
+	})
+
+	When("only a ServiceDiscovery resource is deployed", func() {
+		BeforeEach(func() {
+			_, err := operatorClient.SubmarinerV1alpha1().ServiceDiscoveries(constants.OperatorNamespace).Create(ctx,
+				&v1alpha1.ServiceDiscovery{
+					ObjectMeta: metav1.ObjectMeta{Name: names.ServiceDiscoveryCrName, Namespace: constants.OperatorNamespace},
+					Spec:       v1alpha1.ServiceDiscoverySpec{ClusterID: "east", Version: "0.11.0"},
+				}, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+		})
+
+		It("should compare against it", func() {
+			plan, err := topology.PlanCluster(cluster, operatorClient)
+			Expect(err).To(Succeed())
+			Expect(plan.Action).To(Equal(topology.ActionUpdate))
+			Expect(plan.Changes).To(ConsistOf(topology.Change{Field: "version", Current: "0.11.0", Desired: ""}))
+		})
+	})
+})
+
+var _ = Describe("PlanBroker", func() {
+	var (
+		operatorClient *fakeOperator.Clientset
+		broker         *topology.Broker
+	)
+
+	BeforeEach(func() {
+		operatorClient = fakeOperator.NewSimpleClientset()
+		broker = &topology.Broker{
+			Context: "broker",
+			BrokerOptions: deploy.BrokerOptions{
+				BrokerNamespace: "submariner-k8s-broker",
+				BrokerSpec: v1alpha1.BrokerSpec{
+					Components: []string{"service-discovery", "connectivity"},
+				},
+			},
+		}
+	})
+
+	When("the broker is not deployed", func() {
+		It("should plan to create", func() {
+			plan, err := topology.PlanBroker(broker, operatorClient)
+			Expect(err).To(Succeed())
+			Expect(plan.Action).To(Equal(topology.ActionCreate))
+		})
+	})
+
+	When("the broker is deployed", func() {
+		BeforeEach(func() {
+			_, err := operatorClient.SubmarinerV1alpha1().Brokers(broker.BrokerNamespace).Create(ctx, &v1alpha1.Broker{
+				ObjectMeta: metav1.ObjectMeta{Name: brokercr.Name, Namespace: broker.BrokerNamespace},
+				Spec: v1alpha1.BrokerSpec{
+					Components:           []string{"service-discovery", "connectivity"},
+					DefaultCustomDomains: []string{},
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+		})
+
+		It("should plan no changes if it matches", func() {
+			plan, err := topology.PlanBroker(broker, operatorClient)
+			Expect(err).To(Succeed())
+			Expect(plan.Action).To(Equal(topology.ActionUnchanged))
+		})
+
+		It("should plan an update if it differs", func() {
+			broker.BrokerSpec.GlobalnetEnabled = true
+
+			plan, err := topology.PlanBroker(broker, operatorClient)
+			Expect(err).To(Succeed())
+			Expect(plan.Action).To(Equal(topology.ActionUpdate))
+			Expect(plan.Changes).To(ContainElement(topology.Change{Field: "globalnetEnabled", Current: false, Desired: true}))
+		})
+	})
+})
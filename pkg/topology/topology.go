@@ -0,0 +1,171 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/submariner-operator/internal/cluster"
+	"github.com/submariner-io/submariner-operator/pkg/deploy"
+	"github.com/submariner-io/submariner-operator/pkg/join"
+	"sigs.k8s.io/yaml"
+)
+
+// Topology describes a broker and the clusters to join to it.
+type Topology struct {
+	Broker   Broker    `json:"broker"`
+	Clusters []Cluster `json:"clusters"`
+}
+
+// Broker describes the cluster hosting the broker and how the broker is deployed there.
+type Broker struct {
+	// Context is the kubeconfig context of the broker cluster.
+	Context string `json:"context"`
+
+	deploy.BrokerOptions
+}
+
+// Cluster describes a cluster to join to the broker.
+type Cluster struct {
+	// Context is the kubeconfig context of the cluster.
+	Context string `json:"context"`
+
+	// LabelGateway determines whether a node is labeled as the gateway if none is labeled yet. Defaults to true.
+	LabelGateway *bool `json:"labelGateway,omitempty"`
+
+	join.Options
+}
+
+// ShouldLabelGateway returns true if a node should be labeled as the gateway when none is labeled yet.
+func (c *Cluster) ShouldLabelGateway() bool {
+	return c.LabelGateway == nil || *c.LabelGateway
+}
+
+// ReadFromFile reads the topology from the given file. Any setting not specified in the file takes its
+// value from the given defaults.
+func ReadFromFile(fileName string, brokerDefaults *deploy.BrokerOptions, joinDefaults *join.Options) (*Topology, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading topology file %q", fileName)
+	}
+
+	return Parse(data, brokerDefaults, joinDefaults)
+}
+
+// Parse parses the topology from the given YAML or JSON data. Any setting not specified in the data takes its
+// value from the given defaults.
+func Parse(data []byte, brokerDefaults *deploy.BrokerOptions, joinDefaults *join.Options) (*Topology, error) {
+	raw := struct {
+		Broker   json.RawMessage   `json:"broker"`
+		Clusters []json.RawMessage `json:"clusters"`
+	}{}
+
+	if err := yaml.UnmarshalStrict(data, &raw); err != nil {
+		return nil, errors.Wrap(err, "error parsing the topology")
+	}
+
+	topology := &Topology{
+		Broker: Broker{BrokerOptions: *brokerDefaults},
+	}
+
+	topology.Broker.BrokerSpec.Components = copyStrings(brokerDefaults.BrokerSpec.Components)
+	topology.Broker.BrokerSpec.DefaultCustomDomains = copyStrings(brokerDefaults.BrokerSpec.DefaultCustomDomains)
+
+	if err := decodeStrict(raw.Broker, &topology.Broker); err != nil {
+		return nil, errors.Wrap(err, "error parsing the broker")
+	}
+
+	for i := range raw.Clusters {
+		c := Cluster{Options: *joinDefaults}
+		c.CustomDomains = copyStrings(joinDefaults.CustomDomains)
+		c.ImageOverrideArr = copyStrings(joinDefaults.ImageOverrideArr)
+
+		if err := decodeStrict(raw.Clusters[i], &c); err != nil {
+			return nil, errors.Wrapf(err, "error parsing cluster %d", i+1)
+		}
+
+		topology.Clusters = append(topology.Clusters, c)
+	}
+
+	return topology, nil
+}
+
+// Validate checks that the topology is complete and consistent. Cluster IDs must have been determined
+// beforehand.
+func (t *Topology) Validate() error {
+	if t.Broker.Context == "" {
+		return errors.New("the broker context must be specified")
+	}
+
+	if len(t.Clusters) == 0 {
+		return errors.New("at least one cluster must be specified")
+	}
+
+	contexts := map[string]bool{}
+	clusterIDs := map[string]string{}
+
+	for i := range t.Clusters {
+		c := &t.Clusters[i]
+
+		if c.Context == "" {
+			return fmt.Errorf("the context of cluster %d must be specified", i+1)
+		}
+
+		if contexts[c.Context] {
+			return fmt.Errorf("context %q is specified more than once", c.Context)
+		}
+
+		contexts[c.Context] = true
+
+		if err := cluster.IsValidID(c.ClusterID); err != nil {
+			return errors.Wrapf(err, "invalid cluster ID for context %q", c.Context)
+		}
+
+		if other, found := clusterIDs[c.ClusterID]; found {
+			return fmt.Errorf("contexts %q and %q have the same cluster ID %q", other, c.Context, c.ClusterID)
+		}
+
+		clusterIDs[c.ClusterID] = c.Context
+	}
+
+	return nil
+}
+
+func decodeStrict(data json.RawMessage, into interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(into) // nolint:wrapcheck // No need to wrap here
+}
+
+func copyStrings(from []string) []string {
+	if from == nil {
+		return nil
+	}
+
+	return append([]string{}, from...)
+}
@@ -0,0 +1,175 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/pkg/deploy"
+	"github.com/submariner-io/submariner-operator/pkg/join"
+	"github.com/submariner-io/submariner-operator/pkg/topology"
+)
+
+var _ = Describe("Parse", func() {
+	var (
+		brokerDefaults *deploy.BrokerOptions
+		joinDefaults   *join.Options
+	)
+
+	BeforeEach(func() {
+		brokerDefaults = &deploy.BrokerOptions{
+			BrokerNamespace: "submariner-k8s-broker",
+			BrokerSpec: v1alpha1.BrokerSpec{
+				Components:                  []string{"service-discovery", "connectivity"},
+				GlobalnetCIDRRange:          "242.0.0.0/8",
+				DefaultGlobalnetClusterSize: 65536,
+			},
+		}
+
+		joinDefaults = &join.Options{
+			NATTraversal:       true,
+			NATTPort:           4500,
+			IKEPort:            500,
+			HealthCheckEnabled: true,
+		}
+	})
+
+	When("settings are specified", func() {
+		It("should override the defaults", func() {
+			t, err := topology.Parse([]byte(`
+broker:
+  context: broker
+  namespace: my-broker
+  spec:
+    components: [service-discovery]
+clusters:
+  - context: east
+    clusterID: east-1
+    natTraversal: false
+    customDomains: [east.example]
+  - context: west
+    labelGateway: false
+    version: 0.12.0
+`), brokerDefaults, joinDefaults)
+			Expect(err).To(Succeed())
+
+			Expect(t.Broker.Context).To(Equal("broker"))
+			Expect(t.Broker.BrokerNamespace).To(Equal("my-broker"))
+			Expect(t.Broker.BrokerSpec.Components).To(Equal([]string{"service-discovery"}))
+			Expect(t.Broker.BrokerSpec.GlobalnetCIDRRange).To(Equal(brokerDefaults.BrokerSpec.GlobalnetCIDRRange))
+
+			Expect(t.Clusters).To(HaveLen(2))
+
+			Expect(t.Clusters[0].Context).To(Equal("east"))
+			Expect(t.Clusters[0].ClusterID).To(Equal("east-1"))
+			Expect(t.Clusters[0].NATTraversal).To(BeFalse())
+			Expect(t.Clusters[0].NATTPort).To(Equal(4500))
+			Expect(t.Clusters[0].CustomDomains).To(Equal([]string{"east.example"}))
+			Expect(t.Clusters[0].ShouldLabelGateway()).To(BeTrue())
+
+			Expect(t.Clusters[1].Context).To(Equal("west"))
+			Expect(t.Clusters[1].ImageVersion).To(Equal("0.12.0"))
+			Expect(t.Clusters[1].NATTraversal).To(BeTrue())
+			Expect(t.Clusters[1].CustomDomains).To(BeEmpty())
+			Expect(t.Clusters[1].ShouldLabelGateway()).To(BeFalse())
+		})
+
+		It("should not modify the defaults", func() {
+			_, err := topology.Parse([]byte(`
+broker:
+  context: broker
+  spec:
+    components: [connectivity, service-discovery]
+clusters:
+  - context: east
+`), brokerDefaults, joinDefaults)
+			Expect(err).To(Succeed())
+			Expect(brokerDefaults.BrokerSpec.Components).To(Equal([]string{"service-discovery", "connectivity"}))
+		})
+	})
+
+	When("an unknown setting is specified", func() {
+		It("should return an error", func() {
+			_, err := topology.Parse([]byte(`
+broker:
+  context: broker
+clusters:
+  - context: east
+    natTraversl: false
+`), brokerDefaults, joinDefaults)
+			Expect(err).ToNot(Succeed())
+		})
+	})
+})
+
+var _ = Describe("Validate", func() {
+	var t *topology.Topology
+
+	BeforeEach(func() {
+		t = &topology.Topology{
+			Broker: topology.Broker{Context: "broker"},
+			Clusters: []topology.Cluster{
+				{Context: "east", Options: join.Options{ClusterID: "east"}},
+				{Context: "west", Options: join.Options{ClusterID: "west"}},
+			},
+		}
+	})
+
+	When("the topology is valid", func() {
+		It("should succeed", func() {
+			Expect(t.Validate()).To(Succeed())
+		})
+	})
+
+	When("the broker context is missing", func() {
+		It("should return an error", func() {
+			t.Broker.Context = ""
+			Expect(t.Validate()).ToNot(Succeed())
+		})
+	})
+
+	When("there are no clusters", func() {
+		It("should return an error", func() {
+			t.Clusters = nil
+			Expect(t.Validate()).ToNot(Succeed())
+		})
+	})
+
+	When("a context is duplicated", func() {
+		It("should return an error", func() {
+			t.Clusters[1].Context = "east"
+			Expect(t.Validate()).ToNot(Succeed())
+		})
+	})
+
+	When("a cluster ID is duplicated", func() {
+		It("should return an error", func() {
+			t.Clusters[1].ClusterID = "east"
+			Expect(t.Validate()).ToNot(Succeed())
+		})
+	})
+
+	When("a cluster ID is invalid", func() {
+		It("should return an error", func() {
+			t.Clusters[1].ClusterID = "West_1"
+			Expect(t.Validate()).ToNot(Succeed())
+		})
+	})
+})
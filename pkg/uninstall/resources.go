@@ -0,0 +1,160 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"context"
+
+	"github.com/submariner-io/admiral/pkg/resource"
+	"github.com/submariner-io/submariner-operator/pkg/client"
+	"github.com/submariner-io/submariner-operator/pkg/embeddedyamls"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The RBAC resources installed with the operator, see submarinerop.Ensure.
+var (
+	clusterRoleBindingYAMLs = []string{
+		embeddedyamls.Config_rbac_submariner_operator_cluster_role_binding_yaml,
+		embeddedyamls.Config_rbac_submariner_gateway_cluster_role_binding_yaml,
+		embeddedyamls.Config_rbac_submariner_route_agent_cluster_role_binding_yaml,
+		embeddedyamls.Config_rbac_submariner_globalnet_cluster_role_binding_yaml,
+		embeddedyamls.Config_rbac_networkplugin_syncer_cluster_role_binding_yaml,
+		embeddedyamls.Config_rbac_lighthouse_agent_cluster_role_binding_yaml,
+		embeddedyamls.Config_rbac_lighthouse_coredns_cluster_role_binding_yaml,
+	}
+
+	clusterRoleYAMLs = []string{
+		embeddedyamls.Config_rbac_submariner_operator_cluster_role_yaml,
+		embeddedyamls.Config_rbac_submariner_gateway_cluster_role_yaml,
+		embeddedyamls.Config_rbac_submariner_route_agent_cluster_role_yaml,
+		embeddedyamls.Config_rbac_submariner_globalnet_cluster_role_yaml,
+		embeddedyamls.Config_rbac_networkplugin_syncer_cluster_role_yaml,
+		embeddedyamls.Config_rbac_lighthouse_agent_cluster_role_yaml,
+		embeddedyamls.Config_rbac_lighthouse_coredns_cluster_role_yaml,
+	}
+
+	roleBindingYAMLs = []string{
+		embeddedyamls.Config_rbac_submariner_operator_role_binding_yaml,
+		embeddedyamls.Config_rbac_submariner_gateway_role_binding_yaml,
+		embeddedyamls.Config_rbac_submariner_route_agent_role_binding_yaml,
+		embeddedyamls.Config_rbac_submariner_globalnet_role_binding_yaml,
+		embeddedyamls.Config_openshift_rbac_submariner_metrics_reader_role_binding_yaml,
+	}
+
+	roleYAMLs = []string{
+		embeddedyamls.Config_rbac_submariner_operator_role_yaml,
+		embeddedyamls.Config_rbac_submariner_gateway_role_yaml,
+		embeddedyamls.Config_rbac_submariner_route_agent_role_yaml,
+		embeddedyamls.Config_rbac_submariner_globalnet_role_yaml,
+		embeddedyamls.Config_openshift_rbac_submariner_metrics_reader_role_yaml,
+	}
+
+	serviceAccountYAMLs = []string{
+		embeddedyamls.Config_rbac_submariner_operator_service_account_yaml,
+		embeddedyamls.Config_rbac_submariner_gateway_service_account_yaml,
+		embeddedyamls.Config_rbac_submariner_route_agent_service_account_yaml,
+		embeddedyamls.Config_rbac_submariner_globalnet_service_account_yaml,
+		embeddedyamls.Config_rbac_networkplugin_syncer_service_account_yaml,
+		embeddedyamls.Config_rbac_lighthouse_agent_service_account_yaml,
+		embeddedyamls.Config_rbac_lighthouse_coredns_service_account_yaml,
+	}
+)
+
+// The CRDs installed by the operator and subctl, for the operator itself, connectivity and service discovery.
+var crdYAMLs = []string{
+	embeddedyamls.Deploy_crds_submariner_io_submariners_yaml,
+	embeddedyamls.Deploy_crds_submariner_io_servicediscoveries_yaml,
+	embeddedyamls.Deploy_crds_submariner_io_brokers_yaml,
+	embeddedyamls.Deploy_crds_submariner_io_globalnetallocations_yaml,
+	embeddedyamls.Deploy_submariner_crds_submariner_io_clusters_yaml,
+	embeddedyamls.Deploy_submariner_crds_submariner_io_endpoints_yaml,
+	embeddedyamls.Deploy_submariner_crds_submariner_io_gateways_yaml,
+	embeddedyamls.Deploy_submariner_crds_submariner_io_clusterglobalegressips_yaml,
+	embeddedyamls.Deploy_submariner_crds_submariner_io_globalegressips_yaml,
+	embeddedyamls.Deploy_submariner_crds_submariner_io_globalingressips_yaml,
+	embeddedyamls.Deploy_mcsapi_crds_multicluster_x_k8s_io_serviceexports_yaml,
+	embeddedyamls.Deploy_mcsapi_crds_multicluster_x_k8s_io_serviceimports_yaml,
+}
+
+func forSubmariners(clientProducer client.Producer, namespace string) resource.Interface {
+	client := clientProducer.ForOperator().SubmarinerV1alpha1().Submariners(namespace)
+
+	return &resource.InterfaceFuncs{
+		GetFunc: func(ctx context.Context, name string, options metav1.GetOptions) (runtime.Object, error) {
+			return client.Get(ctx, name, options)
+		},
+		DeleteFunc: client.Delete,
+	}
+}
+
+func forServiceDiscoveries(clientProducer client.Producer, namespace string) resource.Interface {
+	client := clientProducer.ForOperator().SubmarinerV1alpha1().ServiceDiscoveries(namespace)
+
+	return &resource.InterfaceFuncs{
+		GetFunc: func(ctx context.Context, name string, options metav1.GetOptions) (runtime.Object, error) {
+			return client.Get(ctx, name, options)
+		},
+		DeleteFunc: client.Delete,
+	}
+}
+
+func forGlobalnetAllocations(clientProducer client.Producer, namespace string) resource.Interface {
+	client := clientProducer.ForOperator().SubmarinerV1alpha1().GlobalnetAllocations(namespace)
+
+	return &resource.InterfaceFuncs{
+		GetFunc: func(ctx context.Context, name string, options metav1.GetOptions) (runtime.Object, error) {
+			return client.Get(ctx, name, options)
+		},
+		DeleteFunc: client.Delete,
+	}
+}
+
+func forBrokers(clientProducer client.Producer, namespace string) resource.Interface {
+	client := clientProducer.ForOperator().SubmarinerV1alpha1().Brokers(namespace)
+
+	return &resource.InterfaceFuncs{
+		GetFunc: func(ctx context.Context, name string, options metav1.GetOptions) (runtime.Object, error) {
+			return client.Get(ctx, name, options)
+		},
+		DeleteFunc: client.Delete,
+	}
+}
+
+func forCRDs(clientProducer client.Producer) resource.Interface {
+	client := clientProducer.ForCRD().ApiextensionsV1().CustomResourceDefinitions()
+
+	return &resource.InterfaceFuncs{
+		GetFunc: func(ctx context.Context, name string, options metav1.GetOptions) (runtime.Object, error) {
+			return client.Get(ctx, name, options)
+		},
+		DeleteFunc: client.Delete,
+	}
+}
+
+func forNamespaces(clientProducer client.Producer) resource.Interface {
+	client := clientProducer.ForKubernetes().CoreV1().Namespaces()
+
+	return &resource.InterfaceFuncs{
+		GetFunc: func(ctx context.Context, name string, options metav1.GetOptions) (runtime.Object, error) {
+			return client.Get(ctx, name, options)
+		},
+		DeleteFunc: client.Delete,
+	}
+}
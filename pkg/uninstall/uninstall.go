@@ -0,0 +1,412 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/submariner-io/admiral/pkg/resource"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	controllerconstants "github.com/submariner-io/submariner-operator/controllers/constants"
+	componentuninstall "github.com/submariner-io/submariner-operator/controllers/uninstall"
+	"github.com/submariner-io/submariner-operator/internal/constants"
+	"github.com/submariner-io/submariner-operator/internal/nodes"
+	"github.com/submariner-io/submariner-operator/pkg/client"
+	"github.com/submariner-io/submariner-operator/pkg/embeddedyamls"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
+	"github.com/submariner-io/submariner-operator/pkg/scc"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const DefaultTimeout = 5 * time.Minute
+
+type Options struct {
+	OperatorNamespace string
+	BrokerNamespace   string
+	DeleteCRDs        bool
+	DryRun            bool
+	Timeout           time.Duration
+}
+
+type uninstaller struct {
+	options        *Options
+	clientProducer client.Producer
+	status         reporter.Interface
+	brokerHosted   bool
+}
+
+type resourceRef struct {
+	client resource.Interface
+	kind   string
+	name   string
+}
+
+// All removes Submariner from the cluster: the Submariner, ServiceDiscovery and Broker resources, once the operator has
+// uninstalled their components, then the operator, its RBAC, the gateway node labels, the namespaces and optionally the CRDs.
+// With DryRun set, the resources which would be removed are reported but left untouched.
+func All(options *Options, clientProducer client.Producer, status reporter.Interface) error {
+	u := &uninstaller{
+		options:        options,
+		clientProducer: clientProducer,
+		status:         status,
+	}
+
+	if u.options.Timeout == 0 {
+		u.options.Timeout = DefaultTimeout
+	}
+
+	type step struct {
+		description string
+		failure     string
+		run         func() error
+	}
+
+	steps := []step{
+		{"Deleting the Submariner resources", "error deleting the Submariner resources", u.deleteSubmarinerResources},
+		{"Removing the Submariner operator", "error removing the Submariner operator", u.deleteOperator},
+		{"Removing the Submariner RBAC resources", "error removing the Submariner RBAC resources", u.deleteRBAC},
+		{"Removing the gateway node labels", "error removing the gateway node labels", u.removeGatewayLabels},
+	}
+
+	if options.DeleteCRDs {
+		steps = append(steps, step{"Removing the Submariner CRDs", "error removing the Submariner CRDs", u.deleteCRDs})
+	}
+
+	steps = append(steps, step{"Removing the Submariner namespaces", "error removing the Submariner namespaces", u.deleteNamespaces})
+
+	for _, step := range steps {
+		status.Start(step.description)
+
+		if err := step.run(); err != nil {
+			return status.Error(err, step.failure)
+		}
+
+		status.End()
+	}
+
+	return nil
+}
+
+func (u *uninstaller) deleteSubmarinerResources() error {
+	operatorClient := u.clientProducer.ForOperator().SubmarinerV1alpha1()
+
+	submariner, err := operatorClient.Submariners(u.options.OperatorNamespace).Get(context.TODO(), constants.SubmarinerName,
+		metav1.GetOptions{})
+	if err == nil && !componentuninstall.IsSupportedForVersion(submariner.Spec.Version) {
+		u.status.Warning("Submariner version %q does not uninstall its components; the dataplane configuration will be left"+
+			" on the nodes", submariner.Spec.Version)
+	} else if err != nil && !isGone(err) {
+		return errors.Wrap(err, "error retrieving the Submariner resource")
+	}
+
+	clusterResources := []resourceRef{
+		{forServiceDiscoveries(u.clientProducer, u.options.OperatorNamespace), "ServiceDiscovery", names.ServiceDiscoveryCrName},
+		{forSubmariners(u.clientProducer, u.options.OperatorNamespace), "Submariner", constants.SubmarinerName},
+	}
+
+	allocations, err := operatorClient.GlobalnetAllocations(u.options.BrokerNamespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil && !isGone(err) {
+		return errors.Wrap(err, "error listing the GlobalnetAllocation resources")
+	}
+
+	if err == nil {
+		for i := range allocations.Items {
+			clusterResources = append(clusterResources, resourceRef{
+				forGlobalnetAllocations(u.clientProducer, u.options.BrokerNamespace), "GlobalnetAllocation", allocations.Items[i].Name,
+			})
+		}
+	}
+
+	// The Broker resource goes last, the operator only releases globalnet allocations while the Broker is present.
+	var brokerResources []resourceRef
+
+	brokers, err := operatorClient.Brokers(u.options.BrokerNamespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil && !isGone(err) {
+		return errors.Wrap(err, "error listing the Broker resources")
+	}
+
+	if err == nil {
+		for i := range brokers.Items {
+			brokerResources = append(brokerResources, resourceRef{
+				forBrokers(u.clientProducer, u.options.BrokerNamespace), "Broker", brokers.Items[i].Name,
+			})
+		}
+	}
+
+	u.brokerHosted = len(brokerResources) > 0
+
+	if err := u.deleteAndAwait(clusterResources); err != nil {
+		return err
+	}
+
+	return u.deleteAndAwait(brokerResources)
+}
+
+// deleteAndAwait deletes the given resources and waits for the operator to finalize them.
+func (u *uninstaller) deleteAndAwait(refs []resourceRef) error {
+	deleted, err := u.deleteAll(refs)
+	if err != nil || len(deleted) == 0 || u.options.DryRun {
+		return err
+	}
+
+	_, err = u.clientProducer.ForKubernetes().AppsV1().Deployments(u.options.OperatorNamespace).Get(context.TODO(),
+		names.OperatorComponent, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("the Submariner operator is not deployed in namespace %q; it is required to finalize the deleted"+
+			" resources", u.options.OperatorNamespace)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error retrieving the Submariner operator deployment")
+	}
+
+	var (
+		remaining    resourceRef
+		remainingObj runtime.Object
+	)
+
+	err = wait.PollImmediate(time.Second, u.options.Timeout, func() (bool, error) {
+		for _, ref := range deleted {
+			obj, err := ref.client.Get(context.TODO(), ref.name, metav1.GetOptions{})
+			if isGone(err) {
+				continue
+			}
+
+			remaining, remainingObj = ref, obj
+
+			return false, err // nolint:wrapcheck // No need to wrap here
+		}
+
+		return true, nil
+	})
+
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return TimeoutError(remaining.kind, remaining.name, remainingObj)
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for %s %q to be deleted", remaining.kind, remaining.name)
+	}
+
+	for _, ref := range deleted {
+		u.status.Success("Uninstalled %s %q", ref.kind, ref.name)
+	}
+
+	return nil
+}
+
+// TimeoutError explains why a deleted Submariner or ServiceDiscovery resource is still present. When the operator itself
+// timed out uninstalling the components, the resource keeps its finalizer until the uninstall is retried or abandoned.
+func TimeoutError(kind, name string, obj runtime.Object) error {
+	var conditions []metav1.Condition
+
+	switch o := obj.(type) {
+	case *v1alpha1.Submariner:
+		conditions = o.Status.Conditions
+	case *v1alpha1.ServiceDiscovery:
+		conditions = o.Status.Conditions
+	}
+
+	condition := meta.FindStatusCondition(conditions, v1alpha1.ConditionUninstalled)
+	if condition == nil || condition.Reason != componentuninstall.TimedOutReason {
+		return fmt.Errorf("timed out waiting for the operator to uninstall %s %q", kind, name)
+	}
+
+	return fmt.Errorf("the operator timed out uninstalling %s %q: %s. The resource keeps its %q finalizer; increase"+
+		" spec.uninstallTimeout to retry the uninstall, or remove the finalizer to abandon it", kind, name,
+		condition.Message, controllerconstants.CleanupFinalizer)
+}
+
+func (u *uninstaller) deleteOperator() error {
+	_, err := u.deleteAll([]resourceRef{
+		{resource.ForDeployment(u.clientProducer.ForKubernetes(), u.options.OperatorNamespace), "Deployment", names.OperatorComponent},
+	})
+
+	return err
+}
+
+func (u *uninstaller) deleteRBAC() error {
+	kubeClient := u.clientProducer.ForKubernetes()
+	refs := []resourceRef{}
+
+	for _, kindYAMLs := range []struct {
+		kind   string
+		client resource.Interface
+		yamls  []string
+	}{
+		{"ClusterRoleBinding", resource.ForClusterRoleBinding(kubeClient), clusterRoleBindingYAMLs},
+		{"ClusterRole", resource.ForClusterRole(kubeClient), clusterRoleYAMLs},
+		{"RoleBinding", resource.ForRoleBinding(kubeClient, u.options.OperatorNamespace), roleBindingYAMLs},
+		{"Role", resource.ForRole(kubeClient, u.options.OperatorNamespace), roleYAMLs},
+		{"ServiceAccount", resource.ForServiceAccount(kubeClient, u.options.OperatorNamespace), serviceAccountYAMLs},
+	} {
+		for _, yaml := range kindYAMLs.yamls {
+			ref, err := refFromYAML(kindYAMLs.client, kindYAMLs.kind, yaml)
+			if err != nil {
+				return err
+			}
+
+			refs = append(refs, ref)
+		}
+	}
+
+	if _, err := u.deleteAll(refs); err != nil {
+		return err
+	}
+
+	return u.removeSCCUsers()
+}
+
+func (u *uninstaller) removeSCCUsers() error {
+	dynClient := u.clientProducer.ForDynamic()
+
+	for _, yaml := range serviceAccountYAMLs {
+		name, err := embeddedyamls.GetObjectName(yaml)
+		if err != nil {
+			return errors.Wrap(err, "error parsing the ServiceAccount resource")
+		}
+
+		var present bool
+
+		if u.options.DryRun {
+			present, err = scc.HasUser(dynClient, u.options.OperatorNamespace, name)
+		} else {
+			present, err = scc.RemoveUser(dynClient, u.options.OperatorNamespace, name)
+		}
+
+		if err != nil {
+			return errors.Wrapf(err, "error removing ServiceAccount %q from the privileged SCC", name)
+		}
+
+		if present {
+			u.status.Success("%s ServiceAccount %q from the privileged SCC", u.action("Removed"), name)
+		}
+	}
+
+	return nil
+}
+
+func (u *uninstaller) removeGatewayLabels() error {
+	kubeClient := u.clientProducer.ForKubernetes()
+
+	gateways, err := nodes.ListGateways(kubeClient)
+	if err != nil {
+		return err // nolint:wrapcheck // No need to wrap here
+	}
+
+	for _, name := range gateways {
+		if !u.options.DryRun {
+			if err := nodes.RemoveGatewayLabel(kubeClient, name); err != nil {
+				return errors.Wrapf(err, "error removing the gateway label from node %q", name)
+			}
+		}
+
+		u.status.Success("%s the gateway label from node %q", u.action("Removed"), name)
+	}
+
+	return nil
+}
+
+func (u *uninstaller) deleteCRDs() error {
+	refs := []resourceRef{}
+
+	for _, yaml := range crdYAMLs {
+		ref, err := refFromYAML(forCRDs(u.clientProducer), "CustomResourceDefinition", yaml)
+		if err != nil {
+			return err
+		}
+
+		refs = append(refs, ref)
+	}
+
+	_, err := u.deleteAll(refs)
+
+	return err
+}
+
+func (u *uninstaller) deleteNamespaces() error {
+	refs := []resourceRef{{forNamespaces(u.clientProducer), "Namespace", u.options.OperatorNamespace}}
+
+	if u.brokerHosted {
+		refs = append(refs, resourceRef{forNamespaces(u.clientProducer), "Namespace", u.options.BrokerNamespace})
+	}
+
+	_, err := u.deleteAll(refs)
+
+	return err
+}
+
+// deleteAll deletes the given resources, skipping those which don't exist, and returns the ones that were deleted.
+func (u *uninstaller) deleteAll(refs []resourceRef) ([]resourceRef, error) {
+	deleted := []resourceRef{}
+
+	for _, ref := range refs {
+		var err error
+
+		if u.options.DryRun {
+			_, err = ref.client.Get(context.TODO(), ref.name, metav1.GetOptions{})
+		} else {
+			err = ref.client.Delete(context.TODO(), ref.name, metav1.DeleteOptions{})
+		}
+
+		if isGone(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "error deleting %s %q", ref.kind, ref.name)
+		}
+
+		u.status.Success("%s %s %q", u.action("Deleted"), ref.kind, ref.name)
+
+		deleted = append(deleted, ref)
+	}
+
+	return deleted, nil
+}
+
+func (u *uninstaller) action(verb string) string {
+	if u.options.DryRun {
+		return "Would have " + strings.ToLower(verb)
+	}
+
+	return verb
+}
+
+func refFromYAML(client resource.Interface, kind, yaml string) (resourceRef, error) {
+	name, err := embeddedyamls.GetObjectName(yaml)
+	if err != nil {
+		return resourceRef{}, errors.Wrapf(err, "error parsing the %s resource", kind)
+	}
+
+	return resourceRef{client: client, kind: kind, name: name}, nil
+}
+
+func isGone(err error) bool {
+	return apierrors.IsNotFound(err) || meta.IsNoMatchError(err)
+}
@@ -0,0 +1,305 @@
+/*
+SPDX-License-Identifier: Apache-2.0
+
+Copyright Contributors to the Submariner project.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/submariner-io/submariner-operator/api/submariner/v1alpha1"
+	"github.com/submariner-io/submariner-operator/internal/constants"
+	"github.com/submariner-io/submariner-operator/pkg/brokercr"
+	"github.com/submariner-io/submariner-operator/pkg/client"
+	fakeOperator "github.com/submariner-io/submariner-operator/pkg/client/clientset/versioned/fake"
+	"github.com/submariner-io/submariner-operator/pkg/embeddedyamls"
+	"github.com/submariner-io/submariner-operator/pkg/names"
+	"github.com/submariner-io/submariner-operator/pkg/reporter"
+	"github.com/submariner-io/submariner-operator/pkg/uninstall"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	fakeApiExt "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeDynamic "k8s.io/client-go/dynamic/fake"
+	fakeKubeClient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+)
+
+const (
+	operatorNamespace = "submariner-operator"
+	brokerNamespace   = "submariner-k8s-broker"
+	gatewayNode       = "gateway-node"
+)
+
+var _ = Describe("All", func() {
+	var (
+		kubeClient     *fakeKubeClient.Clientset
+		operatorClient *fakeOperator.Clientset
+		crdClient      *fakeApiExt.Clientset
+		options        *uninstall.Options
+		status         *reporter.Collector
+		submariner     *v1alpha1.Submariner
+		operatorSA     string
+		crdName        string
+		keepSubmariner bool
+		err            error
+	)
+
+	BeforeEach(func() {
+		operatorSA, err = embeddedyamls.GetObjectName(embeddedyamls.Config_rbac_submariner_operator_service_account_yaml)
+		Expect(err).To(Succeed())
+
+		crdName, err = embeddedyamls.GetObjectName(embeddedyamls.Deploy_crds_submariner_io_submariners_yaml)
+		Expect(err).To(Succeed())
+
+		submariner = &v1alpha1.Submariner{
+			ObjectMeta: metav1.ObjectMeta{Name: constants.SubmarinerName, Namespace: operatorNamespace},
+			Spec:       v1alpha1.SubmarinerSpec{Version: "0.12.0"},
+		}
+
+		options = &uninstall.Options{
+			OperatorNamespace: operatorNamespace,
+			BrokerNamespace:   brokerNamespace,
+			Timeout:           time.Second,
+		}
+
+		status = reporter.NewCollector()
+		keepSubmariner = false
+	})
+
+	JustBeforeEach(func() {
+		kubeClient = fakeKubeClient.NewSimpleClientset(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: operatorNamespace}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: brokerNamespace}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: names.OperatorComponent, Namespace: operatorNamespace}},
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: operatorSA, Namespace: operatorNamespace}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: operatorSA}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   gatewayNode,
+				Labels: map[string]string{constants.SubmarinerGatewayLabel: constants.TrueLabel, "other": "label"},
+			}},
+		)
+
+		operatorClient = fakeOperator.NewSimpleClientset(
+			submariner,
+			&v1alpha1.ServiceDiscovery{ObjectMeta: metav1.ObjectMeta{Name: names.ServiceDiscoveryCrName, Namespace: operatorNamespace}},
+			&v1alpha1.GlobalnetAllocation{ObjectMeta: metav1.ObjectMeta{Name: "east", Namespace: brokerNamespace}},
+		)
+
+		if keepSubmariner {
+			// Simulate the operator's finalizer
+			operatorClient.PrependReactor("delete", "submariners", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, nil
+			})
+		}
+
+		crdClient = fakeApiExt.NewSimpleClientset(&apiextensions.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: crdName}})
+
+		err = uninstall.All(options, &client.DefaultProducer{
+			CRDClient:      crdClient,
+			KubeClient:     kubeClient,
+			DynamicClient:  fakeDynamic.NewSimpleDynamicClient(runtime.NewScheme()),
+			OperatorClient: operatorClient,
+		}, status)
+	})
+
+	assertMissing := func(get func() error) {
+		Expect(apierrors.IsNotFound(get())).To(BeTrue())
+	}
+
+	assertPresent := func(get func() error) {
+		Expect(get()).To(Succeed())
+	}
+
+	getSubmariner := func() error {
+		_, err := operatorClient.SubmarinerV1alpha1().Submariners(operatorNamespace).Get(context.TODO(), submariner.Name,
+			metav1.GetOptions{})
+		return err
+	}
+
+	getServiceDiscovery := func() error {
+		_, err := operatorClient.SubmarinerV1alpha1().ServiceDiscoveries(operatorNamespace).Get(context.TODO(),
+			names.ServiceDiscoveryCrName, metav1.GetOptions{})
+		return err
+	}
+
+	getAllocation := func() error {
+		_, err := operatorClient.SubmarinerV1alpha1().GlobalnetAllocations(brokerNamespace).Get(context.TODO(), "east",
+			metav1.GetOptions{})
+		return err
+	}
+
+	getOperator := func() error {
+		_, err := kubeClient.AppsV1().Deployments(operatorNamespace).Get(context.TODO(), names.OperatorComponent, metav1.GetOptions{})
+		return err
+	}
+
+	getServiceAccount := func() error {
+		_, err := kubeClient.CoreV1().ServiceAccounts(operatorNamespace).Get(context.TODO(), operatorSA, metav1.GetOptions{})
+		return err
+	}
+
+	getClusterRole := func() error {
+		_, err := kubeClient.RbacV1().ClusterRoles().Get(context.TODO(), operatorSA, metav1.GetOptions{})
+		return err
+	}
+
+	getNamespace := func(name string) func() error {
+		return func() error {
+			_, err := kubeClient.CoreV1().Namespaces().Get(context.TODO(), name, metav1.GetOptions{})
+			return err
+		}
+	}
+
+	getCRD := func() error {
+		_, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), crdName, metav1.GetOptions{})
+		return err
+	}
+
+	gatewayLabels := func() map[string]string {
+		node, err := kubeClient.CoreV1().Nodes().Get(context.TODO(), gatewayNode, metav1.GetOptions{})
+		Expect(err).To(Succeed())
+
+		return node.Labels
+	}
+
+	When("Submariner is installed", func() {
+		It("should remove the resources, the operator, the RBAC resources, the labels and the namespace", func() {
+			Expect(err).To(Succeed())
+			Expect(status.Result()).To(Equal(reporter.SuccessLevel))
+
+			assertMissing(getSubmariner)
+			assertMissing(getServiceDiscovery)
+			assertMissing(getAllocation)
+			assertMissing(getOperator)
+			assertMissing(getServiceAccount)
+			assertMissing(getClusterRole)
+			assertMissing(getNamespace(operatorNamespace))
+			Expect(gatewayLabels()).To(Equal(map[string]string{"other": "label"}))
+		})
+
+		It("should leave the broker namespace and the CRDs", func() {
+			assertPresent(getNamespace(brokerNamespace))
+			assertPresent(getCRD)
+		})
+	})
+
+	When("the broker is deployed in the cluster", func() {
+		JustBeforeEach(func() {
+			// Run a second time now that a Broker is present
+			_, err = operatorClient.SubmarinerV1alpha1().Brokers(brokerNamespace).Create(context.TODO(), &v1alpha1.Broker{
+				ObjectMeta: metav1.ObjectMeta{Name: brokercr.Name, Namespace: brokerNamespace},
+			}, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+
+			_, err = kubeClient.AppsV1().Deployments(operatorNamespace).Create(context.TODO(), &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: names.OperatorComponent, Namespace: operatorNamespace},
+			}, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+
+			err = uninstall.All(options, &client.DefaultProducer{
+				CRDClient:      crdClient,
+				KubeClient:     kubeClient,
+				DynamicClient:  fakeDynamic.NewSimpleDynamicClient(runtime.NewScheme()),
+				OperatorClient: operatorClient,
+			}, status)
+		})
+
+		It("should remove the Broker and the broker namespace", func() {
+			Expect(err).To(Succeed())
+
+			_, err := operatorClient.SubmarinerV1alpha1().Brokers(brokerNamespace).Get(context.TODO(), brokercr.Name, metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			assertMissing(getNamespace(brokerNamespace))
+		})
+	})
+
+	When("CRD deletion is requested", func() {
+		BeforeEach(func() {
+			options.DeleteCRDs = true
+		})
+
+		It("should remove the CRDs", func() {
+			Expect(err).To(Succeed())
+			assertMissing(getCRD)
+		})
+	})
+
+	When("running in dry-run mode", func() {
+		BeforeEach(func() {
+			options.DryRun = true
+			options.DeleteCRDs = true
+		})
+
+		It("should report the resources without removing them", func() {
+			Expect(err).To(Succeed())
+
+			assertPresent(getSubmariner)
+			assertPresent(getServiceDiscovery)
+			assertPresent(getAllocation)
+			assertPresent(getOperator)
+			assertPresent(getServiceAccount)
+			assertPresent(getClusterRole)
+			assertPresent(getNamespace(operatorNamespace))
+			assertPresent(getCRD)
+			Expect(gatewayLabels()).To(HaveKey(constants.SubmarinerGatewayLabel))
+
+			Expect(status.Operations).To(ContainElement(HaveField("Messages", ContainElement(reporter.Message{
+				Level: reporter.SuccessLevel,
+				Text:  `Would have deleted Submariner "submariner"`,
+			}))))
+		})
+	})
+
+	When("the installed version doesn't support uninstalling its components", func() {
+		BeforeEach(func() {
+			submariner.Spec.Version = "0.11.0"
+		})
+
+		It("should report a warning", func() {
+			Expect(err).To(Succeed())
+			Expect(status.Result()).To(Equal(reporter.WarningLevel))
+		})
+	})
+
+	When("the operator times out uninstalling the components", func() {
+		BeforeEach(func() {
+			keepSubmariner = true
+			submariner.Status.Conditions = []metav1.Condition{{
+				Type:    v1alpha1.ConditionUninstalled,
+				Status:  metav1.ConditionFalse,
+				Reason:  "TimedOut",
+				Message: "Timed out after 2m0s waiting for the components to be uninstalled",
+			}}
+		})
+
+		It("should report that the resource keeps its finalizer", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Timed out after 2m0s"))
+			Expect(err.Error()).To(ContainSubstring("keeps its"))
+			Expect(err.Error()).To(ContainSubstring("spec.uninstallTimeout"))
+		})
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/coreos/go-semver/semver"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
 )
@@ -39,6 +40,22 @@ func PrintSubctlVersion(w io.Writer) {
 	fmt.Fprintf(w, "subctl version: %s\n", Version)
 }
 
+// Compare compares two versions, ignoring any "v" prefix, returning -1, 0 or 1 if a is older than, the same as, or newer
+// than b; ok is false if either of them isn't a semantic version, e.g. development builds.
+func Compare(a, b string) (result int, ok bool) {
+	aVersion, err := semver.NewVersion(strings.TrimPrefix(a, "v"))
+	if err != nil {
+		return 0, false
+	}
+
+	bVersion, err := semver.NewVersion(strings.TrimPrefix(b, "v"))
+	if err != nil {
+		return 0, false
+	}
+
+	return aVersion.Compare(*bVersion), true
+}
+
 func CheckRequirements(k8sclient kubernetes.Interface) (string, []string, error) {
 	failedRequirements := []string{}
 